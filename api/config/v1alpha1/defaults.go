@@ -106,6 +106,16 @@ const (
 	DefaultEtcdNotReadyThreshold = 5 * time.Minute
 	// DefaultEtcdUnknownThreshold is the default threshold for etcd unknown status.
 	DefaultEtcdUnknownThreshold = 1 * time.Minute
+	// DefaultLeaderStabilityWindow is the default duration of the sliding window within which leader changes are counted.
+	DefaultLeaderStabilityWindow = 1 * time.Hour
+	// DefaultMaxLeaderChanges is the default maximum number of leader changes within the window.
+	DefaultMaxLeaderChanges = 3
+	// DefaultExtraCheckTimeout is the default duration after which the execution of an additionally registered check is aborted.
+	DefaultExtraCheckTimeout = 30 * time.Second
+	// DefaultResourceRecommendationInterval is the default minimum duration between two samples of the resource usage of an etcd cluster.
+	DefaultResourceRecommendationInterval = 5 * time.Minute
+	// DefaultResourceRecommendationHalfLife is the default duration after which the weight of a peak resource usage has halved.
+	DefaultResourceRecommendationHalfLife = 24 * time.Hour
 )
 
 // SetDefaults_EtcdControllerConfiguration sets defaults for the etcd controller configuration.
@@ -122,6 +132,25 @@ func SetDefaults_EtcdControllerConfiguration(etcdCtrlConfig *EtcdControllerConfi
 	if etcdCtrlConfig.EtcdMember.UnknownThreshold == zeroDuration {
 		etcdCtrlConfig.EtcdMember.UnknownThreshold = metav1.Duration{Duration: DefaultEtcdUnknownThreshold}
 	}
+	if etcdCtrlConfig.LeaderStability.Window == zeroDuration {
+		etcdCtrlConfig.LeaderStability.Window = metav1.Duration{Duration: DefaultLeaderStabilityWindow}
+	}
+	if etcdCtrlConfig.LeaderStability.MaxLeaderChanges == 0 {
+		etcdCtrlConfig.LeaderStability.MaxLeaderChanges = DefaultMaxLeaderChanges
+	}
+	for _, extraChecks := range [][]ExtraCheck{etcdCtrlConfig.ExtraChecks.Conditions, etcdCtrlConfig.ExtraChecks.EtcdMembers} {
+		for i := range extraChecks {
+			if extraChecks[i].Timeout == nil {
+				extraChecks[i].Timeout = &metav1.Duration{Duration: DefaultExtraCheckTimeout}
+			}
+		}
+	}
+	if etcdCtrlConfig.ResourceRecommendation.Interval == zeroDuration {
+		etcdCtrlConfig.ResourceRecommendation.Interval = metav1.Duration{Duration: DefaultResourceRecommendationInterval}
+	}
+	if etcdCtrlConfig.ResourceRecommendation.HalfLife == zeroDuration {
+		etcdCtrlConfig.ResourceRecommendation.HalfLife = metav1.Duration{Duration: DefaultResourceRecommendationHalfLife}
+	}
 }
 
 const (
@@ -133,6 +162,12 @@ const (
 	DefaultCompactionTriggerFullSnapshotThreshold = 3000000
 	// DefaultCompactionActiveDeadlineDuration is the default active deadline duration for compaction.
 	DefaultCompactionActiveDeadlineDuration = 3 * time.Hour
+	// DefaultCompactionFailureBackoffInitialDelay is the default delay before creating a new compaction job after the first failed compaction job.
+	DefaultCompactionFailureBackoffInitialDelay = 5 * time.Minute
+	// DefaultCompactionFailureBackoffMaxDelay is the default upper bound for the delay between compaction jobs after failed compaction jobs.
+	DefaultCompactionFailureBackoffMaxDelay = 2 * time.Hour
+	// DefaultCompactionFailureThreshold is the default number of consecutive failed compaction jobs after which a full snapshot is triggered.
+	DefaultCompactionFailureThreshold = 5
 )
 
 // SetDefaults_CompactionControllerConfiguration sets defaults for the compaction controller configuration.
@@ -152,6 +187,15 @@ func SetDefaults_CompactionControllerConfiguration(compactionCtrlConfig *Compact
 	if compactionCtrlConfig.ActiveDeadlineDuration == zeroDuration {
 		compactionCtrlConfig.ActiveDeadlineDuration = metav1.Duration{Duration: DefaultCompactionActiveDeadlineDuration}
 	}
+	if compactionCtrlConfig.FailureBackoff.InitialDelay == zeroDuration {
+		compactionCtrlConfig.FailureBackoff.InitialDelay = metav1.Duration{Duration: DefaultCompactionFailureBackoffInitialDelay}
+	}
+	if compactionCtrlConfig.FailureBackoff.MaxDelay == zeroDuration {
+		compactionCtrlConfig.FailureBackoff.MaxDelay = metav1.Duration{Duration: DefaultCompactionFailureBackoffMaxDelay}
+	}
+	if compactionCtrlConfig.FailureBackoff.FailureThreshold == 0 {
+		compactionCtrlConfig.FailureBackoff.FailureThreshold = DefaultCompactionFailureThreshold
+	}
 }
 
 // DefaultEtcdCopyBackupsTaskConcurrentSyncs is the default number of concurrent syncs for the etcd copy backups task controller.
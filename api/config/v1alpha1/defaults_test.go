@@ -182,6 +182,14 @@ func TestSetDefaults_EtcdControllerConfiguration(t *testing.T) {
 					NotReadyThreshold: metav1.Duration{Duration: 5 * time.Minute},
 					UnknownThreshold:  metav1.Duration{Duration: 1 * time.Minute},
 				},
+				LeaderStability: LeaderStabilityConfiguration{
+					Window:           metav1.Duration{Duration: 1 * time.Hour},
+					MaxLeaderChanges: 3,
+				},
+				ResourceRecommendation: ResourceRecommendationConfiguration{
+					Interval: metav1.Duration{Duration: 5 * time.Minute},
+					HalfLife: metav1.Duration{Duration: 24 * time.Hour},
+				},
 			},
 		},
 		{
@@ -192,6 +200,16 @@ func TestSetDefaults_EtcdControllerConfiguration(t *testing.T) {
 				EtcdMember: EtcdMemberConfiguration{
 					NotReadyThreshold: metav1.Duration{Duration: 10 * time.Minute},
 				},
+				LeaderStability: LeaderStabilityConfiguration{
+					MaxLeaderChanges: 5,
+				},
+				ExtraChecks: ExtraChecksConfiguration{
+					Conditions: []ExtraCheck{{Name: "zone-spread"}, {Name: "backup-bucket-region", Timeout: &metav1.Duration{Duration: time.Minute}}},
+				},
+				ResourceRecommendation: ResourceRecommendationConfiguration{
+					Enabled:  true,
+					Interval: metav1.Duration{Duration: time.Minute},
+				},
 			},
 			expected: &EtcdControllerConfiguration{
 				ConcurrentSyncs:      ptr.To(5),
@@ -200,6 +218,21 @@ func TestSetDefaults_EtcdControllerConfiguration(t *testing.T) {
 					NotReadyThreshold: metav1.Duration{Duration: 10 * time.Minute},
 					UnknownThreshold:  metav1.Duration{Duration: 1 * time.Minute},
 				},
+				LeaderStability: LeaderStabilityConfiguration{
+					Window:           metav1.Duration{Duration: 1 * time.Hour},
+					MaxLeaderChanges: 5,
+				},
+				ExtraChecks: ExtraChecksConfiguration{
+					Conditions: []ExtraCheck{
+						{Name: "zone-spread", Timeout: &metav1.Duration{Duration: 30 * time.Second}},
+						{Name: "backup-bucket-region", Timeout: &metav1.Duration{Duration: time.Minute}},
+					},
+				},
+				ResourceRecommendation: ResourceRecommendationConfiguration{
+					Enabled:  true,
+					Interval: metav1.Duration{Duration: time.Minute},
+					HalfLife: metav1.Duration{Duration: 24 * time.Hour},
+				},
 			},
 		},
 	}
@@ -272,6 +305,11 @@ func TestSetDefaults_CompactionControllerConfiguration(t *testing.T) {
 				EventsThreshold:              1000000,
 				TriggerFullSnapshotThreshold: 3000000,
 				ActiveDeadlineDuration:       metav1.Duration{Duration: 3 * time.Hour},
+				FailureBackoff: CompactionFailureBackoffConfiguration{
+					InitialDelay:     metav1.Duration{Duration: 5 * time.Minute},
+					MaxDelay:         metav1.Duration{Duration: 2 * time.Hour},
+					FailureThreshold: 5,
+				},
 			},
 		},
 		{
@@ -281,6 +319,9 @@ func TestSetDefaults_CompactionControllerConfiguration(t *testing.T) {
 				ConcurrentSyncs:              ptr.To(5),
 				TriggerFullSnapshotThreshold: 2000000,
 				ActiveDeadlineDuration:       metav1.Duration{Duration: 1 * time.Hour},
+				FailureBackoff: CompactionFailureBackoffConfiguration{
+					FailureThreshold: 2,
+				},
 			},
 			expected: &CompactionControllerConfiguration{
 				Enabled:                      true,
@@ -288,6 +329,11 @@ func TestSetDefaults_CompactionControllerConfiguration(t *testing.T) {
 				EventsThreshold:              1000000,
 				TriggerFullSnapshotThreshold: 2000000,
 				ActiveDeadlineDuration:       metav1.Duration{Duration: 1 * time.Hour},
+				FailureBackoff: CompactionFailureBackoffConfiguration{
+					InitialDelay:     metav1.Duration{Duration: 5 * time.Minute},
+					MaxDelay:         metav1.Duration{Duration: 2 * time.Hour},
+					FailureThreshold: 2,
+				},
 			},
 		},
 	}
@@ -142,6 +142,53 @@ type EtcdControllerConfiguration struct {
 	EtcdStatusSyncPeriod metav1.Duration `json:"etcdStatusSyncPeriod"`
 	// EtcdMember holds configuration related to etcd members.
 	EtcdMember EtcdMemberConfiguration `json:"etcdMember"`
+	// LeaderStability holds configuration related to the tracking of leader changes of etcd clusters.
+	LeaderStability LeaderStabilityConfiguration `json:"leaderStability"`
+	// ExtraChecks enables checks which have been registered in addition to the built-in checks of etcd-druid. The
+	// enabled checks are executed whenever the status of an Etcd is updated.
+	// +optional
+	ExtraChecks ExtraChecksConfiguration `json:"extraChecks,omitempty"`
+	// ResourceRecommendation holds configuration related to the vertical resource recommendations for etcd members.
+	ResourceRecommendation ResourceRecommendationConfiguration `json:"resourceRecommendation"`
+}
+
+// ResourceRecommendationConfiguration holds configuration related to the vertical resource recommendations for etcd
+// members, which are derived from the resource usage reported by the metrics API and the database size of the members.
+type ResourceRecommendationConfiguration struct {
+	// Enabled specifies whether resource recommendations are computed and published in the status of the Etcd resources.
+	Enabled bool `json:"enabled"`
+	// Interval is the minimum duration between two samples of the resource usage of an etcd cluster.
+	Interval metav1.Duration `json:"interval"`
+	// HalfLife is the duration after which the weight of a peak resource usage in the recommendations has halved.
+	HalfLife metav1.Duration `json:"halfLife"`
+}
+
+// ExtraChecksConfiguration defines which of the additionally registered checks are enabled.
+type ExtraChecksConfiguration struct {
+	// Conditions are the enabled condition checks. Their results are merged into the conditions of the Etcd status.
+	// +optional
+	Conditions []ExtraCheck `json:"conditions,omitempty"`
+	// EtcdMembers are the enabled etcd member checks. Their results are merged into the members of the Etcd status.
+	// +optional
+	EtcdMembers []ExtraCheck `json:"etcdMembers,omitempty"`
+}
+
+// ExtraCheck enables an additionally registered check.
+type ExtraCheck struct {
+	// Name is the name under which the check has been registered.
+	Name string `json:"name"`
+	// Timeout is the duration after which the execution of the check is aborted.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// LeaderStabilityConfiguration holds configuration related to the tracking of leader changes of etcd clusters.
+type LeaderStabilityConfiguration struct {
+	// Window is the duration of the sliding window within which leader changes are counted.
+	Window metav1.Duration `json:"window"`
+	// MaxLeaderChanges is the maximum number of leader changes within the window up to which the leader of an etcd
+	// cluster is still considered stable.
+	MaxLeaderChanges int32 `json:"maxLeaderChanges"`
 }
 
 // EtcdMemberConfiguration holds configuration related to etcd members.
@@ -150,6 +197,10 @@ type EtcdMemberConfiguration struct {
 	NotReadyThreshold metav1.Duration `json:"notReadyThreshold"`
 	// UnknownThreshold is the duration after which an etcd member's state is considered `Unknown`.
 	UnknownThreshold metav1.Duration `json:"unknownThreshold"`
+	// RecommendDivergentMemberReplacement enables a warning event on the Etcd resource which recommends replacing a
+	// member, once the data of the member has been detected to diverge from the data of the other members.
+	// +optional
+	RecommendDivergentMemberReplacement bool `json:"recommendDivergentMemberReplacement,omitempty"`
 }
 
 // SecretControllerConfiguration defines the configuration for the Secret controller.
@@ -174,6 +225,21 @@ type CompactionControllerConfiguration struct {
 	ActiveDeadlineDuration metav1.Duration `json:"activeDeadlineDuration"`
 	// MetricsScrapeWaitDuration is the duration to wait for after compaction job is completed, to allow Prometheus metrics to be scraped
 	MetricsScrapeWaitDuration metav1.Duration `json:"metricsScrapeWaitDuration"`
+	// FailureBackoff defines how the compaction controller backs off after failed compaction jobs.
+	// +optional
+	FailureBackoff CompactionFailureBackoffConfiguration `json:"failureBackoff"`
+}
+
+// CompactionFailureBackoffConfiguration defines how the compaction controller backs off after failed compaction jobs.
+type CompactionFailureBackoffConfiguration struct {
+	// InitialDelay is the duration to wait before creating a new compaction job after the first failed compaction job.
+	// The delay is doubled for every further consecutive failure.
+	InitialDelay metav1.Duration `json:"initialDelay"`
+	// MaxDelay is the upper bound for the duration to wait before creating a new compaction job after failed compaction jobs.
+	MaxDelay metav1.Duration `json:"maxDelay"`
+	// FailureThreshold is the number of consecutive failed compaction jobs after which the compaction controller
+	// gives up on compaction jobs and triggers a full snapshot instead.
+	FailureThreshold int32 `json:"failureThreshold"`
 }
 
 // EtcdCopyBackupsTaskControllerConfiguration defines the configuration for the EtcdCopyBackupsTask controller.
@@ -84,6 +84,34 @@ func validateEtcdControllerConfiguration(etcdControllerConfig druidconfigv1alpha
 	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.EtcdStatusSyncPeriod, fldPath.Child("etcdStatusSyncPeriod"))...)
 	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.EtcdMember.NotReadyThreshold, fldPath.Child("etcdMember", "notReadyThreshold"))...)
 	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.EtcdMember.UnknownThreshold, fldPath.Child("etcdMember", "unknownThreshold"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.LeaderStability.Window, fldPath.Child("leaderStability", "window"))...)
+	if etcdControllerConfig.LeaderStability.MaxLeaderChanges <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("leaderStability", "maxLeaderChanges"), etcdControllerConfig.LeaderStability.MaxLeaderChanges, "must be greater than 0"))
+	}
+	allErrs = append(allErrs, validateExtraChecks(etcdControllerConfig.ExtraChecks.Conditions, fldPath.Child("extraChecks", "conditions"))...)
+	allErrs = append(allErrs, validateExtraChecks(etcdControllerConfig.ExtraChecks.EtcdMembers, fldPath.Child("extraChecks", "etcdMembers"))...)
+	if etcdControllerConfig.ResourceRecommendation.Enabled {
+		allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.ResourceRecommendation.Interval, fldPath.Child("resourceRecommendation", "interval"))...)
+		allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.ResourceRecommendation.HalfLife, fldPath.Child("resourceRecommendation", "halfLife"))...)
+	}
+	return allErrs
+}
+
+func validateExtraChecks(extraChecks []druidconfigv1alpha1.ExtraCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+	for i, extraCheck := range extraChecks {
+		idxPath := fldPath.Index(i)
+		if extraCheck.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else if names.Has(extraCheck.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), extraCheck.Name))
+		}
+		names.Insert(extraCheck.Name)
+		if extraCheck.Timeout != nil {
+			allErrs = append(allErrs, mustBeGreaterThanZeroDuration(*extraCheck.Timeout, idxPath.Child("timeout"))...)
+		}
+	}
 	return allErrs
 }
 
@@ -101,6 +129,20 @@ func validateCompactionControllerConfiguration(compactionControllerConfig druidc
 	if compactionControllerConfig.TriggerFullSnapshotThreshold <= 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("triggerFullSnapshotThreshold"), compactionControllerConfig.TriggerFullSnapshotThreshold, "must be greater than 0"))
 	}
+	allErrs = append(allErrs, validateCompactionFailureBackoffConfiguration(compactionControllerConfig.FailureBackoff, fldPath.Child("failureBackoff"))...)
+	return allErrs
+}
+
+func validateCompactionFailureBackoffConfiguration(failureBackoffConfig druidconfigv1alpha1.CompactionFailureBackoffConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(failureBackoffConfig.InitialDelay, fldPath.Child("initialDelay"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(failureBackoffConfig.MaxDelay, fldPath.Child("maxDelay"))...)
+	if failureBackoffConfig.MaxDelay.Duration < failureBackoffConfig.InitialDelay.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDelay"), failureBackoffConfig.MaxDelay, "must be greater than or equal to initialDelay"))
+	}
+	if failureBackoffConfig.FailureThreshold <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), failureBackoffConfig.FailureThreshold, "must be greater than 0"))
+	}
 	return allErrs
 }
 
@@ -232,13 +232,16 @@ func TestValidateLogConfiguration(t *testing.T) {
 
 func TestValidateEtcdControllerConfiguration(t *testing.T) {
 	tests := []struct {
-		name                 string
-		concurrentSync       *int
-		etcdStatusSyncPeriod *metav1.Duration
-		notReadyThreshold    *metav1.Duration
-		unknownThreshold     *metav1.Duration
-		expectedErrors       int
-		matcher              gomegatypes.GomegaMatcher
+		name                   string
+		concurrentSync         *int
+		etcdStatusSyncPeriod   *metav1.Duration
+		notReadyThreshold      *metav1.Duration
+		unknownThreshold       *metav1.Duration
+		leaderStability        *druidconfigv1alpha1.LeaderStabilityConfiguration
+		extraChecks            *druidconfigv1alpha1.ExtraChecksConfiguration
+		resourceRecommendation *druidconfigv1alpha1.ResourceRecommendationConfiguration
+		expectedErrors         int
+		matcher                gomegatypes.GomegaMatcher
 	}{
 		{
 			name:           "should allow default etcd controller configuration",
@@ -280,6 +283,50 @@ func TestValidateEtcdControllerConfiguration(t *testing.T) {
 			expectedErrors:   1,
 			matcher:          ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.etcdMember.unknownThreshold")}))),
 		},
+		{
+			name:            "should forbid leader stability window and max leader changes less than or equal to zero",
+			leaderStability: &druidconfigv1alpha1.LeaderStabilityConfiguration{MaxLeaderChanges: -1},
+			expectedErrors:  2,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.leaderStability.window")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.leaderStability.maxLeaderChanges")})),
+			),
+		},
+		{
+			name: "should allow extra checks with unique names",
+			extraChecks: &druidconfigv1alpha1.ExtraChecksConfiguration{
+				Conditions:  []druidconfigv1alpha1.ExtraCheck{{Name: "backup-bucket-region"}, {Name: "zone-spread", Timeout: &metav1.Duration{Duration: time.Minute}}},
+				EtcdMembers: []druidconfigv1alpha1.ExtraCheck{{Name: "zone-spread"}},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "should forbid extra checks without name, with duplicate names or with a timeout less than or equal to zero",
+			extraChecks: &druidconfigv1alpha1.ExtraChecksConfiguration{
+				Conditions:  []druidconfigv1alpha1.ExtraCheck{{Name: "zone-spread"}, {Name: "zone-spread"}},
+				EtcdMembers: []druidconfigv1alpha1.ExtraCheck{{Timeout: &metav1.Duration{}}},
+			},
+			expectedErrors: 3,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("controllers.etcd.extraChecks.conditions[1].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("controllers.etcd.extraChecks.etcdMembers[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.extraChecks.etcdMembers[0].timeout")})),
+			),
+		},
+		{
+			name:                   "should allow invalid resource recommendation durations if resource recommendations are disabled",
+			resourceRecommendation: &druidconfigv1alpha1.ResourceRecommendationConfiguration{},
+			expectedErrors:         0,
+		},
+		{
+			name:                   "should forbid resource recommendation durations less than or equal to zero if resource recommendations are enabled",
+			resourceRecommendation: &druidconfigv1alpha1.ResourceRecommendationConfiguration{Enabled: true, Interval: metav1.Duration{Duration: -time.Second}},
+			expectedErrors:         2,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.resourceRecommendation.interval")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.resourceRecommendation.halfLife")})),
+			),
+		},
 	}
 
 	fldPath := field.NewPath("controllers.etcd")
@@ -302,6 +349,15 @@ func TestValidateEtcdControllerConfiguration(t *testing.T) {
 			if test.unknownThreshold != nil {
 				etcdConfig.EtcdMember.UnknownThreshold = *test.unknownThreshold
 			}
+			if test.leaderStability != nil {
+				etcdConfig.LeaderStability = *test.leaderStability
+			}
+			if test.extraChecks != nil {
+				etcdConfig.ExtraChecks = *test.extraChecks
+			}
+			if test.resourceRecommendation != nil {
+				etcdConfig.ResourceRecommendation = *test.resourceRecommendation
+			}
 			actualErrList := validateEtcdControllerConfiguration(*etcdConfig, fldPath)
 			g.Expect(len(actualErrList)).To(Equal(test.expectedErrors))
 			if test.matcher != nil {
@@ -320,6 +376,7 @@ func TestValidateCompactionControllerConfiguration(t *testing.T) {
 		triggerFullSnapshotThreshold *int64
 		activeDeadlineDuration       *metav1.Duration
 		metricsScrapeWaitDuration    *metav1.Duration
+		failureBackoff               *druidconfigv1alpha1.CompactionFailureBackoffConfiguration
 		expectedErrors               int
 		matcher                      gomegatypes.GomegaMatcher
 	}{
@@ -397,6 +454,28 @@ func TestValidateCompactionControllerConfiguration(t *testing.T) {
 			expectedErrors:            1,
 			matcher:                   ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.metricsScrapeWaitDuration")}))),
 		},
+		{
+			name:    "should forbid failure backoff max delay less than initial delay",
+			enabled: true,
+			failureBackoff: &druidconfigv1alpha1.CompactionFailureBackoffConfiguration{
+				InitialDelay:     metav1.Duration{Duration: 10 * time.Minute},
+				MaxDelay:         metav1.Duration{Duration: time.Minute},
+				FailureThreshold: 3,
+			},
+			expectedErrors: 1,
+			matcher:        ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.failureBackoff.maxDelay")}))),
+		},
+		{
+			name:    "should forbid failure threshold less than zero",
+			enabled: true,
+			failureBackoff: &druidconfigv1alpha1.CompactionFailureBackoffConfiguration{
+				InitialDelay:     metav1.Duration{Duration: time.Minute},
+				MaxDelay:         metav1.Duration{Duration: time.Hour},
+				FailureThreshold: -1,
+			},
+			expectedErrors: 1,
+			matcher:        ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.failureBackoff.failureThreshold")}))),
+		},
 	}
 
 	fldPath := field.NewPath("controllers.compaction")
@@ -423,6 +502,9 @@ func TestValidateCompactionControllerConfiguration(t *testing.T) {
 			if test.metricsScrapeWaitDuration != nil {
 				controllerConfig.MetricsScrapeWaitDuration = *test.metricsScrapeWaitDuration
 			}
+			if test.failureBackoff != nil {
+				controllerConfig.FailureBackoff = *test.failureBackoff
+			}
 			actualErrList := validateCompactionControllerConfiguration(*controllerConfig, fldPath)
 			g.Expect(len(actualErrList)).To(Equal(test.expectedErrors))
 			if test.matcher != nil {
@@ -39,6 +39,7 @@ func (in *CompactionControllerConfiguration) DeepCopyInto(out *CompactionControl
 	}
 	out.ActiveDeadlineDuration = in.ActiveDeadlineDuration
 	out.MetricsScrapeWaitDuration = in.MetricsScrapeWaitDuration
+	out.FailureBackoff = in.FailureBackoff
 	return
 }
 
@@ -52,6 +53,24 @@ func (in *CompactionControllerConfiguration) DeepCopy() *CompactionControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactionFailureBackoffConfiguration) DeepCopyInto(out *CompactionFailureBackoffConfiguration) {
+	*out = *in
+	out.InitialDelay = in.InitialDelay
+	out.MaxDelay = in.MaxDelay
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactionFailureBackoffConfiguration.
+func (in *CompactionFailureBackoffConfiguration) DeepCopy() *CompactionFailureBackoffConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactionFailureBackoffConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -114,6 +133,9 @@ func (in *EtcdControllerConfiguration) DeepCopyInto(out *EtcdControllerConfigura
 	}
 	out.EtcdStatusSyncPeriod = in.EtcdStatusSyncPeriod
 	out.EtcdMember = in.EtcdMember
+	out.LeaderStability = in.LeaderStability
+	in.ExtraChecks.DeepCopyInto(&out.ExtraChecks)
+	out.ResourceRecommendation = in.ResourceRecommendation
 	return
 }
 
@@ -192,6 +214,57 @@ func (in *EtcdOpsTaskControllerConfiguration) DeepCopy() *EtcdOpsTaskControllerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtraCheck) DeepCopyInto(out *ExtraCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraCheck.
+func (in *ExtraCheck) DeepCopy() *ExtraCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtraCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtraChecksConfiguration) DeepCopyInto(out *ExtraChecksConfiguration) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ExtraCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EtcdMembers != nil {
+		in, out := &in.EtcdMembers, &out.EtcdMembers
+		*out = make([]ExtraCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraChecksConfiguration.
+func (in *ExtraChecksConfiguration) DeepCopy() *ExtraChecksConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtraChecksConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfiguration) DeepCopyInto(out *LeaderElectionConfiguration) {
 	*out = *in
@@ -211,6 +284,23 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaderStabilityConfiguration) DeepCopyInto(out *LeaderStabilityConfiguration) {
+	*out = *in
+	out.Window = in.Window
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderStabilityConfiguration.
+func (in *LeaderStabilityConfiguration) DeepCopy() *LeaderStabilityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LeaderStabilityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogConfiguration) DeepCopyInto(out *LogConfiguration) {
 	*out = *in
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// additionalConfigValueKind is the kind of value expected by an etcd configuration option.
+type additionalConfigValueKind int
+
+const (
+	additionalConfigValueString additionalConfigValueKind = iota
+	additionalConfigValueUint
+	additionalConfigValueBool
+	// additionalConfigValueDuration is a time.Duration, which etcd expects in nanoseconds.
+	additionalConfigValueDuration
+	additionalConfigValueStringList
+)
+
+// supportedAdditionalConfig contains the etcd configuration options which can be set via EtcdConfig.AdditionalConfig.
+// NOTE: The options are also listed in the CEL validation of EtcdConfig.AdditionalConfig, which is checked to be in
+// sync with this map by a unit test of the CRDs.
+var supportedAdditionalConfig = map[string]additionalConfigValueKind{
+	"max-request-bytes":                           additionalConfigValueUint,
+	"max-txn-ops":                                 additionalConfigValueUint,
+	"max-concurrent-streams":                      additionalConfigValueUint,
+	"max-snapshots":                               additionalConfigValueUint,
+	"max-wals":                                    additionalConfigValueUint,
+	"heartbeat-interval":                          additionalConfigValueUint,
+	"election-timeout":                            additionalConfigValueUint,
+	"pre-vote":                                    additionalConfigValueBool,
+	"strict-reconfig-check":                       additionalConfigValueBool,
+	"log-level":                                   additionalConfigValueString,
+	"cipher-suites":                               additionalConfigValueStringList,
+	"tls-min-version":                             additionalConfigValueString,
+	"tls-max-version":                             additionalConfigValueString,
+	"grpc-keepalive-min-time":                     additionalConfigValueDuration,
+	"grpc-keepalive-interval":                     additionalConfigValueDuration,
+	"grpc-keepalive-timeout":                      additionalConfigValueDuration,
+	"backend-batch-limit":                         additionalConfigValueUint,
+	"backend-batch-interval":                      additionalConfigValueDuration,
+	"experimental-compact-hash-check-enabled":     additionalConfigValueBool,
+	"experimental-compact-hash-check-time":        additionalConfigValueDuration,
+	"experimental-initial-corrupt-check":          additionalConfigValueBool,
+	"experimental-corrupt-check-time":             additionalConfigValueDuration,
+	"experimental-warning-apply-duration":         additionalConfigValueDuration,
+	"experimental-watch-progress-notify-interval": additionalConfigValueDuration,
+	"experimental-enable-lease-checkpoint":        additionalConfigValueBool,
+}
+
+// GetSupportedAdditionalConfigOptions returns the sorted names of the etcd configuration options which can be set via
+// EtcdConfig.AdditionalConfig.
+func GetSupportedAdditionalConfigOptions() []string {
+	options := make([]string, 0, len(supportedAdditionalConfig))
+	for option := range supportedAdditionalConfig {
+		options = append(options, option)
+	}
+	slices.Sort(options)
+	return options
+}
+
+// IsAdditionalConfigOptionSupported checks if the given etcd configuration option can be set via EtcdConfig.AdditionalConfig.
+func IsAdditionalConfigOptionSupported(option string) bool {
+	_, ok := supportedAdditionalConfig[option]
+	return ok
+}
+
+// ParseAdditionalConfigValue converts the given value of an etcd configuration option set via EtcdConfig.AdditionalConfig
+// to the type expected by etcd. An error is returned if the option is not supported or the value is invalid.
+func ParseAdditionalConfigValue(option, value string) (any, error) {
+	kind, ok := supportedAdditionalConfig[option]
+	if !ok {
+		return nil, fmt.Errorf("etcd configuration option %s is not supported", option)
+	}
+	switch kind {
+	case additionalConfigValueUint:
+		return strconv.ParseUint(value, 10, 64)
+	case additionalConfigValueBool:
+		return strconv.ParseBool(value)
+	case additionalConfigValueDuration:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return duration.Nanoseconds(), nil
+	case additionalConfigValueStringList:
+		values := strings.Split(value, ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		return values, nil
+	default:
+		return value, nil
+	}
+}
@@ -30,6 +30,20 @@ const (
 	// DisableEtcdComponentProtectionAnnotation is an annotation set by an operator to disable protection of components created for
 	// an etcd cluster and managed by etcd-druid.
 	DisableEtcdComponentProtectionAnnotation = "druid.gardener.cloud/disable-etcd-component-protection"
+	// IgnoreMaintenanceWindowAnnotation is an annotation set by an operator to carry out disruptive operations on an etcd
+	// cluster immediately, instead of deferring them until the maintenance window of the Etcd opens. It is removed by
+	// etcd-druid once the operations which are pending when it is set, i.e. the deferred operations and the reconciliation
+	// of the latest spec, have been carried out.
+	IgnoreMaintenanceWindowAnnotation = "druid.gardener.cloud/ignore-maintenance-window"
+	// ExpandedQuotaAnnotation is an annotation set by etcd-druid to record the quota of the backend database which has been
+	// raised by the automatic quota expansion. It is kept on the Etcd resource, so that the expanded quota survives the
+	// loss of the status. The expanded quota remains in effect after the automatic quota expansion has been disabled,
+	// it is dropped by removing the annotation.
+	ExpandedQuotaAnnotation = "druid.gardener.cloud/expanded-quota"
+	// AppliedResourceRecommendationAnnotation is an annotation set by etcd-druid to record the recommended resources which
+	// have been applied to the containers, encoded as JSON. It is kept on the Etcd resource, so that the applied resources
+	// survive the loss of the status.
+	AppliedResourceRecommendationAnnotation = "druid.gardener.cloud/applied-resource-recommendation"
 	// GardenerOperationAnnotation is an annotation set by an operator to specify the operation that is desired on an Etcd resource.
 	// Deprecated: Please use DruidOperationAnnotation instead.
 	GardenerOperationAnnotation = "gardener.cloud/operation"
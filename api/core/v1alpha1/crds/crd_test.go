@@ -5,8 +5,13 @@
 package crds
 
 import (
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
 	. "github.com/onsi/gomega"
 )
 
@@ -85,3 +90,17 @@ func TestGetAll(t *testing.T) {
 	}
 
 }
+
+func TestAdditionalConfigValidationRule(t *testing.T) {
+	g := NewWithT(t)
+	// The CEL rule is wrapped over multiple lines of the CRD, hence all whitespace is collapsed before matching it.
+	crd := strings.Join(strings.Fields(etcdCRD), " ")
+	matches := regexp.MustCompile(`rule: self\.all\(k, k in \[([^\]]*)\]\)`).FindStringSubmatch(crd)
+	g.Expect(matches).To(HaveLen(2))
+	var options []string
+	for _, option := range strings.Split(matches[1], ",") {
+		options = append(options, strings.Trim(strings.TrimSpace(option), "'"))
+	}
+	slices.Sort(options)
+	g.Expect(options).To(Equal(druidv1alpha1.GetSupportedAdditionalConfigOptions()))
+}
@@ -17,6 +17,8 @@ const (
 	GarbageCollectionPolicyExponential = "Exponential"
 	// GarbageCollectionPolicyLimitBased defines the limit based policy for garbage collecting old backups
 	GarbageCollectionPolicyLimitBased = "LimitBased"
+	// GarbageCollectionPolicyTiered defines the tiered policy for garbage collecting old backups
+	GarbageCollectionPolicyTiered = "Tiered"
 
 	// Basic is a constant for metrics level basic.
 	Basic MetricsLevel = "basic"
@@ -29,6 +31,10 @@ const (
 	LzwCompression CompressionPolicy = "lzw"
 	// ZlibCompression is constant for zlib compression policy.
 	ZlibCompression CompressionPolicy = "zlib"
+	// ZstdCompression is constant for zstd compression policy.
+	ZstdCompression CompressionPolicy = "zstd"
+	// Lz4Compression is constant for lz4 compression policy.
+	Lz4Compression CompressionPolicy = "lz4"
 
 	// DefaultCompression is constant for default compression policy(only if compression is enabled).
 	DefaultCompression = GzipCompression
@@ -80,11 +86,12 @@ type EtcdList struct {
 type MetricsLevel string
 
 // GarbageCollectionPolicy defines the type of policy for snapshot garbage collection.
-// +kubebuilder:validation:Enum=Exponential;LimitBased
+// +kubebuilder:validation:Enum=Exponential;LimitBased;Tiered
 type GarbageCollectionPolicy string
 
 // CompressionPolicy defines the type of policy for compression of snapshots.
-// +kubebuilder:validation:Enum=gzip;lzw;zlib
+// The zstd and lz4 policies require etcd-backup-restore v0.43.0 or later.
+// +kubebuilder:validation:Enum=gzip;lzw;zlib;zstd;lz4
 type CompressionPolicy string
 
 // CompactionMode defines the auto-compaction-mode: 'periodic' or 'revision'.
@@ -118,6 +125,57 @@ type CompressionSpec struct {
 	Policy *CompressionPolicy `json:"policy,omitempty"`
 }
 
+// GarbageCollectionTier defines how many full snapshots are retained at a given interval by the Tiered
+// GarbageCollectionPolicy. Of all full snapshots taken within one interval, only the latest one is retained.
+// +kubebuilder:validation:XValidation:message="exactly one of count and retentionPeriod must be set",rule="has(self.count) != has(self.retentionPeriod)"
+// +kubebuilder:validation:XValidation:message="interval must be greater than zero",rule="duration(self.interval) > duration('0s')"
+// +kubebuilder:validation:XValidation:message="retentionPeriod must not be less than interval",rule="!has(self.retentionPeriod) || duration(self.retentionPeriod) >= duration(self.interval)"
+type GarbageCollectionTier struct {
+	// Interval is the interval at which full snapshots are retained in this tier, e.g. `1h` for hourly snapshots.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	Interval metav1.Duration `json:"interval"`
+	// Count is the number of full snapshots which are retained in this tier.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int32 `json:"count,omitempty"`
+	// RetentionPeriod is the duration for which full snapshots are retained in this tier.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+}
+
+// EncryptionSpec defines the client-side encryption of snapshots before they are uploaded to the backup store.
+// +kubebuilder:validation:XValidation:message="exactly one of secretRef and kms must be set",rule="has(self.secretRef) != has(self.kms)"
+type EncryptionSpec struct {
+	// KeyID is the ID of the key which is used to encrypt new snapshots. The key ID is recorded with every snapshot,
+	// so that snapshots encrypted with previous keys can still be decrypted after the key has been rotated.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[-._a-zA-Z0-9]+$"
+	KeyID string `json:"keyID"`
+	// SecretRef references a secret in the namespace of the Etcd resource which contains the encryption keys. Every
+	// data key of the secret is a key ID and its value is the corresponding 256-bit AES key. Keys which have been used
+	// before must be retained as long as snapshots encrypted with them exist.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// KMS defines a key management service which holds the encryption keys.
+	// +optional
+	KMS *KMSSpec `json:"kms,omitempty"`
+}
+
+// KMSSpec defines a key management service which holds the keys used to encrypt snapshots.
+type KMSSpec struct {
+	// Endpoint is the URL of the key management service.
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+	// CredentialsSecretRef references a secret in the namespace of the Etcd resource which contains the credentials
+	// to access the key management service.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+}
+
 // LeaderElectionSpec defines parameters related to the LeaderElection configuration.
 type LeaderElectionSpec struct {
 	// ReelectionPeriod defines the Period after which leadership status of corresponding etcd is checked.
@@ -134,6 +192,8 @@ type LeaderElectionSpec struct {
 
 // BackupSpec defines parameters associated with the full and delta snapshots of etcd.
 // +kubebuilder:validation:XValidation:message="etcd.spec.backup.garbageCollectionPeriod must be greater than etcd.spec.backup.deltaSnapshotPeriod",rule="!(has(self.deltaSnapshotPeriod) && has(self.garbageCollectionPeriod)) || duration(self.deltaSnapshotPeriod).getSeconds() < duration(self.garbageCollectionPeriod).getSeconds()"
+// +kubebuilder:validation:XValidation:message="etcd.spec.backup.secondaryStores requires etcd.spec.backup.store to be set.",rule="!has(self.secondaryStores) || has(self.store)"
+// +kubebuilder:validation:XValidation:message="etcd.spec.backup.garbageCollectionTiers must be set if and only if etcd.spec.backup.garbageCollectionPolicy is Tiered.",rule="has(self.garbageCollectionTiers) == (has(self.garbageCollectionPolicy) && self.garbageCollectionPolicy == 'Tiered')"
 type BackupSpec struct {
 	// Port define the port on which etcd-backup-restore server will be exposed.
 	// +optional
@@ -146,6 +206,14 @@ type BackupSpec struct {
 	// Store defines the specification of object store provider for storing backups.
 	// +optional
 	Store *StoreSpec `json:"store,omitempty"`
+	// SecondaryStores defines backup stores to which snapshots are replicated by etcd-backup-restore in addition to
+	// Store. If Store is unavailable during a restoration, the secondary stores are used in the given order.
+	// They require etcd-backup-restore v0.43.0 or later, older versions do not replicate snapshots to them.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=3
+	SecondaryStores []SecondaryStoreSpec `json:"secondaryStores,omitempty"`
 	// Resources defines compute Resources required by backup-restore container.
 	// More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/
 	// +optional
@@ -158,12 +226,21 @@ type BackupSpec struct {
 	// +kubebuilder:validation:Pattern="^(\\*|[1-5]?[0-9]|[1-5]?[0-9]-[1-5]?[0-9]|(?:[1-9]|[1-4][0-9]|5[0-9])\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60)|\\*\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60))\\s+(\\*|[0-9]|1[0-9]|2[0-3]|[0-9]-(?:[0-9]|1[0-9]|2[0-3])|1[0-9]-(?:1[0-9]|2[0-3])|2[0-3]-2[0-3]|(?:[1-9]|1[0-9]|2[0-3])\\/(?:[1-9]|1[0-9]|2[0-4])|\\*\\/(?:[1-9]|1[0-9]|2[0-4]))\\s+(\\*|[1-9]|[12][0-9]|3[01]|[1-9]-(?:[1-9]|[12][0-9]|3[01])|[12][0-9]-(?:[12][0-9]|3[01])|3[01]-3[01]|(?:[1-9]|[12][0-9]|30)\\/(?:[1-9]|[12][0-9]|3[01])|\\*\\/(?:[1-9]|[12][0-9]|3[01]))\\s+(\\*|[1-9]|1[0-2]|[1-9]-(?:[1-9]|1[0-2])|1[0-2]-1[0-2]|(?:[1-9]|1[0-2])\\/(?:[1-9]|1[0-2])|\\*\\/(?:[1-9]|1[0-2]))\\s+(\\*|[1-7]|[1-6]-[1-7]|[1-6]\\/[1-7]|\\*\\/[1-7])$"
 	FullSnapshotSchedule *string `json:"fullSnapshotSchedule,omitempty"`
 	// GarbageCollectionPolicy defines the policy for garbage collecting old backups
+	// The Tiered policy requires etcd-backup-restore v0.43.0 or later.
 	// +optional
 	GarbageCollectionPolicy *GarbageCollectionPolicy `json:"garbageCollectionPolicy,omitempty"`
 	// MaxBackupsLimitBasedGC defines the maximum number of Full snapshots to retain in Limit Based GarbageCollectionPolicy
 	// All full snapshots beyond this limit will be garbage collected.
 	// +optional
 	MaxBackupsLimitBasedGC *int32 `json:"maxBackupsLimitBasedGC,omitempty"`
+	// GarbageCollectionTiers defines the tiers of full snapshots to retain in Tiered GarbageCollectionPolicy, ordered
+	// by ascending interval. A full snapshot is retained as long as it is retained by any of the tiers, all other full
+	// snapshots are garbage collected. Delta snapshots are retained according to DeltaSnapshotRetentionPeriod.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:XValidation:message="garbageCollectionTiers must be ordered by strictly ascending interval",rule="self.all(a, self.exists_one(b, duration(b.interval) == duration(a.interval))) && self.map(t, duration(t.interval)).isSorted()"
+	GarbageCollectionTiers []GarbageCollectionTier `json:"garbageCollectionTiers,omitempty"`
 	// GarbageCollectionPeriod defines the period for garbage collecting old backups
 	// +optional
 	// +kubebuilder:validation:Type=string
@@ -187,6 +264,11 @@ type BackupSpec struct {
 	// SnapshotCompression defines the specification for compression of Snapshots.
 	// +optional
 	SnapshotCompression *CompressionSpec `json:"compression,omitempty"`
+	// Encryption defines the client-side encryption of snapshots. It is used by etcd-backup-restore as well as by the
+	// snapshot compaction and copy backups jobs.
+	// It requires etcd-backup-restore v0.43.0 or later.
+	// +optional
+	Encryption *EncryptionSpec `json:"encryption,omitempty"`
 	// EnableProfiling defines if profiling should be enabled for the etcd-backup-restore-sidecar
 	// +optional
 	EnableProfiling *bool `json:"enableProfiling,omitempty"`
@@ -198,6 +280,63 @@ type BackupSpec struct {
 	// LeaderElection defines parameters related to the LeaderElection configuration.
 	// +optional
 	LeaderElection *LeaderElectionSpec `json:"leaderElection,omitempty"`
+	// Probes defines the liveness and startup probes of the backup-restore container.
+	// +optional
+	Probes *ContainerProbes `json:"probes,omitempty"`
+}
+
+// QuotaAutoExpansion defines the policy with which etcd-druid raises the quota of the backend database of etcd.
+type QuotaAutoExpansion struct {
+	// ThresholdPercent is the size of the backend database of a member, in percent of the quota, above which the quota
+	// is raised. Defaults to 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	ThresholdPercent *int32 `json:"thresholdPercent,omitempty"`
+	// Step is the amount by which the quota is raised at a time.
+	Step resource.Quantity `json:"step"`
+	// MaxQuota is the quota up to which the quota is raised. The quota is never raised beyond the capacity of the
+	// persistent volumes of the etcd members.
+	MaxQuota resource.Quantity `json:"maxQuota"`
+	// DisarmNoSpaceAlarm enables disarming the NOSPACE alarm once the backend databases of all members are below the
+	// quota again, e.g. after the quota has been raised or the backend databases have been defragmented. Defaults to true.
+	// +optional
+	DisarmNoSpaceAlarm *bool `json:"disarmNoSpaceAlarm,omitempty"`
+}
+
+// ContainerProbes defines the liveness and startup probes of a container managed by etcd-druid.
+// Probes are only configured if they are explicitly specified, an empty probe enables it with default thresholds.
+type ContainerProbes struct {
+	// Liveness enables a liveness probe for the container. Whenever a liveness probe is configured, a startup probe
+	// is configured as well, so that the liveness probe only takes effect once the container has started successfully.
+	// Defaults to a period of 10s and a failure threshold of 6.
+	// +optional
+	Liveness *ProbeThresholds `json:"liveness,omitempty"`
+	// Startup enables a startup probe for the container. As the startup of the etcd container includes restoring the
+	// data directory from the backup store, its failure threshold has to cover the longest expected restoration.
+	// Defaults to a period of 10s and a failure threshold of 8640, i.e. 24h.
+	// +optional
+	Startup *ProbeThresholds `json:"startup,omitempty"`
+}
+
+// ProbeThresholds defines the thresholds of a probe.
+type ProbeThresholds struct {
+	// InitialDelaySeconds is the number of seconds after the container has started before the probe is initiated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// PeriodSeconds defines how often (in seconds) to perform the probe.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// TimeoutSeconds is the number of seconds after which the probe times out. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// FailureThreshold is the number of consecutive failures after which the probe is considered failed.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
 }
 
 // SnapshotCompactionSpec defines parameters related to the compaction job configuration.
@@ -214,11 +353,86 @@ type SnapshotCompactionSpec struct {
 	TriggerFullSnapshotThreshold *int64 `json:"triggerFullSnapshotThreshold,omitempty"`
 }
 
+// EtcdAuth defines the users and roles of etcd's built-in authentication. Once specified, etcd-druid enables
+// authentication and reconciles the users and roles through the etcd auth API, users and roles which have been created
+// by etcd-druid and are no longer specified are removed. The root user is managed by etcd-druid and the common name
+// of the client certificate is granted the root role, so that etcd-druid's components can keep accessing etcd.
+type EtcdAuth struct {
+	// Roles defines the roles which are created in etcd.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Roles []EtcdAuthRole `json:"roles,omitempty"`
+	// Users defines the users which are created in etcd.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Users []EtcdAuthUser `json:"users,omitempty"`
+}
+
+// EtcdAuthRole defines a role which grants permissions on key prefixes.
+type EtcdAuthRole struct {
+	// Name is the name of the role.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:message="root role is managed by etcd-druid",rule="self != 'root'"
+	Name string `json:"name"`
+	// Permissions defines the permissions which are granted by the role.
+	// +optional
+	// +listType=map
+	// +listMapKey=keyPrefix
+	Permissions []EtcdAuthPermission `json:"permissions,omitempty"`
+}
+
+// EtcdAuthPermissionType defines the type of permission which is granted on a key prefix.
+// +kubebuilder:validation:Enum=Read;Write;ReadWrite
+type EtcdAuthPermissionType string
+
+const (
+	// EtcdAuthPermissionRead grants read access.
+	EtcdAuthPermissionRead EtcdAuthPermissionType = "Read"
+	// EtcdAuthPermissionWrite grants write access.
+	EtcdAuthPermissionWrite EtcdAuthPermissionType = "Write"
+	// EtcdAuthPermissionReadWrite grants read and write access.
+	EtcdAuthPermissionReadWrite EtcdAuthPermissionType = "ReadWrite"
+)
+
+// EtcdAuthPermission defines a permission on all keys with a given prefix.
+type EtcdAuthPermission struct {
+	// KeyPrefix is the prefix of the keys the permission is granted on.
+	// +kubebuilder:validation:MinLength=1
+	KeyPrefix string `json:"keyPrefix"`
+	// Type is the type of the permission.
+	Type EtcdAuthPermissionType `json:"type"`
+}
+
+// EtcdAuthUser defines a user which authenticates with a password or a client certificate.
+type EtcdAuthUser struct {
+	// Name is the name of the user.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:message="root user is managed by etcd-druid",rule="self != 'root'"
+	Name string `json:"name"`
+	// PasswordSecretRef references the key of a secret in the namespace of the Etcd resource which contains the
+	// password of the user. If it is not set, the user has no password and authenticates with a client certificate
+	// whose common name is the name of the user.
+	// +optional
+	PasswordSecretRef *corev1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+	// Roles are the names of the roles which are granted to the user.
+	// +optional
+	// +listType=set
+	Roles []string `json:"roles,omitempty"`
+}
+
 // EtcdConfig defines the configuration for the etcd cluster to be deployed.
+// +kubebuilder:validation:XValidation:message="etcd.spec.etcd.auth requires etcd.spec.etcd.clientUrlTls to be set.",rule="!has(self.auth) || has(self.clientUrlTls)"
 type EtcdConfig struct {
 	// Quota defines the etcd DB quota.
 	// +optional
 	Quota *resource.Quantity `json:"quota,omitempty"`
+	// QuotaAutoExpansion enables raising the quota of the backend database automatically, before the backend database
+	// of a member exceeds it. The raised quota is recorded in the status of the Etcd resource. Once disabled, the raised
+	// quota remains in effect as long as it is larger than spec.etcd.quota.
+	// +optional
+	QuotaAutoExpansion *QuotaAutoExpansion `json:"quotaAutoExpansion,omitempty"`
 	// SnapshotCount defines the number of applied Raft entries to hold in-memory before compaction.
 	// More info: https://etcd.io/docs/v3.5/op-guide/maintenance/#raft-log-retention
 	// +optional
@@ -226,7 +440,8 @@ type EtcdConfig struct {
 	// EnableGRPCGateway enables the gRPC-Gateway proxy for etcd.
 	// +optional
 	EnableGRPCGateway *bool `json:"enableGRPCGateway,omitempty"`
-	// DefragmentationSchedule defines the cron standard schedule for defragmentation of etcd.
+	// DefragmentationSchedule defines the cron standard schedule for defragmentation of etcd. It is not constrained by
+	// the MaintenanceWindow.
 	// +optional
 	// +kubebuilder:validation:Pattern="^(\\*|[1-5]?[0-9]|[1-5]?[0-9]-[1-5]?[0-9]|(?:[1-9]|[1-4][0-9]|5[0-9])\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60)|\\*\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60))\\s+(\\*|[0-9]|1[0-9]|2[0-3]|[0-9]-(?:[0-9]|1[0-9]|2[0-3])|1[0-9]-(?:1[0-9]|2[0-3])|2[0-3]-2[0-3]|(?:[1-9]|1[0-9]|2[0-3])\\/(?:[1-9]|1[0-9]|2[0-4])|\\*\\/(?:[1-9]|1[0-9]|2[0-4]))\\s+(\\*|[1-9]|[12][0-9]|3[01]|[1-9]-(?:[1-9]|[12][0-9]|3[01])|[12][0-9]-(?:[12][0-9]|3[01])|3[01]-3[01]|(?:[1-9]|[12][0-9]|30)\\/(?:[1-9]|[12][0-9]|3[01])|\\*\\/(?:[1-9]|[12][0-9]|3[01]))\\s+(\\*|[1-9]|1[0-2]|[1-9]-(?:[1-9]|1[0-2])|1[0-2]-1[0-2]|(?:[1-9]|1[0-2])\\/(?:[1-9]|1[0-2])|\\*\\/(?:[1-9]|1[0-2]))\\s+(\\*|[1-7]|[1-6]-[1-7]|[1-6]\\/[1-7]|\\*\\/[1-7])$"
 	DefragmentationSchedule *string `json:"defragmentationSchedule,omitempty"`
@@ -241,6 +456,10 @@ type EtcdConfig struct {
 	Image *string `json:"image,omitempty"`
 	// +optional
 	AuthSecretRef *corev1.SecretReference `json:"authSecretRef,omitempty"`
+	// Auth defines the users and roles of etcd's built-in authentication. It requires client TLS to be configured, as
+	// etcd-druid's components authenticate with the client certificate.
+	// +optional
+	Auth *EtcdAuth `json:"auth,omitempty"`
 	// Metrics defines the level of detail for exported metrics of etcd, specify 'extensive' to include histogram metrics.
 	// +optional
 	Metrics *MetricsLevel `json:"metrics,omitempty"`
@@ -268,6 +487,18 @@ type EtcdConfig struct {
 	// ClientService defines the parameters of the client service that a user can specify
 	// +optional
 	ClientService *ClientService `json:"clientService,omitempty"`
+	// Probes defines the liveness and startup probes of the etcd container.
+	// +optional
+	Probes *ContainerProbes `json:"probes,omitempty"`
+	// AdditionalConfig defines additional etcd configuration options which are merged into the etcd configuration
+	// generated by etcd-druid. Keys are the names of etcd configuration options (e.g. max-request-bytes), values are
+	// given as strings and are converted to the type of the option. Durations are specified as Go duration strings
+	// (e.g. 100ms), lists as comma separated values. Only a curated set of options is supported, options that are
+	// managed by etcd-druid cannot be overridden.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	// +kubebuilder:validation:XValidation:message="etcd.spec.etcd.additionalConfig contains an unsupported etcd configuration option.",rule="self.all(k, k in ['max-request-bytes', 'max-txn-ops', 'max-concurrent-streams', 'max-snapshots', 'max-wals', 'heartbeat-interval', 'election-timeout', 'pre-vote', 'strict-reconfig-check', 'log-level', 'cipher-suites', 'tls-min-version', 'tls-max-version', 'grpc-keepalive-min-time', 'grpc-keepalive-interval', 'grpc-keepalive-timeout', 'backend-batch-limit', 'backend-batch-interval', 'experimental-compact-hash-check-enabled', 'experimental-compact-hash-check-time', 'experimental-initial-corrupt-check', 'experimental-corrupt-check-time', 'experimental-warning-apply-duration', 'experimental-watch-progress-notify-interval', 'experimental-enable-lease-checkpoint'])"
+	AdditionalConfig map[string]string `json:"additionalConfig,omitempty"`
 }
 
 // ClientService defines the parameters of the client service that a user can specify
@@ -307,6 +538,118 @@ type SchedulingConstraints struct {
 	// that are honoured by the kube-scheduler.
 	// +optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// TopologyPolicy generates topology spread constraints which spread the etcd pods across nodes for the Zonal
+	// policy, and across zones and nodes for the MultiZonal policy. A constraint is not generated for a topology key
+	// for which TopologySpreadConstraints already contains a constraint.
+	// +optional
+	TopologyPolicy *TopologyPolicy `json:"topologyPolicy,omitempty"`
+}
+
+// TopologyPolicy defines how the etcd pods are spread across the topology of the cluster.
+// +kubebuilder:validation:Enum=Zonal;MultiZonal
+type TopologyPolicy string
+
+const (
+	// TopologyPolicyZonal spreads the etcd pods across the nodes of a single zone.
+	TopologyPolicyZonal TopologyPolicy = "Zonal"
+	// TopologyPolicyMultiZonal spreads the etcd pods across zones and across the nodes within each zone.
+	TopologyPolicyMultiZonal TopologyPolicy = "MultiZonal"
+)
+
+// PodTemplateOverrides defines customizations which are applied to the pod template of the etcd StatefulSet after it
+// has been built by etcd-druid. Fields which etcd-druid must own, such as the names of the containers, their ports, the
+// data volume and the TLS volume mounts, cannot be overridden.
+type PodTemplateOverrides struct {
+	// Tolerations are the tolerations of the etcd pods.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// NodeSelector is a selector which must match a node's labels for the etcd pods to be scheduled on that node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// RuntimeClassName is the name of the RuntimeClass which is used to run the etcd pods.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// SecurityContext overrides the fields of the security context of the etcd pods which are set in it. All other fields
+	// keep the values which are derived from RunAsRoot.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+	// AdditionalContainers are sidecar containers which are added to the etcd pods, e.g. log shippers. Their names and
+	// ports must not clash with the ones of the containers managed by etcd-druid.
+	// +optional
+	AdditionalContainers []AdditionalContainer `json:"additionalContainers,omitempty"`
+	// AdditionalVolumes are volumes which are added to the etcd pods. Their names must not clash with the ones of the
+	// volumes managed by etcd-druid, including the data volume.
+	// +optional
+	AdditionalVolumes []AdditionalVolume `json:"additionalVolumes,omitempty"`
+	// Containers defines customizations of the containers managed by etcd-druid.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Containers []ContainerOverrides `json:"containers,omitempty"`
+}
+
+// AdditionalContainer is a sidecar container which is added to the etcd pods. It supports the subset of the fields of a
+// container which sidecars such as log shippers typically require.
+type AdditionalContainer struct {
+	// Name is the name of the container.
+	Name string `json:"name"`
+	// Image is the image of the container.
+	Image string `json:"image"`
+	// ImagePullPolicy is the policy for pulling the image of the container.
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Command is the entrypoint of the container. The entrypoint of the image is used if it is not set.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// Args are the arguments to the entrypoint of the container.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Ports are the ports which are exposed by the container.
+	// +optional
+	Ports []corev1.ContainerPort `json:"ports,omitempty"`
+	// Env are the environment variables which are set in the container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Resources are the compute resources required by the container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// VolumeMounts are the volume mounts of the container. They must refer to volumes of the etcd pods.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+}
+
+// AdditionalVolume is a volume which is added to the etcd pods. Exactly one of its sources must be set.
+// +kubebuilder:validation:XValidation:message="exactly one of configMap, secret, emptyDir and persistentVolumeClaim must be set.",rule="[has(self.configMap), has(self.secret), has(self.emptyDir), has(self.persistentVolumeClaim)].filter(x, x).size() == 1"
+type AdditionalVolume struct {
+	// Name is the name of the volume.
+	Name string `json:"name"`
+	// ConfigMap is a ConfigMap which populates the volume.
+	// +optional
+	ConfigMap *corev1.ConfigMapVolumeSource `json:"configMap,omitempty"`
+	// Secret is a Secret which populates the volume.
+	// +optional
+	Secret *corev1.SecretVolumeSource `json:"secret,omitempty"`
+	// EmptyDir is a temporary directory which shares the lifetime of the pod.
+	// +optional
+	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+	// PersistentVolumeClaim is a reference to a PersistentVolumeClaim in the namespace of the Etcd.
+	// +optional
+	PersistentVolumeClaim *corev1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+}
+
+// ContainerOverrides defines customizations of a container managed by etcd-druid.
+type ContainerOverrides struct {
+	// Name is the name of the container which is customized.
+	// +kubebuilder:validation:Enum=etcd;backup-restore
+	Name string `json:"name"`
+	// Env are additional environment variables which are set in the container. Their names must not clash with the
+	// ones of the environment variables set by etcd-druid.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// VolumeMounts are additional volume mounts of the container. Their names and mount paths must not clash with the
+	// ones of the volume mounts managed by etcd-druid.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 }
 
 // EtcdSpec defines the desired state of Etcd
@@ -360,12 +703,75 @@ type EtcdSpec struct {
 	// run as root. By default, they run as non-root with user 'nobody'.
 	// +optional
 	RunAsRoot *bool `json:"runAsRoot,omitempty"`
+	// RollPodsOnSecretChange defines whether the etcd pods are restarted when the contents of a TLS or backup store
+	// secret referenced by the Etcd change. The pods are restarted one member at a time by a rolling update of the
+	// StatefulSet, which is deferred while another rollout of the StatefulSet is in progress. Defaults to false.
+	// +optional
+	RollPodsOnSecretChange *bool `json:"rollPodsOnSecretChange,omitempty"`
 	// ExternallyManagedMemberAddresses defines the list of addresses of externally managed etcd members. Specifying this
 	// will disable components that are involved in management of etcd members like Pods, Services and PDBs.
 	// Allowed values include: IPv4/IPv6 addresses and hostnames. Protocol or port shall not be specified.
 	// +optional
 	// +listType=set
 	ExternallyManagedMemberAddresses []string `json:"externallyManagedMemberAddresses,omitempty"`
+	// PodTemplateOverrides defines customizations of the pod template of the etcd StatefulSet.
+	// +optional
+	PodTemplateOverrides *PodTemplateOverrides `json:"podTemplateOverrides,omitempty"`
+	// ResourceRecommendation configures how the resources recommended by etcd-druid for the etcd and backup-restore
+	// containers are applied. The recommendations are published in the status whenever resource recommendations are
+	// enabled in the operator configuration.
+	// +optional
+	ResourceRecommendation *ResourceRecommendationSpec `json:"resourceRecommendation,omitempty"`
+	// MaintenanceWindow defines the recurring window in which disruptive operations are carried out on the etcd cluster.
+	// Spec changes which roll the pods of the StatefulSet, including certificate rotations and applied resource
+	// recommendations, and compaction jobs are deferred until the window opens. Deferred operations are listed in the
+	// status. If no maintenance window is defined, operations are never deferred. The defragmentation according to
+	// spec.etcd.defragmentationSchedule is not deferred, since it is triggered by the backup-restore sidecars on their own
+	// schedule. Choose a defragmentation schedule which falls into the window to defragment only during maintenance.
+	// Spec changes which raise the quota of the backend database, e.g. by the QuotaAutoExpansion, are not deferred, and are
+	// rolled out together with any other pending spec changes.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring window which opens according to a cron schedule and stays open for a duration.
+// +kubebuilder:validation:XValidation:message="duration must be greater than zero",rule="duration(self.duration) > duration('0s')"
+type MaintenanceWindow struct {
+	// Schedule is the cron standard schedule at which the maintenance window opens.
+	// +kubebuilder:validation:Pattern="^(\\*|[1-5]?[0-9]|[1-5]?[0-9]-[1-5]?[0-9]|(?:[1-9]|[1-4][0-9]|5[0-9])\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60)|\\*\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60))\\s+(\\*|[0-9]|1[0-9]|2[0-3]|[0-9]-(?:[0-9]|1[0-9]|2[0-3])|1[0-9]-(?:1[0-9]|2[0-3])|2[0-3]-2[0-3]|(?:[1-9]|1[0-9]|2[0-3])\\/(?:[1-9]|1[0-9]|2[0-4])|\\*\\/(?:[1-9]|1[0-9]|2[0-4]))\\s+(\\*|[1-9]|[12][0-9]|3[01]|[1-9]-(?:[1-9]|[12][0-9]|3[01])|[12][0-9]-(?:[12][0-9]|3[01])|3[01]-3[01]|(?:[1-9]|[12][0-9]|30)\\/(?:[1-9]|[12][0-9]|3[01])|\\*\\/(?:[1-9]|[12][0-9]|3[01]))\\s+(\\*|[1-9]|1[0-2]|[1-9]-(?:[1-9]|1[0-2])|1[0-2]-1[0-2]|(?:[1-9]|1[0-2])\\/(?:[1-9]|1[0-2])|\\*\\/(?:[1-9]|1[0-2]))\\s+(\\*|[1-7]|[1-6]-[1-7]|[1-6]\\/[1-7]|\\*\\/[1-7])$"
+	Schedule string `json:"schedule"`
+	// Duration is the duration for which the maintenance window stays open.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ResourceRecommendationSpec configures how the resources recommended by etcd-druid are applied.
+type ResourceRecommendationSpec struct {
+	// Apply defines whether the recommended resources are applied to the etcd and backup-restore containers in place of
+	// the resources configured in the spec. The recommendations are only applied while all members are ready and, if a
+	// MaintenanceWindow is defined, while the maintenance window is open. Defaults to false.
+	// +optional
+	Apply *bool `json:"apply,omitempty"`
+	// Etcd defines the bounds within which the recommended resources of the etcd container are applied.
+	// +optional
+	Etcd *ResourceBounds `json:"etcd,omitempty"`
+	// BackupRestore defines the bounds within which the recommended resources of the backup-restore container are applied.
+	// +optional
+	BackupRestore *ResourceBounds `json:"backupRestore,omitempty"`
+}
+
+// ResourceBounds defines the minimum and maximum resources which are applied to a container.
+// +kubebuilder:validation:XValidation:message="minAllowed must not be greater than maxAllowed",rule="!has(self.minAllowed) || !has(self.maxAllowed) || self.minAllowed.all(k, !(k in self.maxAllowed) || quantity(string(self.minAllowed[k])).compareTo(quantity(string(self.maxAllowed[k]))) <= 0)"
+type ResourceBounds struct {
+	// MinAllowed is the minimum amount of resources which is applied to the container.
+	// +kubebuilder:validation:MaxProperties=16
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// MaxAllowed is the maximum amount of resources which is applied to the container.
+	// +kubebuilder:validation:MaxProperties=16
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
 }
 
 // CrossVersionObjectReference contains enough information to let you identify the referred resource.
@@ -395,10 +801,31 @@ const (
 	ConditionTypeAllMembersUpdated ConditionType = "AllMembersUpdated"
 	// ConditionTypeBackupReady is a constant for a condition type indicating that the etcd backup is ready.
 	ConditionTypeBackupReady ConditionType = "BackupReady"
+	// ConditionTypeBackupReplicated is a constant for a condition type indicating that snapshots are replicated to a
+	// secondary backup store. It is only used in the status of the secondary stores.
+	ConditionTypeBackupReplicated ConditionType = "BackupReplicated"
 	// ConditionTypeDataVolumesReady is a constant for a condition type indicating that the etcd data volumes are ready.
 	ConditionTypeDataVolumesReady ConditionType = "DataVolumesReady"
 	// ConditionTypeClusterIDMismatch is a constant for a condition type indicating that the etcd cluster has multiple cluster IDs.
 	ConditionTypeClusterIDMismatch ConditionType = "ClusterIDMismatch"
+	// ConditionTypeSnapshotCompactionBackoff is a constant for a condition type indicating that the creation of new compaction jobs
+	// is being delayed because of consecutive compaction job failures.
+	ConditionTypeSnapshotCompactionBackoff ConditionType = "SnapshotCompactionBackoff"
+	// ConditionTypeQuotaHealthy is a constant for a condition type indicating that the backend database of no etcd member
+	// is close to exceeding its quota and that no alarm, such as the NOSPACE alarm, has been raised in the etcd cluster.
+	ConditionTypeQuotaHealthy ConditionType = "QuotaHealthy"
+	// ConditionTypeDataConsistent is a constant for a condition type indicating that the key-value stores of all etcd
+	// members have the same hash at a common revision, i.e. that the data of no member has silently diverged.
+	ConditionTypeDataConsistent ConditionType = "DataConsistent"
+	// ConditionTypeMembersInSync is a constant for a condition type indicating that the raft applied index of no etcd
+	// member lags behind the raft committed index of the leader by more than a threshold.
+	ConditionTypeMembersInSync ConditionType = "MembersInSync"
+	// ConditionTypeLeaderStable is a constant for a condition type indicating that the leader of the etcd cluster has
+	// not changed more often than the configured maximum within the configured window.
+	ConditionTypeLeaderStable ConditionType = "LeaderStable"
+	// ConditionTypeTopologySpreadSatisfied is a constant for a condition type indicating that the etcd members are
+	// spread across zones such that the failure of a single zone does not break the quorum of the etcd cluster.
+	ConditionTypeTopologySpreadSatisfied ConditionType = "TopologySpreadSatisfied"
 )
 
 // EtcdMemberConditionStatus is the status of an etcd cluster member.
@@ -421,6 +848,9 @@ const (
 	EtcdRoleLeader EtcdRole = "Leader"
 	// EtcdRoleMember describes the etcd role `Member`.
 	EtcdRoleMember EtcdRole = "Member"
+	// EtcdRoleLearner describes the etcd role `Learner`. A learner is a non-voting member which replicates the data of
+	// the leader until it has caught up and is promoted to a voting member.
+	EtcdRoleLearner EtcdRole = "Learner"
 )
 
 // EtcdMemberStatus holds information about etcd cluster membership.
@@ -430,7 +860,7 @@ type EtcdMemberStatus struct {
 	// ID is the ID of the etcd member.
 	// +optional
 	ID *string `json:"id,omitempty"`
-	// Role is the role in the etcd cluster, either `Leader` or `Member`.
+	// Role is the role in the etcd cluster, either `Leader`, `Member` or `Learner`.
 	// +optional
 	Role *EtcdRole `json:"role,omitempty"`
 	// Status of the condition, one of True, False, Unknown.
@@ -439,6 +869,28 @@ type EtcdMemberStatus struct {
 	Reason string `json:"reason"`
 	// LastTransitionTime is the last time the condition's status changed.
 	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// DBSize is the size of the backend database of the etcd member, as reported by etcd.
+	// +optional
+	DBSize *resource.Quantity `json:"dbSize,omitempty"`
+	// DBSizeInUse is the size of the backend database of the etcd member which is logically in use, as reported by etcd.
+	// The difference to DBSize can be reclaimed by defragmenting the backend database.
+	// +optional
+	DBSizeInUse *resource.Quantity `json:"dbSizeInUse,omitempty"`
+	// EtcdVersion is the version of etcd which the etcd member is running, as reported by etcd.
+	// +optional
+	EtcdVersion *string `json:"etcdVersion,omitempty"`
+	// RaftTerm is the current raft term of the etcd member, as reported by etcd.
+	// +optional
+	RaftTerm *int64 `json:"raftTerm,omitempty"`
+	// RaftIndex is the current raft committed index of the etcd member, as reported by etcd.
+	// +optional
+	RaftIndex *int64 `json:"raftIndex,omitempty"`
+	// RaftAppliedIndex is the current raft applied index of the etcd member, as reported by etcd.
+	// +optional
+	RaftAppliedIndex *int64 `json:"raftAppliedIndex,omitempty"`
+	// Revision is the current revision of the key-value store of the etcd member, as reported by etcd.
+	// +optional
+	Revision *int64 `json:"revision,omitempty"`
 }
 
 // EtcdStatus defines the observed state of Etcd.
@@ -484,6 +936,255 @@ type EtcdStatus struct {
 	// It must match the pod template's labels.
 	// +optional
 	Selector *string `json:"selector,omitempty"`
+	// SnapshotCompaction captures the state of snapshot compaction for the etcd cluster.
+	// +optional
+	SnapshotCompaction *SnapshotCompactionStatus `json:"snapshotCompaction,omitempty"`
+	// BackupEncryptionKeyIDs are the IDs of all keys which have been configured to encrypt snapshots. Snapshots in the
+	// backup store may be encrypted with any of these keys, therefore all of them are required for a restoration.
+	// The key IDs are kept if encryption is disabled and are only cleared if the backup store is disabled.
+	// +optional
+	// +listType=set
+	BackupEncryptionKeyIDs []string `json:"backupEncryptionKeyIDs,omitempty"`
+	// SecondaryStores captures the state of the replication of snapshots to the secondary backup stores.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	SecondaryStores []SecondaryStoreStatus `json:"secondaryStores,omitempty"`
+	// GarbageCollection captures the full snapshots retained by the Tiered GarbageCollectionPolicy.
+	// +optional
+	GarbageCollection *GarbageCollectionStatus `json:"garbageCollection,omitempty"`
+	// SnapshotCatalog lists the latest full snapshot and the delta snapshots taken after it, as last reported by
+	// etcd-backup-restore. It describes the snapshots from which the etcd cluster can currently be restored.
+	// +optional
+	SnapshotCatalog *SnapshotCatalog `json:"snapshotCatalog,omitempty"`
+	// ExpandedQuota is the quota of the backend database as raised by etcd-druid according to spec.etcd.quotaAutoExpansion.
+	// It mirrors the druid.gardener.cloud/expanded-quota annotation, which is the source of truth for the expanded quota.
+	// It is kept once spec.etcd.quotaAutoExpansion has been removed, until the annotation is removed.
+	// +optional
+	ExpandedQuota *resource.Quantity `json:"expandedQuota,omitempty"`
+	// LeaderElection captures the changes of the leader of the etcd cluster.
+	// +optional
+	LeaderElection *LeaderElectionStatus `json:"leaderElection,omitempty"`
+	// ResourceRecommendation contains the resources recommended by etcd-druid for the etcd and backup-restore containers.
+	// +optional
+	ResourceRecommendation *ResourceRecommendationStatus `json:"resourceRecommendation,omitempty"`
+	// DeferredOperations are the disruptive operations which have been deferred until the maintenance window opens.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	DeferredOperations []DeferredOperation `json:"deferredOperations,omitempty"`
+}
+
+// DeferredOperationType is the type of operation which is deferred until the maintenance window opens.
+// +kubebuilder:validation:Enum=RollingUpdate;CertificateRotation;Compaction
+type DeferredOperationType string
+
+const (
+	// DeferredOperationTypeRollingUpdate is a spec change which rolls the pods of the StatefulSet.
+	DeferredOperationTypeRollingUpdate DeferredOperationType = "RollingUpdate"
+	// DeferredOperationTypeCertificateRotation is a change of the referenced secrets which rolls the pods of the StatefulSet.
+	DeferredOperationTypeCertificateRotation DeferredOperationType = "CertificateRotation"
+	// DeferredOperationTypeCompaction is a snapshot compaction job.
+	DeferredOperationTypeCompaction DeferredOperationType = "Compaction"
+)
+
+// DeferredOperation is a disruptive operation which is deferred until the maintenance window opens.
+type DeferredOperation struct {
+	// Type is the type of the deferred operation.
+	Type DeferredOperationType `json:"type"`
+	// Description describes the deferred operation.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// DeferredSince is the time since which the operation is deferred.
+	DeferredSince metav1.Time `json:"deferredSince"`
+	// ScheduledTime is the time at which the maintenance window opens next and the operation is carried out.
+	// +optional
+	ScheduledTime *metav1.Time `json:"scheduledTime,omitempty"`
+}
+
+// ResourceRecommendationStatus contains the resources recommended for the etcd and backup-restore containers, derived
+// from the resource usage and the database size trend of the members.
+type ResourceRecommendationStatus struct {
+	// Etcd are the recommended resources of the etcd container.
+	// +optional
+	Etcd *ContainerResources `json:"etcd,omitempty"`
+	// BackupRestore are the recommended resources of the backup-restore container.
+	// +optional
+	BackupRestore *ContainerResources `json:"backupRestore,omitempty"`
+	// Members contains the last observed resource usage and database size trend of each member.
+	// +optional
+	Members []MemberResourceUsage `json:"members,omitempty"`
+	// LastUpdateTime is the time at which the recommendations have last been updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Applied contains the recommended resources which are currently applied to the containers. It mirrors the
+	// druid.gardener.cloud/applied-resource-recommendation annotation, which is the source of truth for the applied
+	// resources.
+	// +optional
+	Applied *AppliedResourceRecommendation `json:"applied,omitempty"`
+}
+
+// ContainerResources are the resource requests and limits of a container.
+type ContainerResources struct {
+	// Requests are the resource requests of the container.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Limits are the resource limits of the container.
+	// +optional
+	Limits corev1.ResourceList `json:"limits,omitempty"`
+}
+
+// MemberResourceUsage is the resource usage and database size trend of an etcd member.
+type MemberResourceUsage struct {
+	// Name is the name of the etcd member.
+	Name string `json:"name"`
+	// Etcd is the resource usage of the etcd container.
+	// +optional
+	Etcd corev1.ResourceList `json:"etcd,omitempty"`
+	// BackupRestore is the resource usage of the backup-restore container.
+	// +optional
+	BackupRestore corev1.ResourceList `json:"backupRestore,omitempty"`
+	// DBSize is the size of the backend database of the etcd member.
+	// +optional
+	DBSize *resource.Quantity `json:"dbSize,omitempty"`
+	// DBSizeGrowthPerDay is the smoothed growth of the backend database of the etcd member per day.
+	// +optional
+	DBSizeGrowthPerDay *resource.Quantity `json:"dbSizeGrowthPerDay,omitempty"`
+}
+
+// AppliedResourceRecommendation contains the recommended resources which are applied to the containers.
+type AppliedResourceRecommendation struct {
+	// Etcd are the resources applied to the etcd container.
+	// +optional
+	Etcd *ContainerResources `json:"etcd,omitempty"`
+	// BackupRestore are the resources applied to the backup-restore container.
+	// +optional
+	BackupRestore *ContainerResources `json:"backupRestore,omitempty"`
+	// LastApplyTime is the time at which the recommendations have last been applied.
+	LastApplyTime metav1.Time `json:"lastApplyTime"`
+}
+
+// LeaderElectionStatus captures the changes of the leader of the etcd cluster within a sliding window.
+type LeaderElectionStatus struct {
+	// Leader is the name of the etcd member which has last been observed as the leader.
+	// +optional
+	Leader string `json:"leader,omitempty"`
+	// LastLeaderChangeTime is the time at which a change of the leader has last been observed.
+	// +optional
+	LastLeaderChangeTime *metav1.Time `json:"lastLeaderChangeTime,omitempty"`
+	// LeaderChangeCount is the number of leader changes which have been observed within the sliding window.
+	// +optional
+	LeaderChangeCount int32 `json:"leaderChangeCount,omitempty"`
+	// LeaderChangeTimes are the times at which the leader changes within the sliding window have been observed.
+	// +optional
+	LeaderChangeTimes []metav1.Time `json:"leaderChangeTimes,omitempty"`
+	// RaftTerm is the raft term in which the leader has last been observed.
+	// +optional
+	RaftTerm *int64 `json:"raftTerm,omitempty"`
+}
+
+// SnapshotCompactionFailureClass classifies the failure of a compaction job.
+type SnapshotCompactionFailureClass string
+
+const (
+	// SnapshotCompactionFailureClassDisruption indicates that the compaction pod was disrupted, e.g. by a preemption or an eviction.
+	// Such failures do not count towards the consecutive failures of compaction jobs.
+	SnapshotCompactionFailureClassDisruption SnapshotCompactionFailureClass = "Disruption"
+	// SnapshotCompactionFailureClassDeadlineExceeded indicates that the compaction job did not complete within its active deadline.
+	SnapshotCompactionFailureClassDeadlineExceeded SnapshotCompactionFailureClass = "DeadlineExceeded"
+	// SnapshotCompactionFailureClassProcessFailure indicates that the compaction process itself failed.
+	SnapshotCompactionFailureClassProcessFailure SnapshotCompactionFailureClass = "ProcessFailure"
+	// SnapshotCompactionFailureClassUnknown indicates that the cause of the failure could not be determined.
+	SnapshotCompactionFailureClassUnknown SnapshotCompactionFailureClass = "Unknown"
+)
+
+// SnapshotCompactionFailure holds information about a failed compaction job.
+type SnapshotCompactionFailure struct {
+	// Class is the classification of the failure.
+	Class SnapshotCompactionFailureClass `json:"class"`
+	// Reason is the reason for the failure of the compaction job.
+	Reason string `json:"reason"`
+	// ObservedAt is the time at which the failure was observed.
+	ObservedAt metav1.Time `json:"observedAt"`
+}
+
+// GarbageCollectionStatus captures the full snapshots retained by the Tiered GarbageCollectionPolicy, as last
+// reported by etcd-backup-restore.
+type GarbageCollectionStatus struct {
+	// Tiers contains the number of retained full snapshots for each of the configured tiers.
+	// +optional
+	Tiers []GarbageCollectionTierStatus `json:"tiers,omitempty"`
+	// LastUpdateTime is the time at which the number of retained full snapshots has last been updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// GarbageCollectionTierStatus captures the number of full snapshots retained in a garbage collection tier.
+type GarbageCollectionTierStatus struct {
+	// Interval is the interval of the tier.
+	Interval metav1.Duration `json:"interval"`
+	// RetainedSnapshots is the number of full snapshots which are retained in the tier.
+	RetainedSnapshots int32 `json:"retainedSnapshots"`
+}
+
+// SnapshotCatalog lists the latest snapshots in the backup store of an etcd cluster.
+type SnapshotCatalog struct {
+	// FullSnapshot is the latest full snapshot.
+	// +optional
+	FullSnapshot *SnapshotInfo `json:"fullSnapshot,omitempty"`
+	// DeltaSnapshots are the most recent delta snapshots taken after FullSnapshot, ordered from oldest to newest.
+	// +optional
+	// +listType=atomic
+	DeltaSnapshots []SnapshotInfo `json:"deltaSnapshots,omitempty"`
+	// LastUpdateTime is the time at which the catalog has last been updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// SnapshotInfo describes a snapshot in the backup store.
+type SnapshotInfo struct {
+	// Name is the name of the snapshot in the backup store.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// StartRevision is the first etcd revision contained in the snapshot.
+	// +optional
+	StartRevision int64 `json:"startRevision,omitempty"`
+	// LastRevision is the last etcd revision contained in the snapshot.
+	LastRevision int64 `json:"lastRevision"`
+	// CreatedOn is the time at which the snapshot was taken.
+	CreatedOn metav1.Time `json:"createdOn"`
+	// SizeBytes is the size of the snapshot in the backup store in bytes.
+	// +optional
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	// CompressionPolicy is the policy with which the snapshot has been compressed, if it is compressed.
+	// +optional
+	CompressionPolicy *CompressionPolicy `json:"compressionPolicy,omitempty"`
+}
+
+// SecondaryStoreStatus captures the state of the replication of snapshots to a secondary backup store.
+type SecondaryStoreStatus struct {
+	// Name is the name of the secondary store.
+	Name string `json:"name"`
+	// Condition indicates whether snapshots are replicated to the secondary store in time. Its type is always
+	// `BackupReplicated`.
+	Condition Condition `json:"condition"`
+	// LastReplicationTime is the time at which a snapshot has last been replicated to the secondary store.
+	// +optional
+	LastReplicationTime *metav1.Time `json:"lastReplicationTime,omitempty"`
+}
+
+// SnapshotCompactionStatus captures the state of snapshot compaction for an etcd cluster.
+type SnapshotCompactionStatus struct {
+	// ConsecutiveFailures is the number of compaction jobs that have failed in succession since the last
+	// successful compaction job or full snapshot. Failures caused by disruptions are not counted.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// NextAttemptTime is the earliest time at which the next compaction job will be created.
+	// +optional
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+	// RecentFailures holds the most recent compaction job failures, ordered from oldest to newest.
+	// +optional
+	RecentFailures []SnapshotCompactionFailure `json:"recentFailures,omitempty"`
 }
 
 const (
@@ -493,6 +1194,11 @@ const (
 	LastOperationTypeReconcile druidapicommon.LastOperationType = "Reconcile"
 	// LastOperationTypeDelete indicates that the last operation was a deletion of an existing Etcd resource.
 	LastOperationTypeDelete druidapicommon.LastOperationType = "Delete"
+	// LastOperationTypeQuotaExpansion indicates that the last operation was an automatic expansion of the quota of the
+	// backend database of etcd.
+	LastOperationTypeQuotaExpansion druidapicommon.LastOperationType = "QuotaExpansion"
+	// LastOperationTypeAlarmDisarm indicates that the last operation was an automatic disarming of the NOSPACE alarm of etcd.
+	LastOperationTypeAlarmDisarm druidapicommon.LastOperationType = "AlarmDisarm"
 )
 
 const (
@@ -7,6 +7,9 @@ package v1alpha1
 import (
 	"fmt"
 
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,14 +39,44 @@ type EtcdCopyBackupsTaskList struct {
 }
 
 // EtcdCopyBackupsTaskSpec defines the parameters for the copy backups task.
+// +kubebuilder:validation:XValidation:message="spec.waitForFinalSnapshot cannot be enabled when spec.schedule is set.",rule="!has(self.schedule) || !has(self.waitForFinalSnapshot) || !self.waitForFinalSnapshot.enabled"
+// +kubebuilder:validation:XValidation:message="exactly one of spec.sourceStore and spec.sourceEtcdRef must be set.",rule="has(self.sourceStore) != has(self.sourceEtcdRef)"
+// +kubebuilder:validation:XValidation:message="exactly one of spec.targetStore and spec.targetEtcdRef must be set.",rule="has(self.targetStore) != has(self.targetEtcdRef)"
+// +kubebuilder:validation:XValidation:message="spec.sourceStore and spec.sourceEtcdRef cannot be interchanged after creation.",rule="has(self.sourceEtcdRef) == has(oldSelf.sourceEtcdRef)"
+// +kubebuilder:validation:XValidation:message="spec.targetStore and spec.targetEtcdRef cannot be interchanged after creation.",rule="has(self.targetEtcdRef) == has(oldSelf.targetEtcdRef)"
+// +kubebuilder:validation:XValidation:message="spec.triggerTargetEtcdReconcile can only be enabled when spec.targetEtcdRef is set and spec.schedule is not set.",rule="!has(self.triggerTargetEtcdReconcile) || !self.triggerTargetEtcdReconcile || (has(self.targetEtcdRef) && !has(self.schedule))"
 type EtcdCopyBackupsTaskSpec struct {
 	// PodLabels is a set of labels that will be added to pod(s) created by the copy backups task.
 	// +optional
 	PodLabels map[string]string `json:"podLabels,omitempty"`
+	// ServiceAccountName is the name of the ServiceAccount the pod(s) created by the copy backups task run as.
+	// It needs to be set if the source or target store uses workload identity.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 	// SourceStore defines the specification of the source object store provider for storing backups.
-	SourceStore StoreSpec `json:"sourceStore"`
+	// It is mutually exclusive with SourceEtcdRef.
+	// +optional
+	SourceStore StoreSpec `json:"sourceStore,omitzero"`
 	// TargetStore defines the specification of the target object store provider for storing backups.
-	TargetStore StoreSpec `json:"targetStore"`
+	// It is mutually exclusive with TargetEtcdRef.
+	// +optional
+	TargetStore StoreSpec `json:"targetStore,omitzero"`
+	// SourceEtcdRef references an Etcd resource in the namespace of the task whose backup store is used as the source store.
+	// It is mutually exclusive with SourceStore.
+	// +optional
+	SourceEtcdRef *corev1.LocalObjectReference `json:"sourceEtcdRef,omitempty"`
+	// TargetEtcdRef references an Etcd resource in the namespace of the task whose backup store is used as the target store.
+	// Backups are only copied if the referenced Etcd is either hibernated, i.e. it has been scaled down to zero replicas,
+	// or not yet started, i.e. its spec reconciliation is suspended and none of its members are running.
+	// It is mutually exclusive with TargetStore.
+	// +optional
+	TargetEtcdRef *corev1.LocalObjectReference `json:"targetEtcdRef,omitempty"`
+	// TriggerTargetEtcdReconcile specifies whether the Etcd referenced by TargetEtcdRef is annotated for reconciliation once
+	// backups have been copied successfully. While its spec reconciliation is suspended, the annotation is not added and
+	// the TargetEtcdReconcileTriggered condition is set to false.
+	// It can only be enabled together with TargetEtcdRef and not for recurring tasks.
+	// +optional
+	TriggerTargetEtcdReconcile bool `json:"triggerTargetEtcdReconcile,omitempty"`
 	// MaxBackupAge is the maximum age in days that a backup must have in order to be copied.
 	// By default, all backups will be copied.
 	// +optional
@@ -56,6 +89,12 @@ type EtcdCopyBackupsTaskSpec struct {
 	// WaitForFinalSnapshot defines the parameters for waiting for a final full snapshot before copying backups.
 	// +optional
 	WaitForFinalSnapshot *WaitForFinalSnapshotSpec `json:"waitForFinalSnapshot,omitempty"`
+	// Schedule defines the cron standard schedule for copying backups. If set, the task is recurring and a copy job
+	// is created for every scheduled run. Only the backups taken on the days since the last successful run are copied,
+	// as older backups have already been copied. If not set, backups are copied only once.
+	// +optional
+	// +kubebuilder:validation:Pattern="^(\\*|[1-5]?[0-9]|[1-5]?[0-9]-[1-5]?[0-9]|(?:[1-9]|[1-4][0-9]|5[0-9])\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60)|\\*\\/(?:[1-9]|[1-4][0-9]|5[0-9]|60))\\s+(\\*|[0-9]|1[0-9]|2[0-3]|[0-9]-(?:[0-9]|1[0-9]|2[0-3])|1[0-9]-(?:1[0-9]|2[0-3])|2[0-3]-2[0-3]|(?:[1-9]|1[0-9]|2[0-3])\\/(?:[1-9]|1[0-9]|2[0-4])|\\*\\/(?:[1-9]|1[0-9]|2[0-4]))\\s+(\\*|[1-9]|[12][0-9]|3[01]|[1-9]-(?:[1-9]|[12][0-9]|3[01])|[12][0-9]-(?:[12][0-9]|3[01])|3[01]-3[01]|(?:[1-9]|[12][0-9]|30)\\/(?:[1-9]|[12][0-9]|3[01])|\\*\\/(?:[1-9]|[12][0-9]|3[01]))\\s+(\\*|[1-9]|1[0-2]|[1-9]-(?:[1-9]|1[0-2])|1[0-2]-1[0-2]|(?:[1-9]|1[0-2])\\/(?:[1-9]|1[0-2])|\\*\\/(?:[1-9]|1[0-2]))\\s+(\\*|[1-7]|[1-6]-[1-7]|[1-6]\\/[1-7]|\\*\\/[1-7])$"
+	Schedule *string `json:"schedule,omitempty"`
 }
 
 // WaitForFinalSnapshotSpec defines the parameters for waiting for a final full snapshot before copying backups.
@@ -75,6 +114,10 @@ const (
 	EtcdCopyBackupsTaskSucceeded ConditionType = "Succeeded"
 	// EtcdCopyBackupsTaskFailed is a condition type indicating that a EtcdCopyBackupsTask has failed.
 	EtcdCopyBackupsTaskFailed ConditionType = "Failed"
+	// EtcdCopyBackupsTaskTargetEtcdReconcileTriggered is a condition type indicating whether the reconciliation of the Etcd
+	// referenced by TargetEtcdRef has been triggered. It is false while the trigger is skipped since the spec
+	// reconciliation of the target Etcd is suspended.
+	EtcdCopyBackupsTaskTargetEtcdReconcileTriggered ConditionType = "TargetEtcdReconcileTriggered"
 )
 
 // EtcdCopyBackupsTaskStatus defines the observed state of the copy backups task.
@@ -88,11 +131,105 @@ type EtcdCopyBackupsTaskStatus struct {
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 	// LastError represents the last occurred error.
+	// Deprecated: Please use LastErrors instead.
 	// +optional
 	LastError *string `json:"lastError,omitempty"`
+	// LastErrors captures the errors that occurred during the last reconciliation, as well as the error reported by the
+	// last copy job if it has failed.
+	// +optional
+	LastErrors []druidapicommon.LastError `json:"lastErrors,omitempty"`
+	// CopyResult is the result reported by the last finished copy job. It is only reported by etcd-backup-restore
+	// v0.43.0 and later.
+	// +optional
+	CopyResult *EtcdCopyBackupsTaskCopyResult `json:"copyResult,omitempty"`
+	// LastScheduleTime is the time at which the last copy job of a recurring task was scheduled.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// NextScheduleTime is the time at which the next copy job of a recurring task will be scheduled.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// LastSuccessfulTime is the start time of the last successful copy job of a recurring task. All backups that were
+	// present in the source store at this time have been copied to the target store.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// LastCopiedSnapshot is the most recent snapshot that is present in the target store after the last successful copy
+	// job of a recurring task. The next copy job only copies the snapshots taken after it. It is only recorded for
+	// etcd-backup-restore v0.43.0 and later.
+	// +optional
+	LastCopiedSnapshot *EtcdCopyBackupsTaskSnapshot `json:"lastCopiedSnapshot,omitempty"`
+	// TimeSinceLastSuccess is the time that had elapsed since LastSuccessfulTime when the status was last updated. It is
+	// an upper bound for the age of backups in the source store that are not yet present in the target store.
+	// +optional
+	TimeSinceLastSuccess *metav1.Duration `json:"timeSinceLastSuccess,omitempty"`
+	// RecentRuns holds the most recent runs of a recurring task, ordered from oldest to newest.
+	// +optional
+	RecentRuns []EtcdCopyBackupsTaskRun `json:"recentRuns,omitempty"`
+	// TargetEtcdReconcileTime is the time at which the reconciliation of the Etcd referenced by TargetEtcdRef was triggered.
+	// +optional
+	TargetEtcdReconcileTime *metav1.Time `json:"targetEtcdReconcileTime,omitempty"`
+}
+
+// EtcdCopyBackupsTaskRunResult is the result of a run of a recurring EtcdCopyBackupsTask.
+type EtcdCopyBackupsTaskRunResult string
+
+const (
+	// EtcdCopyBackupsTaskRunSucceeded indicates that the copy job of a run has succeeded.
+	EtcdCopyBackupsTaskRunSucceeded EtcdCopyBackupsTaskRunResult = "Succeeded"
+	// EtcdCopyBackupsTaskRunFailed indicates that the copy job of a run has failed.
+	EtcdCopyBackupsTaskRunFailed EtcdCopyBackupsTaskRunResult = "Failed"
+)
+
+// EtcdCopyBackupsTaskRun holds information about a completed run of a recurring EtcdCopyBackupsTask.
+type EtcdCopyBackupsTaskRun struct {
+	// StartTime is the time at which the copy job of the run was created.
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is the time at which the copy job of the run has finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Result is the result of the run.
+	Result EtcdCopyBackupsTaskRunResult `json:"result"`
+	// Message is a human-readable message indicating details about the result of the run.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// CopyResult is the result reported by the copy job of the run.
+	// +optional
+	CopyResult *EtcdCopyBackupsTaskCopyResult `json:"copyResult,omitempty"`
+}
+
+// EtcdCopyBackupsTaskCopyResult holds the result reported by a copy job.
+type EtcdCopyBackupsTaskCopyResult struct {
+	// SnapshotsCopied is the number of snapshots that have been copied to the target store.
+	SnapshotsCopied int32 `json:"snapshotsCopied"`
+	// SnapshotsSkipped is the number of snapshots that have not been copied since they were already present in the
+	// target store.
+	SnapshotsSkipped int32 `json:"snapshotsSkipped"`
+	// BytesCopied is the total size in bytes of the snapshots that have been copied.
+	BytesCopied int64 `json:"bytesCopied"`
+	// FirstSnapshot is the oldest snapshot that has either been copied or skipped.
+	// +optional
+	FirstSnapshot *EtcdCopyBackupsTaskSnapshot `json:"firstSnapshot,omitempty"`
+	// LastSnapshot is the most recent snapshot that has either been copied or skipped.
+	// +optional
+	LastSnapshot *EtcdCopyBackupsTaskSnapshot `json:"lastSnapshot,omitempty"`
+	// Duration is the time it took the copy job to finish.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// EtcdCopyBackupsTaskSnapshot identifies a snapshot in the source store.
+type EtcdCopyBackupsTaskSnapshot struct {
+	// Revision is the last etcd revision contained in the snapshot.
+	Revision int64 `json:"revision"`
+	// Timestamp is the time at which the snapshot was taken.
+	Timestamp metav1.Time `json:"timestamp"`
 }
 
 // GetJobName returns the name of the CopyBackups Job.
 func (e *EtcdCopyBackupsTask) GetJobName() string {
 	return fmt.Sprintf("%s-worker", e.Name)
 }
+
+// IsRecurring returns true if copy jobs are created on a schedule for the EtcdCopyBackupsTask.
+func (e *EtcdCopyBackupsTask) IsRecurring() bool {
+	return e.Spec.Schedule != nil
+}
@@ -6,9 +6,11 @@ package v1alpha1
 
 import (
 	"crypto/rand"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -115,11 +117,22 @@ func GetFullSnapshotLeaseName(etcdObjMeta metav1.ObjectMeta) string {
 	return fmt.Sprintf("%s-full-snap", etcdObjMeta.Name)
 }
 
+// GetSecondaryStoreSnapshotLeaseName returns the name of the lease which is renewed whenever a snapshot has been
+// replicated to the secondary store with the given name.
+func GetSecondaryStoreSnapshotLeaseName(etcdObjMeta metav1.ObjectMeta, storeName string) string {
+	return fmt.Sprintf("%s-replicated-snap-%s", etcdObjMeta.Name, storeName)
+}
+
 // GetStatefulSetName returns the name of the StatefulSet for the Etcd.
 func GetStatefulSetName(etcdObjMeta metav1.ObjectMeta) string {
 	return etcdObjMeta.Name
 }
 
+// GetAuthRootSecretName returns the name of the secret containing the credentials of the etcd root user for the Etcd.
+func GetAuthRootSecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-auth-root", etcdObjMeta.Name)
+}
+
 // --------------- Miscellaneous helper functions ---------------
 
 // GetNamespaceName is a convenience function which creates a types.NamespacedName for an Etcd resource.
@@ -145,6 +158,12 @@ func AreManagedResourcesProtected(etcdObjMeta metav1.ObjectMeta) bool {
 	return !metav1.HasAnnotation(etcdObjMeta, DisableEtcdComponentProtectionAnnotation)
 }
 
+// IsMaintenanceWindowIgnored returns true if the Etcd resource has the `druid.gardener.cloud/ignore-maintenance-window`
+// annotation set, else returns false.
+func IsMaintenanceWindowIgnored(etcdObjMeta metav1.ObjectMeta) bool {
+	return metav1.HasAnnotation(etcdObjMeta, IgnoreMaintenanceWindowAnnotation)
+}
+
 // GetDefaultLabels returns the default labels for etcd.
 func GetDefaultLabels(etcdObjMeta metav1.ObjectMeta) map[string]string {
 	return map[string]string{
@@ -198,3 +217,48 @@ func RemoveOperationAnnotation(etcdObjMeta metav1.ObjectMeta) {
 func ArePodsManagedByEtcdDruid(etcd *Etcd) bool {
 	return len(etcd.Spec.ExternallyManagedMemberAddresses) == 0
 }
+
+// GetQuota returns the quota of the backend database of etcd, or nil if the default quota applies. The quota which has
+// been raised by the automatic quota expansion takes precedence over spec.etcd.quota as long as it is larger. This also
+// applies once the automatic quota expansion has been disabled, since the backend database may have outgrown
+// spec.etcd.quota in the meantime. The expanded quota is only dropped once the ExpandedQuotaAnnotation is removed.
+func GetQuota(etcd *Etcd) *resource.Quantity {
+	quota := etcd.Spec.Etcd.Quota
+	expandedQuota := GetExpandedQuota(etcd)
+	if expandedQuota == nil {
+		return quota
+	}
+	if quota == nil || expandedQuota.Cmp(*quota) > 0 {
+		return expandedQuota
+	}
+	return quota
+}
+
+// GetExpandedQuota returns the quota which has been raised by the automatic quota expansion, as recorded in the
+// ExpandedQuotaAnnotation, or nil if the quota has not been expanded or the annotation cannot be parsed.
+func GetExpandedQuota(etcd *Etcd) *resource.Quantity {
+	value, ok := etcd.Annotations[ExpandedQuotaAnnotation]
+	if !ok {
+		return nil
+	}
+	expandedQuota, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil
+	}
+	return &expandedQuota
+}
+
+// GetAppliedResourceRecommendation returns the recommended resources which have been applied to the containers, as
+// recorded in the AppliedResourceRecommendationAnnotation, or nil if none have been applied or the annotation cannot be
+// parsed.
+func GetAppliedResourceRecommendation(etcd *Etcd) *AppliedResourceRecommendation {
+	value, ok := etcd.Annotations[AppliedResourceRecommendationAnnotation]
+	if !ok {
+		return nil
+	}
+	applied := &AppliedResourceRecommendation{}
+	if err := json.Unmarshal([]byte(value), applied); err != nil {
+		return nil
+	}
+	return applied
+}
@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -118,6 +121,13 @@ func TestGetFullSnapshotLeaseName(t *testing.T) {
 	g.Expect(fullSnapshotLeaseName).To(Equal(etcdObjMeta.Name + "-full-snap"))
 }
 
+func TestGetSecondaryStoreSnapshotLeaseName(t *testing.T) {
+	g := NewWithT(t)
+	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
+	secondaryStoreSnapshotLeaseName := GetSecondaryStoreSnapshotLeaseName(etcdObjMeta, "dr")
+	g.Expect(secondaryStoreSnapshotLeaseName).To(Equal(etcdObjMeta.Name + "-replicated-snap-dr"))
+}
+
 func TestGetMemberNameFromAddress(t *testing.T) {
 	g := NewWithT(t)
 	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
@@ -177,6 +187,13 @@ func TestGetRoleBindingName(t *testing.T) {
 	g.Expect(roleBindingName).To(Equal("druid.gardener.cloud:etcd:" + etcdObjMeta.Name))
 }
 
+func TestGetAuthRootSecretName(t *testing.T) {
+	g := NewWithT(t)
+	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
+	secretName := GetAuthRootSecretName(etcdObjMeta)
+	g.Expect(secretName).To(Equal(etcdObjMeta.Name + "-auth-root"))
+}
+
 func TestGetSuspendEtcdSpecReconcileAnnotationKey(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -235,6 +252,34 @@ func TestAreManagedResourcesProtected(t *testing.T) {
 	}
 }
 
+func TestIsMaintenanceWindowIgnored(t *testing.T) {
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		expectedIgnored bool
+	}{
+		{
+			name:            "No IgnoreMaintenanceWindowAnnotation annotation is set",
+			annotations:     nil,
+			expectedIgnored: false,
+		},
+		{
+			name:            "IgnoreMaintenanceWindowAnnotation is set",
+			annotations:     map[string]string{IgnoreMaintenanceWindowAnnotation: ""},
+			expectedIgnored: true,
+		},
+	}
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), test.annotations, nil, false)
+			g.Expect(IsMaintenanceWindowIgnored(etcdObjMeta)).To(Equal(test.expectedIgnored))
+		})
+	}
+}
+
 func TestGetDefaultLabels(t *testing.T) {
 	g := NewWithT(t)
 	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
@@ -527,6 +572,73 @@ func TestIsPodManagementEnabled(t *testing.T) {
 	}
 }
 
+func TestGetQuota(t *testing.T) {
+	tests := []struct {
+		name               string
+		quota              *resource.Quantity
+		quotaAutoExpansion *QuotaAutoExpansion
+		expandedQuota      *resource.Quantity
+		expected           *resource.Quantity
+	}{
+		{
+			name:     "no quota configured",
+			expected: nil,
+		},
+		{
+			name:          "automatic quota expansion disabled after the quota has been expanded",
+			quota:         ptr.To(resource.MustParse("8Gi")),
+			expandedQuota: ptr.To(resource.MustParse("10Gi")),
+			expected:      ptr.To(resource.MustParse("10Gi")),
+		},
+		{
+			name:     "automatic quota expansion disabled without expanded quota",
+			quota:    ptr.To(resource.MustParse("8Gi")),
+			expected: ptr.To(resource.MustParse("8Gi")),
+		},
+		{
+			name:               "quota not expanded yet",
+			quota:              ptr.To(resource.MustParse("8Gi")),
+			quotaAutoExpansion: &QuotaAutoExpansion{},
+			expected:           ptr.To(resource.MustParse("8Gi")),
+		},
+		{
+			name:               "expanded quota larger than configured quota",
+			quota:              ptr.To(resource.MustParse("8Gi")),
+			quotaAutoExpansion: &QuotaAutoExpansion{},
+			expandedQuota:      ptr.To(resource.MustParse("10Gi")),
+			expected:           ptr.To(resource.MustParse("10Gi")),
+		},
+		{
+			name:               "expanded quota without configured quota",
+			quotaAutoExpansion: &QuotaAutoExpansion{},
+			expandedQuota:      ptr.To(resource.MustParse("10Gi")),
+			expected:           ptr.To(resource.MustParse("10Gi")),
+		},
+		{
+			name:               "configured quota raised beyond expanded quota",
+			quota:              ptr.To(resource.MustParse("12Gi")),
+			quotaAutoExpansion: &QuotaAutoExpansion{},
+			expandedQuota:      ptr.To(resource.MustParse("10Gi")),
+			expected:           ptr.To(resource.MustParse("12Gi")),
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := &Etcd{
+				Spec: EtcdSpec{Etcd: EtcdConfig{Quota: test.quota, QuotaAutoExpansion: test.quotaAutoExpansion}},
+			}
+			if test.expandedQuota != nil {
+				etcd.Annotations = map[string]string{ExpandedQuotaAnnotation: test.expandedQuota.String()}
+			}
+			g.Expect(GetQuota(etcd)).To(Equal(test.expected))
+		})
+	}
+}
+
 func createEtcdObjectMetadata(uid types.UID, annotations, labels map[string]string, markedForDeletion bool) metav1.ObjectMeta {
 	etcdObjMeta := metav1.ObjectMeta{
 		Name:        etcdName,
@@ -543,3 +655,75 @@ func createEtcdObjectMetadata(uid types.UID, annotations, labels map[string]stri
 
 	return etcdObjMeta
 }
+
+func TestGetExpandedQuota(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *resource.Quantity
+	}{
+		{
+			name:     "quota not expanded",
+			expected: nil,
+		},
+		{
+			name:        "quota expanded",
+			annotations: map[string]string{ExpandedQuotaAnnotation: "10Gi"},
+			expected:    ptr.To(resource.MustParse("10Gi")),
+		},
+		{
+			name:        "invalid annotation",
+			annotations: map[string]string{ExpandedQuotaAnnotation: "ten gigabytes"},
+			expected:    nil,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := &Etcd{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			g.Expect(GetExpandedQuota(etcd)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetAppliedResourceRecommendation(t *testing.T) {
+	applied := &AppliedResourceRecommendation{
+		Etcd: &ContainerResources{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("300m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+		LastApplyTime: metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *AppliedResourceRecommendation
+	}{
+		{
+			name:     "no recommendation applied",
+			expected: nil,
+		},
+		{
+			name:        "recommendation applied",
+			annotations: map[string]string{AppliedResourceRecommendationAnnotation: `{"etcd":{"requests":{"cpu":"300m","memory":"1Gi"}},"lastApplyTime":"2025-01-02T00:00:00Z"}`},
+			expected:    applied,
+		},
+		{
+			name:        "invalid annotation",
+			annotations: map[string]string{AppliedResourceRecommendationAnnotation: "300m"},
+			expected:    nil,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := &Etcd{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			g.Expect(GetAppliedResourceRecommendation(etcd)).To(BeComparableTo(test.expected))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import corev1 "k8s.io/api/core/v1"
 type StorageProvider string
 
 // StoreSpec defines parameters related to ObjectStore persisting backups
+// +kubebuilder:validation:XValidation:message="workloadIdentity is only supported for the S3, GCS and ABS storage providers.",rule="!has(self.workloadIdentity) || (has(self.provider) && self.provider in ['aws', 'stackit', 'S3', 's3', 'gcp', 'GCS', 'gcs', 'azure', 'ABS', 'abs'])"
 type StoreSpec struct {
 	// Container is the name of the container the backup is stored at.
 	// +optional
@@ -25,6 +26,43 @@ type StoreSpec struct {
 	// +optional
 	Provider *StorageProvider `json:"provider,omitempty"`
 	// SecretRef is the reference to the secret which used to connect to the backup store.
+	// If WorkloadIdentity is set, the secret is optional and must not contain any long-lived credentials.
 	// +optional
 	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+	// WorkloadIdentity configures access to the backup store with the workload identity of the pods accessing it,
+	// instead of static credentials. It is only supported for the S3, GCS and ABS storage providers.
+	// +optional
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+// WorkloadIdentity defines the parameters for accessing a backup store with workload identity.
+type WorkloadIdentity struct {
+	// ServiceAccountAnnotations are added to the ServiceAccount of the etcd cluster, which is also used by the snapshot
+	// compaction jobs. Examples are `eks.amazonaws.com/role-arn` for IAM roles for service accounts on S3,
+	// `iam.gke.io/gcp-service-account` for workload identity on GCS, and `azure.workload.identity/client-id` as well
+	// as `azure.workload.identity/tenant-id` for workload identity on ABS.
+	// +optional
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+	// Audience is the audience of the service account token which is projected into the pods accessing the backup
+	// store. Defaults to `sts.amazonaws.com` for S3 and `api://AzureADTokenExchange` for ABS. For GCS, a token is only
+	// projected if an audience is set, e.g. for workload identity federation outside of GKE.
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+}
+
+// SecondaryStoreSpec defines a backup store to which snapshots are replicated in addition to the primary backup store.
+// +kubebuilder:validation:XValidation:message="secondary stores must reference a secret with the credentials of the store.",rule="has(self.store.secretRef)"
+// +kubebuilder:validation:XValidation:message="workloadIdentity is not supported for secondary stores.",rule="!has(self.store.workloadIdentity)"
+type SecondaryStoreSpec struct {
+	// Name identifies the secondary store. It is part of the name of the snapshot lease of the secondary store.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+	// Store defines the object store of the secondary store.
+	Store StoreSpec `json:"store"`
+	// ReplicateDeltaSnapshots defines whether delta snapshots are replicated to the secondary store as well. By
+	// default, only full snapshots are replicated.
+	// +optional
+	ReplicateDeltaSnapshots *bool `json:"replicateDeltaSnapshots,omitempty"`
 }
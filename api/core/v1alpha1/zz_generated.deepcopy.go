@@ -15,6 +15,119 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalContainer) DeepCopyInto(out *AdditionalContainer) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]v1.ContainerPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalContainer.
+func (in *AdditionalContainer) DeepCopy() *AdditionalContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalVolume) DeepCopyInto(out *AdditionalVolume) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(v1.EmptyDirVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(v1.PersistentVolumeClaimVolumeSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalVolume.
+func (in *AdditionalVolume) DeepCopy() *AdditionalVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedResourceRecommendation) DeepCopyInto(out *AppliedResourceRecommendation) {
+	*out = *in
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(ContainerResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRestore != nil {
+		in, out := &in.BackupRestore, &out.BackupRestore
+		*out = new(ContainerResources)
+		(*in).DeepCopyInto(*out)
+	}
+	in.LastApplyTime.DeepCopyInto(&out.LastApplyTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedResourceRecommendation.
+func (in *AppliedResourceRecommendation) DeepCopy() *AppliedResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -38,6 +151,13 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(StoreSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecondaryStores != nil {
+		in, out := &in.SecondaryStores, &out.SecondaryStores
+		*out = make([]SecondaryStoreSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
@@ -63,6 +183,13 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.GarbageCollectionTiers != nil {
+		in, out := &in.GarbageCollectionTiers, &out.GarbageCollectionTiers
+		*out = make([]GarbageCollectionTier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.GarbageCollectionPeriod != nil {
 		in, out := &in.GarbageCollectionPeriod, &out.GarbageCollectionPeriod
 		*out = new(metav1.Duration)
@@ -88,6 +215,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(CompressionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnableProfiling != nil {
 		in, out := &in.EnableProfiling, &out.EnableProfiling
 		*out = new(bool)
@@ -103,6 +235,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(LeaderElectionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ContainerProbes)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -195,6 +332,92 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerOverrides) DeepCopyInto(out *ContainerOverrides) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverrides.
+func (in *ContainerOverrides) DeepCopy() *ContainerOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerProbes) DeepCopyInto(out *ContainerProbes) {
+	*out = *in
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(ProbeThresholds)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Startup != nil {
+		in, out := &in.Startup, &out.Startup
+		*out = new(ProbeThresholds)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerProbes.
+func (in *ContainerProbes) DeepCopy() *ContainerProbes {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerProbes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerResources) DeepCopyInto(out *ContainerResources) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResources.
+func (in *ContainerResources) DeepCopy() *ContainerResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CrossVersionObjectReference) DeepCopyInto(out *CrossVersionObjectReference) {
 	*out = *in
@@ -211,6 +434,53 @@ func (in *CrossVersionObjectReference) DeepCopy() *CrossVersionObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeferredOperation) DeepCopyInto(out *DeferredOperation) {
+	*out = *in
+	in.DeferredSince.DeepCopyInto(&out.DeferredSince)
+	if in.ScheduledTime != nil {
+		in, out := &in.ScheduledTime, &out.ScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeferredOperation.
+func (in *DeferredOperation) DeepCopy() *DeferredOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeferredOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionSpec) DeepCopyInto(out *EncryptionSpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionSpec.
+func (in *EncryptionSpec) DeepCopy() *EncryptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Etcd) DeepCopyInto(out *Etcd) {
 	*out = *in
@@ -239,6 +509,99 @@ func (in *Etcd) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuth) DeepCopyInto(out *EtcdAuth) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]EtcdAuthRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]EtcdAuthUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuth.
+func (in *EtcdAuth) DeepCopy() *EtcdAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthPermission) DeepCopyInto(out *EtcdAuthPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthPermission.
+func (in *EtcdAuthPermission) DeepCopy() *EtcdAuthPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthRole) DeepCopyInto(out *EtcdAuthRole) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]EtcdAuthPermission, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthRole.
+func (in *EtcdAuthRole) DeepCopy() *EtcdAuthRole {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthUser) DeepCopyInto(out *EtcdAuthUser) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthUser.
+func (in *EtcdAuthUser) DeepCopy() *EtcdAuthUser {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 	*out = *in
@@ -247,6 +610,11 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.QuotaAutoExpansion != nil {
+		in, out := &in.QuotaAutoExpansion, &out.QuotaAutoExpansion
+		*out = new(QuotaAutoExpansion)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SnapshotCount != nil {
 		in, out := &in.SnapshotCount, &out.SnapshotCount
 		*out = new(int64)
@@ -287,6 +655,11 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		*out = new(v1.SecretReference)
 		**out = **in
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(EtcdAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
 		*out = new(MetricsLevel)
@@ -322,6 +695,18 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		*out = new(ClientService)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ContainerProbes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalConfig != nil {
+		in, out := &in.AdditionalConfig, &out.AdditionalConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -364,20 +749,51 @@ func (in *EtcdCopyBackupsTask) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EtcdCopyBackupsTaskList) DeepCopyInto(out *EtcdCopyBackupsTaskList) {
+func (in *EtcdCopyBackupsTaskCopyResult) DeepCopyInto(out *EtcdCopyBackupsTaskCopyResult) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]EtcdCopyBackupsTask, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.FirstSnapshot != nil {
+		in, out := &in.FirstSnapshot, &out.FirstSnapshot
+		*out = new(EtcdCopyBackupsTaskSnapshot)
+		(*in).DeepCopyInto(*out)
 	}
-	return
-}
-
+	if in.LastSnapshot != nil {
+		in, out := &in.LastSnapshot, &out.LastSnapshot
+		*out = new(EtcdCopyBackupsTaskSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdCopyBackupsTaskCopyResult.
+func (in *EtcdCopyBackupsTaskCopyResult) DeepCopy() *EtcdCopyBackupsTaskCopyResult {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdCopyBackupsTaskCopyResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdCopyBackupsTaskList) DeepCopyInto(out *EtcdCopyBackupsTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EtcdCopyBackupsTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdCopyBackupsTaskList.
 func (in *EtcdCopyBackupsTaskList) DeepCopy() *EtcdCopyBackupsTaskList {
 	if in == nil {
@@ -396,6 +812,49 @@ func (in *EtcdCopyBackupsTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdCopyBackupsTaskRun) DeepCopyInto(out *EtcdCopyBackupsTaskRun) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CopyResult != nil {
+		in, out := &in.CopyResult, &out.CopyResult
+		*out = new(EtcdCopyBackupsTaskCopyResult)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdCopyBackupsTaskRun.
+func (in *EtcdCopyBackupsTaskRun) DeepCopy() *EtcdCopyBackupsTaskRun {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdCopyBackupsTaskRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdCopyBackupsTaskSnapshot) DeepCopyInto(out *EtcdCopyBackupsTaskSnapshot) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdCopyBackupsTaskSnapshot.
+func (in *EtcdCopyBackupsTaskSnapshot) DeepCopy() *EtcdCopyBackupsTaskSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdCopyBackupsTaskSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdCopyBackupsTaskSpec) DeepCopyInto(out *EtcdCopyBackupsTaskSpec) {
 	*out = *in
@@ -408,6 +867,16 @@ func (in *EtcdCopyBackupsTaskSpec) DeepCopyInto(out *EtcdCopyBackupsTaskSpec) {
 	}
 	in.SourceStore.DeepCopyInto(&out.SourceStore)
 	in.TargetStore.DeepCopyInto(&out.TargetStore)
+	if in.SourceEtcdRef != nil {
+		in, out := &in.SourceEtcdRef, &out.SourceEtcdRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.TargetEtcdRef != nil {
+		in, out := &in.TargetEtcdRef, &out.TargetEtcdRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.MaxBackupAge != nil {
 		in, out := &in.MaxBackupAge, &out.MaxBackupAge
 		*out = new(uint32)
@@ -423,6 +892,11 @@ func (in *EtcdCopyBackupsTaskSpec) DeepCopyInto(out *EtcdCopyBackupsTaskSpec) {
 		*out = new(WaitForFinalSnapshotSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -456,6 +930,51 @@ func (in *EtcdCopyBackupsTaskStatus) DeepCopyInto(out *EtcdCopyBackupsTaskStatus
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastErrors != nil {
+		in, out := &in.LastErrors, &out.LastErrors
+		*out = make([]common.LastError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CopyResult != nil {
+		in, out := &in.CopyResult, &out.CopyResult
+		*out = new(EtcdCopyBackupsTaskCopyResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCopiedSnapshot != nil {
+		in, out := &in.LastCopiedSnapshot, &out.LastCopiedSnapshot
+		*out = new(EtcdCopyBackupsTaskSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeSinceLastSuccess != nil {
+		in, out := &in.TimeSinceLastSuccess, &out.TimeSinceLastSuccess
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RecentRuns != nil {
+		in, out := &in.RecentRuns, &out.RecentRuns
+		*out = make([]EtcdCopyBackupsTaskRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetEtcdReconcileTime != nil {
+		in, out := &in.TargetEtcdReconcileTime, &out.TargetEtcdReconcileTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -516,6 +1035,41 @@ func (in *EtcdMemberStatus) DeepCopyInto(out *EtcdMemberStatus) {
 		**out = **in
 	}
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.DBSize != nil {
+		in, out := &in.DBSize, &out.DBSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DBSizeInUse != nil {
+		in, out := &in.DBSizeInUse, &out.DBSizeInUse
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EtcdVersion != nil {
+		in, out := &in.EtcdVersion, &out.EtcdVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.RaftTerm != nil {
+		in, out := &in.RaftTerm, &out.RaftTerm
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RaftIndex != nil {
+		in, out := &in.RaftIndex, &out.RaftIndex
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RaftAppliedIndex != nil {
+		in, out := &in.RaftAppliedIndex, &out.RaftAppliedIndex
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -730,11 +1284,31 @@ func (in *EtcdSpec) DeepCopyInto(out *EtcdSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RollPodsOnSecretChange != nil {
+		in, out := &in.RollPodsOnSecretChange, &out.RollPodsOnSecretChange
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ExternallyManagedMemberAddresses != nil {
 		in, out := &in.ExternallyManagedMemberAddresses, &out.ExternallyManagedMemberAddresses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodTemplateOverrides != nil {
+		in, out := &in.PodTemplateOverrides, &out.PodTemplateOverrides
+		*out = new(PodTemplateOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRecommendation != nil {
+		in, out := &in.ResourceRecommendation, &out.ResourceRecommendation
+		*out = new(ResourceRecommendationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -807,6 +1381,55 @@ func (in *EtcdStatus) DeepCopyInto(out *EtcdStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SnapshotCompaction != nil {
+		in, out := &in.SnapshotCompaction, &out.SnapshotCompaction
+		*out = new(SnapshotCompactionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupEncryptionKeyIDs != nil {
+		in, out := &in.BackupEncryptionKeyIDs, &out.BackupEncryptionKeyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecondaryStores != nil {
+		in, out := &in.SecondaryStores, &out.SecondaryStores
+		*out = make([]SecondaryStoreStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotCatalog != nil {
+		in, out := &in.SnapshotCatalog, &out.SnapshotCatalog
+		*out = new(SnapshotCatalog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpandedQuota != nil {
+		in, out := &in.ExpandedQuota, &out.ExpandedQuota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(LeaderElectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRecommendation != nil {
+		in, out := &in.ResourceRecommendation, &out.ResourceRecommendation
+		*out = new(ResourceRecommendationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeferredOperations != nil {
+		in, out := &in.DeferredOperations, &out.DeferredOperations
+		*out = make([]DeferredOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -821,183 +1444,741 @@ func (in *EtcdStatus) DeepCopy() *EtcdStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LeaderElectionSpec) DeepCopyInto(out *LeaderElectionSpec) {
+func (in *GarbageCollectionStatus) DeepCopyInto(out *GarbageCollectionStatus) {
 	*out = *in
-	if in.ReelectionPeriod != nil {
-		in, out := &in.ReelectionPeriod, &out.ReelectionPeriod
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]GarbageCollectionTierStatus, len(*in))
+		copy(*out, *in)
 	}
-	if in.EtcdConnectionTimeout != nil {
-		in, out := &in.EtcdConnectionTimeout, &out.EtcdConnectionTimeout
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElectionSpec.
-func (in *LeaderElectionSpec) DeepCopy() *LeaderElectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionStatus.
+func (in *GarbageCollectionStatus) DeepCopy() *GarbageCollectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LeaderElectionSpec)
+	out := new(GarbageCollectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OnDemandSnapshotConfig) DeepCopyInto(out *OnDemandSnapshotConfig) {
+func (in *GarbageCollectionTier) DeepCopyInto(out *GarbageCollectionTier) {
 	*out = *in
-	if in.IsFinal != nil {
-		in, out := &in.IsFinal, &out.IsFinal
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TimeoutSecondsDelta != nil {
-		in, out := &in.TimeoutSecondsDelta, &out.TimeoutSecondsDelta
+	out.Interval = in.Interval
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
 		*out = new(int32)
 		**out = **in
 	}
-	if in.TimeoutSecondsFull != nil {
-		in, out := &in.TimeoutSecondsFull, &out.TimeoutSecondsFull
-		*out = new(int32)
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnDemandSnapshotConfig.
-func (in *OnDemandSnapshotConfig) DeepCopy() *OnDemandSnapshotConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionTier.
+func (in *GarbageCollectionTier) DeepCopy() *GarbageCollectionTier {
 	if in == nil {
 		return nil
 	}
-	out := new(OnDemandSnapshotConfig)
+	out := new(GarbageCollectionTier)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SchedulingConstraints) DeepCopyInto(out *SchedulingConstraints) {
+func (in *GarbageCollectionTierStatus) DeepCopyInto(out *GarbageCollectionTierStatus) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TopologySpreadConstraints != nil {
-		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
-		*out = make([]v1.TopologySpreadConstraint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.Interval = in.Interval
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConstraints.
-func (in *SchedulingConstraints) DeepCopy() *SchedulingConstraints {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionTierStatus.
+func (in *GarbageCollectionTierStatus) DeepCopy() *GarbageCollectionTierStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SchedulingConstraints)
+	out := new(GarbageCollectionTierStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+func (in *KMSSpec) DeepCopyInto(out *KMSSpec) {
 	*out = *in
-	out.SecretReference = in.SecretReference
-	if in.DataKey != nil {
-		in, out := &in.DataKey, &out.DataKey
-		*out = new(string)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
-func (in *SecretReference) DeepCopy() *SecretReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSSpec.
+func (in *KMSSpec) DeepCopy() *KMSSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretReference)
+	out := new(KMSSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedConfig) DeepCopyInto(out *SharedConfig) {
+func (in *LeaderElectionSpec) DeepCopyInto(out *LeaderElectionSpec) {
 	*out = *in
-	if in.AutoCompactionMode != nil {
-		in, out := &in.AutoCompactionMode, &out.AutoCompactionMode
-		*out = new(CompactionMode)
+	if in.ReelectionPeriod != nil {
+		in, out := &in.ReelectionPeriod, &out.ReelectionPeriod
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.AutoCompactionRetention != nil {
-		in, out := &in.AutoCompactionRetention, &out.AutoCompactionRetention
-		*out = new(string)
+	if in.EtcdConnectionTimeout != nil {
+		in, out := &in.EtcdConnectionTimeout, &out.EtcdConnectionTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedConfig.
-func (in *SharedConfig) DeepCopy() *SharedConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElectionSpec.
+func (in *LeaderElectionSpec) DeepCopy() *LeaderElectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SharedConfig)
+	out := new(LeaderElectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SnapshotCompactionSpec) DeepCopyInto(out *SnapshotCompactionSpec) {
+func (in *LeaderElectionStatus) DeepCopyInto(out *LeaderElectionStatus) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.LastLeaderChangeTime != nil {
+		in, out := &in.LastLeaderChangeTime, &out.LastLeaderChangeTime
+		*out = (*in).DeepCopy()
 	}
-	if in.EventsThreshold != nil {
-		in, out := &in.EventsThreshold, &out.EventsThreshold
-		*out = new(int64)
-		**out = **in
+	if in.LeaderChangeTimes != nil {
+		in, out := &in.LeaderChangeTimes, &out.LeaderChangeTimes
+		*out = make([]metav1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.TriggerFullSnapshotThreshold != nil {
-		in, out := &in.TriggerFullSnapshotThreshold, &out.TriggerFullSnapshotThreshold
+	if in.RaftTerm != nil {
+		in, out := &in.RaftTerm, &out.RaftTerm
 		*out = new(int64)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotCompactionSpec.
-func (in *SnapshotCompactionSpec) DeepCopy() *SnapshotCompactionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElectionStatus.
+func (in *LeaderElectionStatus) DeepCopy() *LeaderElectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SnapshotCompactionSpec)
+	out := new(LeaderElectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StoreSpec) DeepCopyInto(out *StoreSpec) {
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
 	*out = *in
-	if in.Container != nil {
-		in, out := &in.Container, &out.Container
-		*out = new(string)
-		**out = **in
-	}
-	if in.EndpointOverride != nil {
-		in, out := &in.EndpointOverride, &out.EndpointOverride
-		*out = new(string)
-		**out = **in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
 	}
-	if in.Provider != nil {
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberResourceUsage) DeepCopyInto(out *MemberResourceUsage) {
+	*out = *in
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.BackupRestore != nil {
+		in, out := &in.BackupRestore, &out.BackupRestore
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DBSize != nil {
+		in, out := &in.DBSize, &out.DBSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DBSizeGrowthPerDay != nil {
+		in, out := &in.DBSizeGrowthPerDay, &out.DBSizeGrowthPerDay
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberResourceUsage.
+func (in *MemberResourceUsage) DeepCopy() *MemberResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnDemandSnapshotConfig) DeepCopyInto(out *OnDemandSnapshotConfig) {
+	*out = *in
+	if in.IsFinal != nil {
+		in, out := &in.IsFinal, &out.IsFinal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeoutSecondsDelta != nil {
+		in, out := &in.TimeoutSecondsDelta, &out.TimeoutSecondsDelta
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSecondsFull != nil {
+		in, out := &in.TimeoutSecondsFull, &out.TimeoutSecondsFull
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnDemandSnapshotConfig.
+func (in *OnDemandSnapshotConfig) DeepCopy() *OnDemandSnapshotConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OnDemandSnapshotConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplateOverrides) DeepCopyInto(out *PodTemplateOverrides) {
+	*out = *in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalContainers != nil {
+		in, out := &in.AdditionalContainers, &out.AdditionalContainers
+		*out = make([]AdditionalContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]AdditionalVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerOverrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplateOverrides.
+func (in *PodTemplateOverrides) DeepCopy() *PodTemplateOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplateOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeThresholds) DeepCopyInto(out *ProbeThresholds) {
+	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeThresholds.
+func (in *ProbeThresholds) DeepCopy() *ProbeThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaAutoExpansion) DeepCopyInto(out *QuotaAutoExpansion) {
+	*out = *in
+	if in.ThresholdPercent != nil {
+		in, out := &in.ThresholdPercent, &out.ThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	out.Step = in.Step.DeepCopy()
+	out.MaxQuota = in.MaxQuota.DeepCopy()
+	if in.DisarmNoSpaceAlarm != nil {
+		in, out := &in.DisarmNoSpaceAlarm, &out.DisarmNoSpaceAlarm
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaAutoExpansion.
+func (in *QuotaAutoExpansion) DeepCopy() *QuotaAutoExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaAutoExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBounds) DeepCopyInto(out *ResourceBounds) {
+	*out = *in
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBounds.
+func (in *ResourceBounds) DeepCopy() *ResourceBounds {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBounds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecommendationSpec) DeepCopyInto(out *ResourceRecommendationSpec) {
+	*out = *in
+	if in.Apply != nil {
+		in, out := &in.Apply, &out.Apply
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(ResourceBounds)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRestore != nil {
+		in, out := &in.BackupRestore, &out.BackupRestore
+		*out = new(ResourceBounds)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendationSpec.
+func (in *ResourceRecommendationSpec) DeepCopy() *ResourceRecommendationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecommendationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecommendationStatus) DeepCopyInto(out *ResourceRecommendationStatus) {
+	*out = *in
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(ContainerResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRestore != nil {
+		in, out := &in.BackupRestore, &out.BackupRestore
+		*out = new(ContainerResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]MemberResourceUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(AppliedResourceRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendationStatus.
+func (in *ResourceRecommendationStatus) DeepCopy() *ResourceRecommendationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecommendationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingConstraints) DeepCopyInto(out *SchedulingConstraints) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologyPolicy != nil {
+		in, out := &in.TopologyPolicy, &out.TopologyPolicy
+		*out = new(TopologyPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConstraints.
+func (in *SchedulingConstraints) DeepCopy() *SchedulingConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryStoreSpec) DeepCopyInto(out *SecondaryStoreSpec) {
+	*out = *in
+	in.Store.DeepCopyInto(&out.Store)
+	if in.ReplicateDeltaSnapshots != nil {
+		in, out := &in.ReplicateDeltaSnapshots, &out.ReplicateDeltaSnapshots
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryStoreSpec.
+func (in *SecondaryStoreSpec) DeepCopy() *SecondaryStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryStoreStatus) DeepCopyInto(out *SecondaryStoreStatus) {
+	*out = *in
+	in.Condition.DeepCopyInto(&out.Condition)
+	if in.LastReplicationTime != nil {
+		in, out := &in.LastReplicationTime, &out.LastReplicationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryStoreStatus.
+func (in *SecondaryStoreStatus) DeepCopy() *SecondaryStoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+	out.SecretReference = in.SecretReference
+	if in.DataKey != nil {
+		in, out := &in.DataKey, &out.DataKey
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedConfig) DeepCopyInto(out *SharedConfig) {
+	*out = *in
+	if in.AutoCompactionMode != nil {
+		in, out := &in.AutoCompactionMode, &out.AutoCompactionMode
+		*out = new(CompactionMode)
+		**out = **in
+	}
+	if in.AutoCompactionRetention != nil {
+		in, out := &in.AutoCompactionRetention, &out.AutoCompactionRetention
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedConfig.
+func (in *SharedConfig) DeepCopy() *SharedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotCatalog) DeepCopyInto(out *SnapshotCatalog) {
+	*out = *in
+	if in.FullSnapshot != nil {
+		in, out := &in.FullSnapshot, &out.FullSnapshot
+		*out = new(SnapshotInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeltaSnapshots != nil {
+		in, out := &in.DeltaSnapshots, &out.DeltaSnapshots
+		*out = make([]SnapshotInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotCatalog.
+func (in *SnapshotCatalog) DeepCopy() *SnapshotCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotCompactionFailure) DeepCopyInto(out *SnapshotCompactionFailure) {
+	*out = *in
+	in.ObservedAt.DeepCopyInto(&out.ObservedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotCompactionFailure.
+func (in *SnapshotCompactionFailure) DeepCopy() *SnapshotCompactionFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotCompactionFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotCompactionSpec) DeepCopyInto(out *SnapshotCompactionSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventsThreshold != nil {
+		in, out := &in.EventsThreshold, &out.EventsThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TriggerFullSnapshotThreshold != nil {
+		in, out := &in.TriggerFullSnapshotThreshold, &out.TriggerFullSnapshotThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotCompactionSpec.
+func (in *SnapshotCompactionSpec) DeepCopy() *SnapshotCompactionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotCompactionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotCompactionStatus) DeepCopyInto(out *SnapshotCompactionStatus) {
+	*out = *in
+	if in.NextAttemptTime != nil {
+		in, out := &in.NextAttemptTime, &out.NextAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RecentFailures != nil {
+		in, out := &in.RecentFailures, &out.RecentFailures
+		*out = make([]SnapshotCompactionFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotCompactionStatus.
+func (in *SnapshotCompactionStatus) DeepCopy() *SnapshotCompactionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotCompactionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotInfo) DeepCopyInto(out *SnapshotInfo) {
+	*out = *in
+	in.CreatedOn.DeepCopyInto(&out.CreatedOn)
+	if in.SizeBytes != nil {
+		in, out := &in.SizeBytes, &out.SizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CompressionPolicy != nil {
+		in, out := &in.CompressionPolicy, &out.CompressionPolicy
+		*out = new(CompressionPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotInfo.
+func (in *SnapshotInfo) DeepCopy() *SnapshotInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreSpec) DeepCopyInto(out *StoreSpec) {
+	*out = *in
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(string)
+		**out = **in
+	}
+	if in.EndpointOverride != nil {
+		in, out := &in.EndpointOverride, &out.EndpointOverride
+		*out = new(string)
+		**out = **in
+	}
+	if in.Provider != nil {
 		in, out := &in.Provider, &out.Provider
 		*out = new(StorageProvider)
 		**out = **in
@@ -1007,6 +2188,11 @@ func (in *StoreSpec) DeepCopyInto(out *StoreSpec) {
 		*out = new(v1.SecretReference)
 		**out = **in
 	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1059,3 +2245,31 @@ func (in *WaitForFinalSnapshotSpec) DeepCopy() *WaitForFinalSnapshotSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentity) DeepCopyInto(out *WorkloadIdentity) {
+	*out = *in
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentity.
+func (in *WorkloadIdentity) DeepCopy() *WorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -12,6 +12,8 @@ import (
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -33,6 +35,12 @@ func ValidateEtcdUpdate(new, old *druidv1alpha1.Etcd) field.ErrorList {
 	allErrs = append(allErrs, ValidateEtcdSpecUpdate(&new.Spec, &old.Spec, new.DeletionTimestamp != nil, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateEtcd(new)...)
 
+	// Snapshots which have been encrypted can only be restored as long as encryption is configured.
+	if old.Spec.Backup.Encryption != nil && new.Spec.Backup.Encryption == nil && new.Spec.Backup.Store != nil && len(old.Status.BackupEncryptionKeyIDs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "backup", "encryption"),
+			fmt.Sprintf("cannot be removed while the backup store contains snapshots which are encrypted with the keys %s", strings.Join(old.Status.BackupEncryptionKeyIDs, ", "))))
+	}
+
 	return allErrs
 }
 
@@ -44,6 +52,23 @@ func ValidateEtcdSpec(spec *druidv1alpha1.EtcdSpec, name, namespace string, path
 		allErrs = append(allErrs, validateStore(spec.Backup.Store, name, namespace, path.Child("backup.store"))...)
 	}
 
+	if spec.PodTemplateOverrides != nil {
+		allErrs = append(allErrs, validatePodTemplateOverrides(spec.PodTemplateOverrides, path.Child("podTemplateOverrides"))...)
+	}
+
+	if spec.ResourceRecommendation != nil {
+		allErrs = append(allErrs, validateResourceBounds(spec.ResourceRecommendation.Etcd, path.Child("resourceRecommendation", "etcd"))...)
+		allErrs = append(allErrs, validateResourceBounds(spec.ResourceRecommendation.BackupRestore, path.Child("resourceRecommendation", "backupRestore"))...)
+	}
+
+	if spec.MaintenanceWindow != nil {
+		allErrs = append(allErrs, validateMaintenanceWindow(spec.MaintenanceWindow, path.Child("maintenanceWindow"))...)
+	}
+
+	if len(spec.Etcd.AdditionalConfig) > 0 {
+		allErrs = append(allErrs, validateAdditionalConfig(spec.Etcd.AdditionalConfig, path.Child("etcd", "additionalConfig"))...)
+	}
+
 	return allErrs
 }
 
@@ -76,6 +101,131 @@ func validateStore(store *druidv1alpha1.StoreSpec, name, namespace string, path
 		}
 	}
 
+	if store.WorkloadIdentity != nil {
+		if provider, _ := storageProviderFromInfraProvider(store.Provider); provider != s3 && provider != gcs && provider != abs {
+			allErrs = append(allErrs, field.Forbidden(path.Child("workloadIdentity"), "is only supported for the S3, GCS and ABS storage providers"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePodTemplateOverrides(overrides *druidv1alpha1.PodTemplateOverrides, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	containerNames := sets.New(reservedContainerNames...)
+	for i, container := range overrides.AdditionalContainers {
+		idxPath := path.Child("additionalContainers").Index(i)
+		allErrs = append(allErrs, validateName(container.Name, containerNames, idxPath.Child("name"))...)
+		if container.Image == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), "must specify an image"))
+		}
+	}
+
+	volumeNames := sets.New[string]()
+	for i, volume := range overrides.AdditionalVolumes {
+		idxPath := path.Child("additionalVolumes").Index(i)
+		allErrs = append(allErrs, validateName(volume.Name, volumeNames, idxPath.Child("name"))...)
+		numSources := 0
+		for _, isSet := range []bool{volume.ConfigMap != nil, volume.Secret != nil, volume.EmptyDir != nil, volume.PersistentVolumeClaim != nil} {
+			if isSet {
+				numSources++
+			}
+		}
+		if numSources != 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath, volume.Name, "exactly one of configMap, secret, emptyDir and persistentVolumeClaim must be set"))
+		}
+	}
+
+	overriddenContainerNames := sets.New[string]()
+	for i, container := range overrides.Containers {
+		idxPath := path.Child("containers").Index(i)
+		if container.Name != containerNameEtcd && container.Name != containerNameBackupRestore {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), container.Name, []string{containerNameEtcd, containerNameBackupRestore}))
+		} else if overriddenContainerNames.Has(container.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), container.Name))
+		}
+		overriddenContainerNames.Insert(container.Name)
+		for j, env := range container.Env {
+			if env.Name == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("env").Index(j).Child("name"), "must specify a name"))
+			}
+		}
+		for j, volumeMount := range container.VolumeMounts {
+			if volumeMount.Name == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("volumeMounts").Index(j).Child("name"), "must specify a name"))
+			}
+			if volumeMount.MountPath == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("volumeMounts").Index(j).Child("mountPath"), "must specify a mount path"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateResourceBounds validates that no minimum allowed resource exceeds the maximum allowed resource.
+func validateResourceBounds(bounds *druidv1alpha1.ResourceBounds, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if bounds == nil {
+		return allErrs
+	}
+	for name, minAllowed := range bounds.MinAllowed {
+		if maxAllowed, ok := bounds.MaxAllowed[name]; ok && minAllowed.Cmp(maxAllowed) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("minAllowed").Key(string(name)), minAllowed.String(), fmt.Sprintf("must not be greater than maxAllowed %s", maxAllowed.String())))
+		}
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceWindow(window *druidv1alpha1.MaintenanceWindow, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if window.Schedule == "" {
+		allErrs = append(allErrs, field.Required(path.Child("schedule"), "must specify a schedule"))
+	}
+	if window.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("duration"), window.Duration.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// validateAdditionalConfig validates that all etcd configuration options are supported and have a value of the type
+// expected by etcd.
+func validateAdditionalConfig(config map[string]string, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, option := range sets.List(sets.KeySet(config)) {
+		if !druidv1alpha1.IsAdditionalConfigOptionSupported(option) {
+			allErrs = append(allErrs, field.NotSupported(path, option, druidv1alpha1.GetSupportedAdditionalConfigOptions()))
+			continue
+		}
+		if _, err := druidv1alpha1.ParseAdditionalConfigValue(option, config[option]); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Key(option), config[option], err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateName validates that the given name is a DNS label which is not contained in the given names, and adds it.
+func validateName(name string, names sets.Set[string], path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if name == "" {
+		return append(allErrs, field.Required(path, "must specify a name"))
+	}
+	for _, msg := range validation.IsDNS1123Label(name) {
+		allErrs = append(allErrs, field.Invalid(path, name, msg))
+	}
+	if names.Has(name) {
+		allErrs = append(allErrs, field.Duplicate(path, name))
+	}
+	names.Insert(name)
+
 	return allErrs
 }
 
@@ -87,7 +237,8 @@ func validateStoreUpdate(new, old *druidv1alpha1.StoreSpec, path *field.Path) fi
 	return allErrs
 }
 
-// NOTE: Constants and storageProviderFromInfraProvider has been duplicated from etcd-druid/internal/store/store.go
+// NOTE: Constants, container names and storageProviderFromInfraProvider have been duplicated from etcd-druid/internal/store/store.go
+// and etcd-druid/internal/common/constants.go
 // Once the gardener adopts to using CEL expressions then the entire validation package will be removed.
 // Having a dependency to etcd-druid/internal within the API package is incorrect and should never be allowed.
 // Therefore, let this code be here for a while till the entire validation package itself gets removed.
@@ -119,6 +270,14 @@ const (
 	ocs = "OCS"
 )
 
+const (
+	containerNameEtcd          = "etcd"
+	containerNameBackupRestore = "backup-restore"
+)
+
+// reservedContainerNames are the names of the containers and init containers managed by etcd-druid.
+var reservedContainerNames = []string{containerNameEtcd, containerNameBackupRestore, "change-permissions", "change-backup-bucket-permissions"}
+
 // storageProviderFromInfraProvider converts infra to object store provider.
 func storageProviderFromInfraProvider(infra *druidv1alpha1.StorageProvider) (string, error) {
 	if infra == nil {
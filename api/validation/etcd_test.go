@@ -7,10 +7,13 @@ package validation
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -76,6 +79,32 @@ func TestValidateEtcd(t *testing.T) {
 			1,
 			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.backup.store.provider")}))),
 		},
+		{
+			"should fail when workload identity is configured for an unsupported provider",
+			etcdTestName,
+			etcdTestNamespace,
+			&druidv1alpha1.StoreSpec{
+				Prefix:           fmt.Sprintf("%s--%s/%s", etcdTestNamespace, testUUID, etcdTestName),
+				Provider:         (*druidv1alpha1.StorageProvider)(ptr.To("Swift")),
+				WorkloadIdentity: &druidv1alpha1.WorkloadIdentity{},
+			},
+			1,
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("spec.backup.store.workloadIdentity")}))),
+		},
+		{
+			"should allow etcd with workload identity and without secret",
+			etcdTestName,
+			etcdTestNamespace,
+			&druidv1alpha1.StoreSpec{
+				Prefix:   fmt.Sprintf("%s--%s/%s", etcdTestNamespace, testUUID, etcdTestName),
+				Provider: (*druidv1alpha1.StorageProvider)(ptr.To("aws")),
+				WorkloadIdentity: &druidv1alpha1.WorkloadIdentity{
+					ServiceAccountAnnotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/etcd-backup"},
+				},
+			},
+			0,
+			nil,
+		},
 		{
 			"should allow etcd with valid store config",
 			etcdTestName,
@@ -115,6 +144,208 @@ func TestValidateEtcd(t *testing.T) {
 	}
 }
 
+func TestValidatePodTemplateOverrides(t *testing.T) {
+	testCases := []struct {
+		description string
+		overrides   *druidv1alpha1.PodTemplateOverrides
+		errMatcher  gomegatypes.GomegaMatcher
+	}{
+		{
+			"should allow valid overrides",
+			&druidv1alpha1.PodTemplateOverrides{
+				NodeSelector:         map[string]string{"pool": "etcd"},
+				AdditionalContainers: []druidv1alpha1.AdditionalContainer{{Name: "log-shipper", Image: "log-shipper:v1"}},
+				AdditionalVolumes:    []druidv1alpha1.AdditionalVolume{{Name: "logs", EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				Containers: []druidv1alpha1.ContainerOverrides{
+					{
+						Name:         "etcd",
+						Env:          []corev1.EnvVar{{Name: "GOMAXPROCS", Value: "2"}},
+						VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/var/log/etcd"}},
+					},
+				},
+			},
+			BeEmpty(),
+		},
+		{
+			"should fail when an additional container uses a reserved name or has no image",
+			&druidv1alpha1.PodTemplateOverrides{
+				AdditionalContainers: []druidv1alpha1.AdditionalContainer{{Name: "backup-restore"}},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("spec.podTemplateOverrides.additionalContainers[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.podTemplateOverrides.additionalContainers[0].image")})),
+			),
+		},
+		{
+			"should fail when additional volumes have duplicate or invalid names",
+			&druidv1alpha1.PodTemplateOverrides{
+				AdditionalVolumes: []druidv1alpha1.AdditionalVolume{
+					{Name: "logs", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					{Name: "logs", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					{Name: "Invalid_Name", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("spec.podTemplateOverrides.additionalVolumes[1].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.podTemplateOverrides.additionalVolumes[2].name")})),
+			),
+		},
+		{
+			"should fail when an additional volume does not have exactly one volume source",
+			&druidv1alpha1.PodTemplateOverrides{
+				AdditionalVolumes: []druidv1alpha1.AdditionalVolume{
+					{Name: "logs"},
+					{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{}, Secret: &corev1.SecretVolumeSource{}},
+				},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.podTemplateOverrides.additionalVolumes[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.podTemplateOverrides.additionalVolumes[1]")})),
+			),
+		},
+		{
+			"should fail when overriding an unknown container or with incomplete entries",
+			&druidv1alpha1.PodTemplateOverrides{
+				Containers: []druidv1alpha1.ContainerOverrides{
+					{Name: "change-permissions"},
+					{Name: "backup-restore", Env: []corev1.EnvVar{{Value: "foo"}}, VolumeMounts: []corev1.VolumeMount{{Name: "logs"}}},
+				},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("spec.podTemplateOverrides.containers[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.podTemplateOverrides.containers[1].env[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.podTemplateOverrides.containers[1].volumeMounts[0].mountPath")})),
+			),
+		},
+	}
+
+	g := NewWithT(t)
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      etcdTestName,
+					Namespace: etcdTestNamespace,
+				},
+				Spec: druidv1alpha1.EtcdSpec{
+					PodTemplateOverrides: tc.overrides,
+				},
+			}
+			g.Expect(ValidateEtcd(etcd)).To(tc.errMatcher)
+		})
+	}
+}
+
+func TestValidateResourceRecommendationAndMaintenanceWindow(t *testing.T) {
+	testCases := []struct {
+		description            string
+		resourceRecommendation *druidv1alpha1.ResourceRecommendationSpec
+		maintenanceWindow      *druidv1alpha1.MaintenanceWindow
+		errMatcher             gomegatypes.GomegaMatcher
+	}{
+		{
+			"should allow valid bounds and maintenance window",
+			&druidv1alpha1.ResourceRecommendationSpec{
+				Apply: ptr.To(true),
+				Etcd: &druidv1alpha1.ResourceBounds{
+					MinAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					MaxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi"), corev1.ResourceCPU: resource.MustParse("4")},
+				},
+			},
+			&druidv1alpha1.MaintenanceWindow{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			BeEmpty(),
+		},
+		{
+			"should fail when a minimum allowed resource exceeds the maximum allowed resource",
+			&druidv1alpha1.ResourceRecommendationSpec{
+				BackupRestore: &druidv1alpha1.ResourceBounds{
+					MinAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+					MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+			nil,
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.resourceRecommendation.backupRestore.minAllowed[cpu]")})),
+			),
+		},
+		{
+			"should fail when the maintenance window has no schedule or duration",
+			nil,
+			&druidv1alpha1.MaintenanceWindow{},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.maintenanceWindow.schedule")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.maintenanceWindow.duration")})),
+			),
+		},
+	}
+
+	g := NewWithT(t)
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      etcdTestName,
+					Namespace: etcdTestNamespace,
+				},
+				Spec: druidv1alpha1.EtcdSpec{
+					ResourceRecommendation: tc.resourceRecommendation,
+					MaintenanceWindow:      tc.maintenanceWindow,
+				},
+			}
+			g.Expect(ValidateEtcd(etcd)).To(tc.errMatcher)
+		})
+	}
+}
+
+func TestValidateAdditionalConfig(t *testing.T) {
+	testCases := []struct {
+		description      string
+		additionalConfig map[string]string
+		errMatcher       gomegatypes.GomegaMatcher
+	}{
+		{
+			"should allow supported options with valid values",
+			map[string]string{"max-request-bytes": "2097152", "pre-vote": "true", "grpc-keepalive-timeout": "20s", "cipher-suites": "TLS_AES_128_GCM_SHA256, TLS_AES_256_GCM_SHA384"},
+			BeEmpty(),
+		},
+		{
+			"should fail for unsupported options",
+			map[string]string{"data-dir": "/tmp"},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("spec.etcd.additionalConfig"), "BadValue": Equal("data-dir")})),
+			),
+		},
+		{
+			"should fail for values which cannot be converted to the type of the option",
+			map[string]string{"max-txn-ops": "-1", "strict-reconfig-check": "maybe", "backend-batch-interval": "10"},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.etcd.additionalConfig[backend-batch-interval]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.etcd.additionalConfig[max-txn-ops]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.etcd.additionalConfig[strict-reconfig-check]")})),
+			),
+		},
+	}
+
+	g := NewWithT(t)
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      etcdTestName,
+					Namespace: etcdTestNamespace,
+				},
+				Spec: druidv1alpha1.EtcdSpec{
+					Etcd: druidv1alpha1.EtcdConfig{AdditionalConfig: tc.additionalConfig},
+				},
+			}
+			g.Expect(ValidateEtcd(etcd)).To(tc.errMatcher)
+		})
+	}
+}
+
 func TestEtcdUpdateWhenDeletionTimestampIsSet(t *testing.T) {
 	oldEtcd := &druidv1alpha1.Etcd{
 		ObjectMeta: metav1.ObjectMeta{
@@ -189,3 +420,33 @@ func TestAllowValidUpdatesToEtcd(t *testing.T) {
 	errs := ValidateEtcdUpdate(newEtcd, oldEtcd)
 	g.Expect(errs).To(HaveLen(0))
 }
+
+func TestPreventDisablingEncryptionWithEncryptedSnapshots(t *testing.T) {
+	oldEtcd := &druidv1alpha1.Etcd{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            etcdTestName,
+			Namespace:       etcdTestNamespace,
+			ResourceVersion: "1",
+		},
+		Spec: druidv1alpha1.EtcdSpec{
+			Replicas: int32(1),
+			Backup: druidv1alpha1.BackupSpec{
+				Store: &druidv1alpha1.StoreSpec{
+					Prefix:   fmt.Sprintf("%s--%s/%s", etcdTestNamespace, testUUID, etcdTestName),
+					Provider: ptr.To(druidv1alpha1.StorageProvider("S3")),
+				},
+				Encryption: &druidv1alpha1.EncryptionSpec{KeyID: "key-1"},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	newEtcd := oldEtcd.DeepCopy()
+	newEtcd.ResourceVersion = "2"
+	newEtcd.Spec.Backup.Encryption = nil
+	g.Expect(ValidateEtcdUpdate(newEtcd, oldEtcd)).To(BeEmpty())
+
+	oldEtcd.Status.BackupEncryptionKeyIDs = []string{"key-1"}
+	errs := ValidateEtcdUpdate(newEtcd, oldEtcd)
+	g.Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("spec.backup.encryption")}))))
+}
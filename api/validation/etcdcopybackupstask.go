@@ -37,8 +37,21 @@ func ValidateEtcdCopyBackupsTaskUpdate(new, old *druidv1alpha1.EtcdCopyBackupsTa
 func ValidateEtcdCopyBackupsTaskSpec(spec *druidv1alpha1.EtcdCopyBackupsTaskSpec, name, namespace string, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
-	allErrs = append(allErrs, validateStore(&spec.SourceStore, name, namespace, path.Child("sourceStore"))...)
-	allErrs = append(allErrs, validateStore(&spec.TargetStore, name, namespace, path.Child("targetStore"))...)
+	if isStoreSet(spec.SourceStore) == (spec.SourceEtcdRef != nil) {
+		allErrs = append(allErrs, field.Invalid(path.Child("sourceStore"), spec.SourceStore, "exactly one of sourceStore and sourceEtcdRef must be set"))
+	}
+	if isStoreSet(spec.SourceStore) {
+		allErrs = append(allErrs, validateStore(&spec.SourceStore, name, namespace, path.Child("sourceStore"))...)
+	}
+	if isStoreSet(spec.TargetStore) == (spec.TargetEtcdRef != nil) {
+		allErrs = append(allErrs, field.Invalid(path.Child("targetStore"), spec.TargetStore, "exactly one of targetStore and targetEtcdRef must be set"))
+	}
+	if isStoreSet(spec.TargetStore) {
+		allErrs = append(allErrs, validateStore(&spec.TargetStore, name, namespace, path.Child("targetStore"))...)
+	}
+	if spec.TriggerTargetEtcdReconcile && (spec.TargetEtcdRef == nil || spec.Schedule != nil) {
+		allErrs = append(allErrs, field.Invalid(path.Child("triggerTargetEtcdReconcile"), spec.TriggerTargetEtcdReconcile, "can only be enabled when targetEtcdRef is set and schedule is not set"))
+	}
 
 	return allErrs
 }
@@ -52,8 +65,21 @@ func ValidateEtcdCopyBackupsTaskSpecUpdate(new, old *druidv1alpha1.EtcdCopyBacku
 		return allErrs
 	}
 
-	allErrs = append(allErrs, validateStoreUpdate(&new.SourceStore, &old.SourceStore, path.Child("sourceStore"))...)
-	allErrs = append(allErrs, validateStoreUpdate(&new.TargetStore, &old.TargetStore, path.Child("targetStore"))...)
+	if (new.SourceEtcdRef != nil) != (old.SourceEtcdRef != nil) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("sourceEtcdRef"), "sourceStore and sourceEtcdRef cannot be interchanged after creation"))
+	} else if new.SourceEtcdRef == nil {
+		allErrs = append(allErrs, validateStoreUpdate(&new.SourceStore, &old.SourceStore, path.Child("sourceStore"))...)
+	}
+	if (new.TargetEtcdRef != nil) != (old.TargetEtcdRef != nil) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("targetEtcdRef"), "targetStore and targetEtcdRef cannot be interchanged after creation"))
+	} else if new.TargetEtcdRef == nil {
+		allErrs = append(allErrs, validateStoreUpdate(&new.TargetStore, &old.TargetStore, path.Child("targetStore"))...)
+	}
 
 	return allErrs
 }
+
+// isStoreSet returns true if the given store has been specified, i.e. it is not the zero value.
+func isStoreSet(store druidv1alpha1.StoreSpec) bool {
+	return store != druidv1alpha1.StoreSpec{}
+}
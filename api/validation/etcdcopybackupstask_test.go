@@ -11,6 +11,7 @@ import (
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -28,33 +29,82 @@ const (
 
 func TestValidateEtcdCopyBackupsTask(t *testing.T) {
 	testCases := []struct {
-		description  string
-		name         string
-		namespace    string
-		sourceStore  *druidv1alpha1.StoreSpec
-		targetStore  *druidv1alpha1.StoreSpec
-		expectedErrs int
-		errMatcher   gomegatypes.GomegaMatcher
+		description                string
+		name                       string
+		namespace                  string
+		sourceStore                druidv1alpha1.StoreSpec
+		targetStore                druidv1alpha1.StoreSpec
+		sourceEtcdRef              *corev1.LocalObjectReference
+		targetEtcdRef              *corev1.LocalObjectReference
+		triggerTargetEtcdReconcile bool
+		expectedErrs               int
+		errMatcher                 gomegatypes.GomegaMatcher
 	}{
 		{
-			description:  "should fail when no name and namespace is set",
-			name:         "",
-			namespace:    "",
-			expectedErrs: 2,
+			description:   "should fail when no name and namespace is set",
+			name:          "",
+			namespace:     "",
+			sourceEtcdRef: &corev1.LocalObjectReference{Name: "source-etcd"},
+			targetEtcdRef: &corev1.LocalObjectReference{Name: "target-etcd"},
+			expectedErrs:  2,
 			errMatcher: ConsistOf(
 				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("metadata.name")})),
 				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("metadata.namespace")})),
 			),
 		},
+		{
+			description:  "should fail when neither stores nor etcd references are set",
+			name:         etcdCopyBackupTestTaskName,
+			namespace:    etcdTestNamespace,
+			expectedErrs: 2,
+			errMatcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.sourceStore")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.targetStore")})),
+			),
+		},
+		{
+			description: "should fail when both a store and an etcd reference are set",
+			name:        etcdCopyBackupTestTaskName,
+			namespace:   etcdTestNamespace,
+			sourceStore: druidv1alpha1.StoreSpec{
+				Prefix: fmt.Sprintf("%s--%s/%s", etcdCopyBackupTestNamespace, sourceUUID, etcdCopyBackupTestTaskName),
+			},
+			sourceEtcdRef: &corev1.LocalObjectReference{Name: "source-etcd"},
+			targetEtcdRef: &corev1.LocalObjectReference{Name: "target-etcd"},
+			expectedErrs:  1,
+			errMatcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.sourceStore")})),
+			),
+		},
+		{
+			description:                "should fail when target etcd reconciliation is triggered without a target etcd reference",
+			name:                       etcdCopyBackupTestTaskName,
+			namespace:                  etcdTestNamespace,
+			sourceEtcdRef:              &corev1.LocalObjectReference{Name: "source-etcd"},
+			targetStore:                druidv1alpha1.StoreSpec{Prefix: fmt.Sprintf("%s--%s/%s", etcdCopyBackupTestNamespace, targetUUID, etcdCopyBackupTestTaskName)},
+			triggerTargetEtcdReconcile: true,
+			expectedErrs:               1,
+			errMatcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.triggerTargetEtcdReconcile")})),
+			),
+		},
+		{
+			description:                "should allow task with source and target etcd references",
+			name:                       etcdCopyBackupTestTaskName,
+			namespace:                  etcdTestNamespace,
+			sourceEtcdRef:              &corev1.LocalObjectReference{Name: "source-etcd"},
+			targetEtcdRef:              &corev1.LocalObjectReference{Name: "target-etcd"},
+			triggerTargetEtcdReconcile: true,
+		},
 		{
 			description: "should fail when invalid source and target source prefixes",
 			name:        etcdCopyBackupTestTaskName,
 			namespace:   etcdTestNamespace,
-			sourceStore: &druidv1alpha1.StoreSpec{
+			sourceStore: druidv1alpha1.StoreSpec{
 				Prefix:   "invalid-source",
 				Provider: ptr.To(druidv1alpha1.StorageProvider("not-supported")),
 			},
-			targetStore: &druidv1alpha1.StoreSpec{
+			targetStore: druidv1alpha1.StoreSpec{
 				Prefix:   "invalid-target",
 				Provider: ptr.To(druidv1alpha1.StorageProvider("not-supported")),
 			},
@@ -70,11 +120,11 @@ func TestValidateEtcdCopyBackupsTask(t *testing.T) {
 			description: "should allow task with valid source and target store config",
 			name:        etcdCopyBackupTestTaskName,
 			namespace:   etcdTestNamespace,
-			sourceStore: &druidv1alpha1.StoreSpec{
+			sourceStore: druidv1alpha1.StoreSpec{
 				Prefix:   fmt.Sprintf("%s--%s/%s", etcdCopyBackupTestNamespace, sourceUUID, etcdCopyBackupTestTaskName),
 				Provider: ptr.To(druidv1alpha1.StorageProvider("S3")),
 			},
-			targetStore: &druidv1alpha1.StoreSpec{
+			targetStore: druidv1alpha1.StoreSpec{
 				Prefix:   fmt.Sprintf("%s--%s/%s", etcdCopyBackupTestNamespace, targetUUID, etcdCopyBackupTestTaskName),
 				Provider: ptr.To(druidv1alpha1.StorageProvider("S3")),
 			},
@@ -90,12 +140,13 @@ func TestValidateEtcdCopyBackupsTask(t *testing.T) {
 					Name:      tc.name,
 					Namespace: tc.namespace,
 				},
-			}
-			if tc.sourceStore != nil {
-				task.Spec.SourceStore = *tc.sourceStore
-			}
-			if tc.targetStore != nil {
-				task.Spec.TargetStore = *tc.targetStore
+				Spec: druidv1alpha1.EtcdCopyBackupsTaskSpec{
+					SourceStore:                tc.sourceStore,
+					TargetStore:                tc.targetStore,
+					SourceEtcdRef:              tc.sourceEtcdRef,
+					TargetEtcdRef:              tc.targetEtcdRef,
+					TriggerTargetEtcdReconcile: tc.triggerTargetEtcdReconcile,
+				},
 			}
 
 			errs := ValidateEtcdCopyBackupsTask(task)
@@ -202,3 +253,38 @@ func TestAllowValidUpdatesToEtcdCopyBackupTask(t *testing.T) {
 	errs := ValidateEtcdCopyBackupsTaskUpdate(newTask, oldTask)
 	g.Expect(errs).To(HaveLen(0))
 }
+
+func TestPreventInterchangingStoresAndEtcdRefs(t *testing.T) {
+	oldTask := &druidv1alpha1.EtcdCopyBackupsTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            etcdCopyBackupTestTaskName,
+			Namespace:       etcdCopyBackupTestNamespace,
+			ResourceVersion: "1",
+		},
+		Spec: druidv1alpha1.EtcdCopyBackupsTaskSpec{
+			SourceStore: druidv1alpha1.StoreSpec{
+				Prefix:   fmt.Sprintf("%s--%s/%s", etcdCopyBackupTestNamespace, sourceUUID, etcdCopyBackupTestTaskName),
+				Provider: ptr.To(druidv1alpha1.StorageProvider("S3")),
+			},
+			TargetEtcdRef: &corev1.LocalObjectReference{Name: "target-etcd"},
+		},
+	}
+	newTask := oldTask.DeepCopy()
+	newTask.ResourceVersion = "2"
+	newTask.Spec.SourceStore = druidv1alpha1.StoreSpec{}
+	newTask.Spec.SourceEtcdRef = &corev1.LocalObjectReference{Name: "source-etcd"}
+	newTask.Spec.TargetEtcdRef = nil
+	newTask.Spec.TargetStore = druidv1alpha1.StoreSpec{
+		Prefix:   fmt.Sprintf("%s--%s/%s", etcdCopyBackupTestNamespace, targetUUID, etcdCopyBackupTestTaskName),
+		Provider: ptr.To(druidv1alpha1.StorageProvider("S3")),
+	}
+
+	g := NewWithT(t)
+	errs := ValidateEtcdCopyBackupsTaskUpdate(newTask, oldTask)
+	g.Expect(errs).To(
+		ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("spec.sourceEtcdRef")})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("spec.targetEtcdRef")})),
+		),
+	)
+}
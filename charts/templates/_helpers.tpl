@@ -42,6 +42,18 @@ config.yaml: |
       etcdMember:
         notReadyThreshold: {{ .Values.operatorConfig.controllers.etcd.etcdMember.notReadyThreshold }}
         unknownThreshold: {{ .Values.operatorConfig.controllers.etcd.etcdMember.unknownThreshold }}
+        recommendDivergentMemberReplacement: {{ .Values.operatorConfig.controllers.etcd.etcdMember.recommendDivergentMemberReplacement }}
+      leaderStability:
+        window: {{ .Values.operatorConfig.controllers.etcd.leaderStability.window }}
+        maxLeaderChanges: {{ .Values.operatorConfig.controllers.etcd.leaderStability.maxLeaderChanges }}
+      {{- with .Values.operatorConfig.controllers.etcd.extraChecks }}
+      extraChecks:
+        {{- toYaml . | nindent 8 }}
+      {{- end }}
+      resourceRecommendation:
+        enabled: {{ .Values.operatorConfig.controllers.etcd.resourceRecommendation.enabled }}
+        interval: {{ .Values.operatorConfig.controllers.etcd.resourceRecommendation.interval }}
+        halfLife: {{ .Values.operatorConfig.controllers.etcd.resourceRecommendation.halfLife }}
     compaction:
       enabled: {{ .Values.operatorConfig.controllers.compaction.enabled }}
       concurrentSyncs: {{ .Values.operatorConfig.controllers.compaction.concurrentSyncs }}
@@ -49,6 +61,12 @@ config.yaml: |
       triggerFullSnapshotThreshold: {{ .Values.operatorConfig.controllers.compaction.triggerFullSnapshotThreshold }}
       activeDeadlineDuration: {{ .Values.operatorConfig.controllers.compaction.activeDeadlineDuration }}
       metricsScrapeWaitDuration: {{ .Values.operatorConfig.controllers.compaction.metricsScrapeWaitDuration }}
+      {{- if .Values.operatorConfig.controllers.compaction.failureBackoff }}
+      failureBackoff:
+        initialDelay: {{ .Values.operatorConfig.controllers.compaction.failureBackoff.initialDelay }}
+        maxDelay: {{ .Values.operatorConfig.controllers.compaction.failureBackoff.maxDelay }}
+        failureThreshold: {{ .Values.operatorConfig.controllers.compaction.failureBackoff.failureThreshold }}
+      {{- end }}
     etcdCopyBackupsTask:
       enabled: {{ .Values.operatorConfig.controllers.etcdCopyBackupsTask.enabled }}
       concurrentSyncs: {{ .Values.operatorConfig.controllers.etcdCopyBackupsTask.concurrentSyncs }}
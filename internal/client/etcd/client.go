@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultTLSCASecretKey = "ca.crt"
+	// DialTimeout is the timeout for establishing a connection to etcd.
+	DialTimeout = 10 * time.Second
+	// RequestTimeout is the timeout for requests to etcd which are issued by etcd-druid.
+	RequestTimeout = 5 * time.Second
+)
+
+// MaintenanceClient is the part of the etcd client which is used to inspect the members of an etcd cluster.
+type MaintenanceClient interface {
+	clientv3.Cluster
+	clientv3.Maintenance
+	Close() error
+}
+
+// MaintenanceClientFactory creates a MaintenanceClient for the given endpoint.
+type MaintenanceClientFactory func(endpoint string, tlsConfig *tls.Config) (MaintenanceClient, error)
+
+// NewMaintenanceClient creates a MaintenanceClient for the given endpoint. If etcd's built-in authentication is
+// enabled, etcd-druid authenticates with the client certificate, which has been granted the root role.
+func NewMaintenanceClient(endpoint string, tlsConfig *tls.Config) (MaintenanceClient, error) {
+	return clientv3.New(clientv3.Config{
+		Endpoints:   []string{endpoint},
+		TLS:         tlsConfig,
+		DialTimeout: DialTimeout,
+		Logger:      zap.NewNop(),
+	})
+}
+
+// GetClientEndpoint returns the client endpoint of the etcd cluster of the given Etcd.
+func GetClientEndpoint(etcd *druidv1alpha1.Etcd) string {
+	scheme := "http"
+	if etcd.Spec.Etcd.ClientUrlTLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, druidv1alpha1.GetClientHostname(etcd), ptr.Deref(etcd.Spec.Etcd.ClientPort, common.DefaultPortEtcdClient))
+}
+
+// GetClientTLSConfig returns the TLS configuration to connect to etcd with the client certificate of the given Etcd,
+// together with the common name of the client certificate, which etcd uses as user name for certificate based authentication.
+func GetClientTLSConfig(ctx context.Context, cl client.Client, etcd *druidv1alpha1.Etcd) (*tls.Config, string, error) {
+	tlsConfig := etcd.Spec.Etcd.ClientUrlTLS
+	if tlsConfig == nil {
+		return nil, "", nil
+	}
+	caSecret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: tlsConfig.TLSCASecretRef.Name, Namespace: etcd.Namespace}, caSecret); err != nil {
+		return nil, "", fmt.Errorf("failed to get CA secret: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caSecret.Data[ptr.Deref(tlsConfig.TLSCASecretRef.DataKey, defaultTLSCASecretKey)]) {
+		return nil, "", fmt.Errorf("CA secret %s does not contain a valid CA bundle", tlsConfig.TLSCASecretRef.Name)
+	}
+	clientSecret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: tlsConfig.ClientTLSSecretRef.Name, Namespace: etcd.Namespace}, clientSecret); err != nil {
+		return nil, "", fmt.Errorf("failed to get client TLS secret: %w", err)
+	}
+	clientCert, err := tls.X509KeyPair(clientSecret.Data[corev1.TLSCertKey], clientSecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, "", fmt.Errorf("client TLS secret %s does not contain a valid certificate-key pair: %w", tlsConfig.ClientTLSSecretRef.Name, err)
+	}
+	leaf, err := x509.ParseCertificate(clientCert.Certificate[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse client certificate: %w", err)
+	}
+	return &tls.Config{
+		RootCAs:      caPool,
+		Certificates: []tls.Certificate{clientCert},
+		MinVersion:   tls.VersionTLS12,
+	}, leaf.Subject.CommonName, nil
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"sync"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StatusClient is a client to the etcd cluster of an Etcd which is shared by the checks of one status sync. The
+// connection to etcd is only established on first use and the responses of MemberList, AlarmList and Status are
+// cached, so that etcd is asked for them at most once per status sync. It is safe for concurrent use.
+type StatusClient struct {
+	cl                   client.Client
+	newMaintenanceClient MaintenanceClientFactory
+	etcd                 *druidv1alpha1.Etcd
+
+	mu         sync.Mutex
+	etcdCl     MaintenanceClient
+	memberList *clientv3.MemberListResponse
+	alarmList  *clientv3.AlarmResponse
+	statuses   map[string]*clientv3.StatusResponse
+}
+
+// NewStatusClient returns a StatusClient for the etcd cluster of the given Etcd, which creates its connection to etcd
+// with the given factory. It must be closed once the status sync is done.
+func NewStatusClient(cl client.Client, newMaintenanceClient MaintenanceClientFactory, etcd *druidv1alpha1.Etcd) *StatusClient {
+	return &StatusClient{
+		cl:                   cl,
+		newMaintenanceClient: newMaintenanceClient,
+		etcd:                 etcd,
+		statuses:             make(map[string]*clientv3.StatusResponse),
+	}
+}
+
+// Client returns the client to etcd, which is created on first use. It must not be closed by the caller.
+func (s *StatusClient) Client(ctx context.Context) (MaintenanceClient, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getClient(ctx)
+}
+
+// MemberList returns the members of the etcd cluster.
+func (s *StatusClient) MemberList(ctx context.Context) (*clientv3.MemberListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.memberList != nil {
+		return s.memberList, nil
+	}
+	etcdCl, err := s.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+	if s.memberList, err = etcdCl.MemberList(reqCtx); err != nil {
+		return nil, err
+	}
+	return s.memberList, nil
+}
+
+// AlarmList returns the alarms which are raised in the etcd cluster.
+func (s *StatusClient) AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.alarmList != nil {
+		return s.alarmList, nil
+	}
+	etcdCl, err := s.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+	if s.alarmList, err = etcdCl.AlarmList(reqCtx); err != nil {
+		return nil, err
+	}
+	return s.alarmList, nil
+}
+
+// Status returns the status of the member with the given endpoint.
+func (s *StatusClient) Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if status, ok := s.statuses[endpoint]; ok {
+		return status, nil
+	}
+	etcdCl, err := s.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+	status, err := etcdCl.Status(reqCtx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	s.statuses[endpoint] = status
+	return status, nil
+}
+
+// Close closes the connection to etcd, if it has been established.
+func (s *StatusClient) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.etcdCl == nil {
+		return nil
+	}
+	err := s.etcdCl.Close()
+	s.etcdCl = nil
+	return err
+}
+
+// getClient returns the client to etcd and creates it if it does not exist yet. It must be called with s.mu held.
+func (s *StatusClient) getClient(ctx context.Context) (MaintenanceClient, error) {
+	if s.etcdCl != nil {
+		return s.etcdCl, nil
+	}
+	tlsConfig, _, err := GetClientTLSConfig(ctx, s.cl, s.etcd)
+	if err != nil {
+		return nil, err
+	}
+	etcdCl, err := s.newMaintenanceClient(GetClientEndpoint(s.etcd), tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	s.etcdCl = etcdCl
+	return etcdCl, nil
+}
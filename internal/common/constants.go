@@ -9,6 +9,10 @@ const (
 	// place an annotation on the StatefulSet pods. The value contains the check-sum of the latest configmap that
 	// should be reflected on the pods.
 	CheckSumKeyConfigMap = "checksum/etcd-configmap"
+	// CheckSumKeySecrets is the key that is set by the StatefulSet component to place an annotation on the StatefulSet
+	// pods if the Etcd opted in to rolling its pods on secret changes. The value contains the check-sum of the TLS and
+	// backup store secrets referenced by the Etcd.
+	CheckSumKeySecrets = "checksum/etcd-secrets"
 )
 
 // LeaseAnnotationKeyPeerURLTLSEnabled is the annotation key present on the member lease.
@@ -16,6 +20,23 @@ const (
 // If the annotation is not present or its value is `false` then it indicates that the member is not TLS enabled.
 const LeaseAnnotationKeyPeerURLTLSEnabled = "member.etcd.gardener.cloud/tls-enabled"
 
+// LeaseAnnotationKeyRetainedSnapshots is the annotation key which is set by etcd-backup-restore on the full snapshot
+// lease if the Tiered garbage collection policy is used. Its value contains the number of retained full snapshots per
+// tier in the format `<interval>=<count>[,<interval>=<count>...]`.
+const LeaseAnnotationKeyRetainedSnapshots = "snapshot.etcd.gardener.cloud/retained-snapshots"
+
+// LeaseAnnotationKeySnapshots is the annotation key which is set by etcd-backup-restore on the full and delta snapshot
+// leases. Its value is a JSON list of the snapshots which have last been taken, i.e. the latest full snapshot on the
+// full snapshot lease and the delta snapshots taken after it on the delta snapshot lease.
+const LeaseAnnotationKeySnapshots = "snapshot.etcd.gardener.cloud/snapshots"
+
+// DefaultEtcdQuotaBytes is the quota of the backend database of etcd in bytes if no quota is specified in the Etcd.
+const DefaultEtcdQuotaBytes int64 = 8 * 1024 * 1024 * 1024 // 8Gi
+
+// DefaultQuotaUsageThresholdPercent is the size of the backend database of an etcd member, in percent of the quota,
+// above which the quota is considered to be close to being exceeded.
+const DefaultQuotaUsageThresholdPercent int32 = 80
+
 // Constants for image keys
 const (
 	// ImageKeyEtcd is the key for the etcd image in the image vector.
@@ -52,6 +73,9 @@ const (
 	DefaultPortEtcdPeer int32 = 2380
 	// DefaultPortEtcdClient is the default port for the etcd client.
 	DefaultPortEtcdClient int32 = 2379
+	// DefaultPortEtcdMetrics is the port on which etcd serves its metrics and health endpoints without TLS. It is only
+	// opened if probes are configured for the etcd container.
+	DefaultPortEtcdMetrics int32 = 2381
 	// DefaultPortEtcdWrapper is the default port for the etcd-wrapper HTTP server.
 	DefaultPortEtcdWrapper int32 = 9095
 	// DefaultPortEtcdBackupRestore is the default port for the HTTP server in the etcd-backup-restore container.
@@ -88,6 +112,16 @@ const (
 	EnvECSAccessKeyID = "ECS_ACCESS_KEY_ID"
 	// EnvECSSecretAccessKey is the environment variable key for Dell ECS secret access key.
 	EnvECSSecretAccessKey = "ECS_SECRET_ACCESS_KEY" // #nosec G101 -- this is the name of an env var, and not the credential itself.
+	// EnvAWSRoleARN is the environment variable key for the ARN of the AWS IAM role assumed with workload identity.
+	EnvAWSRoleARN = "AWS_ROLE_ARN"
+	// EnvAWSWebIdentityTokenFile is the environment variable key for the path to the service account token used for AWS workload identity.
+	EnvAWSWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	// EnvAzureClientID is the environment variable key for the client ID of the Azure identity used with workload identity.
+	EnvAzureClientID = "AZURE_CLIENT_ID"
+	// EnvAzureTenantID is the environment variable key for the tenant ID of the Azure identity used with workload identity.
+	EnvAzureTenantID = "AZURE_TENANT_ID"
+	// EnvAzureFederatedTokenFile is the environment variable key for the path to the service account token used for Azure workload identity.
+	EnvAzureFederatedTokenFile = "AZURE_FEDERATED_TOKEN_FILE"
 )
 
 // Constants for values to be set against druidv1alpha1.LabelComponentKey
@@ -112,6 +146,8 @@ const (
 	ComponentNameServiceAccount = "etcd-service-account"
 	// ComponentNameStatefulSet is the component name for statefulset resource.
 	ComponentNameStatefulSet = "etcd-statefulset"
+	// ComponentNameEtcdAuth is the component name for the resources used to manage etcd's built-in authentication.
+	ComponentNameEtcdAuth = "etcd-auth"
 	// ComponentNameSnapshotCompactionJob is the component name for snapshot compaction job resource.
 	ComponentNameSnapshotCompactionJob = "etcd-snapshot-compaction-job"
 	// ComponentNameEtcdCopyBackupsJob is the component name for copy-backup task resource.
@@ -149,6 +185,12 @@ const (
 	VolumeNameLocalBackup = "local-backup"
 	// VolumeNameProviderBackupSecret is the name of the volume that contains the provider backup secret.
 	VolumeNameProviderBackupSecret = "etcd-backup-secret" // #nosec G101 -- this is the name of the mounted volume for backup secret, and not the credential itself.
+	// VolumeNameWorkloadIdentityToken is the name of the volume that contains the service account token used to access the backup store with workload identity.
+	VolumeNameWorkloadIdentityToken = "workload-identity-token"
+	// VolumeNameBackupEncryption is the name of the volume that contains the keys or the key management service credentials used to encrypt snapshots.
+	VolumeNameBackupEncryption = "backup-encryption"
+	// VolumeNamePrefixSecondaryStore is the prefix of the names of the volumes that contain the credentials of the secondary backup stores.
+	VolumeNamePrefixSecondaryStore = "secondary-store-"
 )
 
 // EtcdConfigFileName is the name of the etcd configuration file.
@@ -180,6 +222,12 @@ const (
 	VolumeMountPathGCSBackupSecret = "/var/.gcp/" // #nosec G101 -- this is a path to the GCP backup credentials file, and not the credential itself.
 	// VolumeMountPathNonGCSProviderBackupSecret is the path on a container where the non-GCS provider backup secret is mounted.
 	VolumeMountPathNonGCSProviderBackupSecret = "/var/etcd-backup" // #nosec G101 -- this is a path to the backup credentials dir, and not the credential itself.
+	// VolumeMountPathWorkloadIdentityToken is the path on a container where the service account token used to access the backup store with workload identity is mounted.
+	VolumeMountPathWorkloadIdentityToken = "/var/run/secrets/druid.gardener.cloud/workload-identity"
+	// VolumeMountPathBackupEncryption is the path on a container where the keys or the key management service credentials used to encrypt snapshots are mounted.
+	VolumeMountPathBackupEncryption = "/var/etcdbr/encryption"
+	// VolumeMountPathSecondaryStores is the path on a container below which the credentials of the secondary backup stores are mounted.
+	VolumeMountPathSecondaryStores = "/var/etcdbr/secondary-stores"
 
 	// VolumeMountPathEtcdData is the path on a container where the etcd data directory is mounted.
 	VolumeMountPathEtcdData = "/var/etcd/data"
@@ -5,6 +5,7 @@
 package configmap
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -113,12 +114,48 @@ func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta meta
 	return nil
 }
 
+// ComputeCheckSum computes the checksum of the configmap for the given Etcd as it would be synced, without syncing it.
+func ComputeCheckSum(etcd *druidv1alpha1.Etcd) (string, error) {
+	cm := emptyConfigMap(getObjectKey(etcd.ObjectMeta))
+	if err := buildResource(etcd, cm); err != nil {
+		return "", err
+	}
+	return computeCheckSum(cm)
+}
+
+// IsQuotaRaised returns true if the quota of the backend database which would be synced for the given Etcd is higher
+// than the quota in the existing configmap. It returns false if the configmap does not exist yet.
+func IsQuotaRaised(ctx context.Context, cl client.Client, etcd *druidv1alpha1.Etcd) (bool, error) {
+	cm := &corev1.ConfigMap{}
+	if err := cl.Get(ctx, getObjectKey(etcd.ObjectMeta), cm); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	existingCfg := etcdConfig{}
+	if err := yaml.Unmarshal([]byte(cm.Data[common.EtcdConfigFileName]), &existingCfg); err != nil {
+		return false, err
+	}
+	return getDBQuotaBytes(etcd) > existingCfg.QuotaBackendBytes, nil
+}
+
 func buildResource(etcd *druidv1alpha1.Etcd, cm *corev1.ConfigMap) error {
 	cfg := createEtcdConfig(etcd)
 	cfgYaml, err := yaml.Marshal(cfg)
 	if err != nil {
 		return err
 	}
+	additionalCfg, err := createAdditionalEtcdConfig(etcd)
+	if err != nil {
+		return err
+	}
+	if len(additionalCfg) > 0 {
+		// additional options are appended instead of being merged into cfg to leave the generated configuration, and
+		// therefore the checksum of the ConfigMap, unchanged for Etcd resources which do not specify any.
+		additionalCfgYaml, err := yaml.Marshal(additionalCfg)
+		if err != nil {
+			return err
+		}
+		cfgYaml = append(cfgYaml, additionalCfgYaml...)
+	}
 	cm.Name = druidv1alpha1.GetConfigMapName(etcd.ObjectMeta)
 	cm.Namespace = etcd.Namespace
 	cm.Labels = getLabels(etcd)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/common"
@@ -20,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -201,6 +203,33 @@ func TestPrepareInitialCluster(t *testing.T) {
 	}
 }
 
+func TestCreateEtcdConfigWithProbes(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		probes                    *druidv1alpha1.ContainerProbes
+		expectedListenMetricsUrls string
+	}{
+		{
+			name: "should not open the metrics listener if no probes are configured",
+		},
+		{
+			name:                      "should open the metrics listener if probes are configured",
+			probes:                    &druidv1alpha1.ContainerProbes{Liveness: &druidv1alpha1.ProbeThresholds{}},
+			expectedListenMetricsUrls: "http://0.0.0.0:2381",
+		},
+	}
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := buildEtcd(3, true, true, nil)
+			etcd.Spec.Etcd.Probes = tc.probes
+			g.Expect(createEtcdConfig(etcd).ListenMetricsUrls).To(Equal(tc.expectedListenMetricsUrls))
+		})
+	}
+}
+
 func TestSyncWhenConfigMapExists(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -246,7 +275,131 @@ func TestSyncWhenConfigMapExists(t *testing.T) {
 	}
 }
 
+func TestSyncWithAdditionalConfig(t *testing.T) {
+	testCases := []struct {
+		name             string
+		additionalConfig map[string]string
+		expectedConfig   Keys
+		expectErr        bool
+	}{
+		{
+			name: "should merge additional config converted to the types expected by etcd",
+			additionalConfig: map[string]string{
+				"max-request-bytes":                       "10485760",
+				"experimental-compact-hash-check-enabled": "true",
+				"experimental-warning-apply-duration":     "200ms",
+				"log-level":                               "warn",
+				"cipher-suites":                           "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+			},
+			expectedConfig: Keys{
+				"max-request-bytes":                       BeNumerically("==", 10485760),
+				"experimental-compact-hash-check-enabled": BeTrue(),
+				"experimental-warning-apply-duration":     BeNumerically("==", 200*time.Millisecond),
+				"log-level":                               Equal("warn"),
+				"cipher-suites":                           ConsistOf("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"),
+			},
+		},
+		{
+			name:             "should return error for an option managed by etcd-druid",
+			additionalConfig: map[string]string{"quota-backend-bytes": "1024"},
+			expectErr:        true,
+		},
+		{
+			name:             "should return error for an unsupported option",
+			additionalConfig: map[string]string{"unsafe-no-fsync": "true"},
+			expectErr:        true,
+		},
+		{
+			name:             "should return error for an invalid value",
+			additionalConfig: map[string]string{"max-txn-ops": "-1"},
+			expectErr:        true,
+		},
+	}
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := buildEtcd(3, true, true, nil)
+			etcd.Spec.Etcd.AdditionalConfig = tc.additionalConfig
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil, getObjectKey(etcd.ObjectMeta))
+			operator := New(cl)
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			err := operator.Sync(opCtx, etcd)
+			if tc.expectErr {
+				g.Expect(druiderr.AsDruidError(err)).To(HaveField("Code", Equal(ErrSyncConfigMap)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			latestConfigMap, err := getLatestConfigMap(cl, etcd)
+			g.Expect(err).NotTo(HaveOccurred())
+			matchConfigMap(g, etcd, *latestConfigMap)
+			actualETCDConfig := make(map[string]any)
+			g.Expect(yaml.Unmarshal([]byte(latestConfigMap.Data[common.EtcdConfigFileName]), &actualETCDConfig)).To(Succeed())
+			g.Expect(actualETCDConfig).To(MatchKeys(IgnoreExtras, tc.expectedConfig))
+		})
+	}
+}
+
 // ----------------------------- TriggerDelete -------------------------------
+func TestComputeCheckSum(t *testing.T) {
+	g := NewWithT(t)
+	etcd := buildEtcd(3, true, true, nil)
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil, getObjectKey(etcd.ObjectMeta))
+	opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+	g.Expect(New(cl).Sync(opCtx, etcd)).To(Succeed())
+
+	checkSum, err := ComputeCheckSum(etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(checkSum).To(Equal(opCtx.Data[common.CheckSumKeyConfigMap]))
+}
+
+func TestIsQuotaRaised(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cmExists     bool
+		quota        *resource.Quantity
+		expectRaised bool
+	}{
+		{
+			name:  "quota is not raised if no configmap exists",
+			quota: resource.NewQuantity(16*1024*1024*1024, resource.BinarySI),
+		},
+		{
+			name:     "quota is not raised if it is unchanged",
+			cmExists: true,
+		},
+		{
+			name:     "quota is not raised if it is lowered",
+			cmExists: true,
+			quota:    resource.NewQuantity(4*1024*1024*1024, resource.BinarySI),
+		},
+		{
+			name:         "quota is raised if it is higher than the quota in the configmap",
+			cmExists:     true,
+			quota:        resource.NewQuantity(16*1024*1024*1024, resource.BinarySI),
+			expectRaised: true,
+		},
+	}
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := buildEtcd(3, true, true, nil)
+			var existingObjects []client.Object
+			if tc.cmExists {
+				existingObjects = append(existingObjects, newConfigMap(g, etcd))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects, getObjectKey(etcd.ObjectMeta))
+			etcd.Spec.Etcd.Quota = tc.quota
+			raised, err := IsQuotaRaised(context.Background(), cl, etcd)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(raised).To(Equal(tc.expectRaised))
+		})
+	}
+}
+
 func TestTriggerDelete(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -6,12 +6,14 @@ package configmap
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/common"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 )
 
@@ -45,6 +47,7 @@ type etcdConfig struct {
 	AutoCompactionRetention string                       `json:"auto-compaction-retention"`
 	ListenPeerUrls          string                       `json:"listen-peer-urls"`
 	ListenClientUrls        string                       `json:"listen-client-urls"`
+	ListenMetricsUrls       string                       `json:"listen-metrics-urls,omitempty"`
 	AdvertisePeerUrls       map[string][]string          `json:"initial-advertise-peer-urls"`
 	AdvertiseClientUrls     map[string][]string          `json:"advertise-client-urls"`
 	ClientSecurity          *securityConfig              `json:"client-transport-security,omitempty"`
@@ -83,12 +86,52 @@ func createEtcdConfig(etcd *druidv1alpha1.Etcd) *etcdConfig {
 		AdvertiseClientUrls:          getAdvertiseURLs(etcd, advertiseURLTypeClient, clientScheme, peerSvcName),
 		NextClusterVersionCompatible: true,
 	}
+	if etcd.Spec.Etcd.Probes != nil {
+		// The liveness and startup probes of the etcd container target the health endpoint, which etcd only serves
+		// without TLS on the metrics listener.
+		cfg.ListenMetricsUrls = fmt.Sprintf("http://0.0.0.0:%d", common.DefaultPortEtcdMetrics)
+	}
 	cfg.PeerSecurity = peerSecurityConfig
 	cfg.ClientSecurity = clientSecurityConfig
 
 	return cfg
 }
 
+// druidOwnedConfigKeys contains the etcd configuration options which are generated by etcd-druid.
+var druidOwnedConfigKeys = getJSONFieldNames(reflect.TypeOf(etcdConfig{}))
+
+// createAdditionalEtcdConfig converts the additional etcd configuration options of the given Etcd to the types expected
+// by etcd. An error is returned for options which are managed by etcd-druid, are not supported or have an invalid value.
+func createAdditionalEtcdConfig(etcd *druidv1alpha1.Etcd) (map[string]any, error) {
+	if len(etcd.Spec.Etcd.AdditionalConfig) == 0 {
+		return nil, nil
+	}
+	cfg := make(map[string]any, len(etcd.Spec.Etcd.AdditionalConfig))
+	for key, value := range etcd.Spec.Etcd.AdditionalConfig {
+		if druidOwnedConfigKeys.Has(key) {
+			return nil, fmt.Errorf("etcd configuration option %s is managed by etcd-druid and cannot be overridden", key)
+		}
+		if !druidv1alpha1.IsAdditionalConfigOptionSupported(key) {
+			return nil, fmt.Errorf("etcd configuration option %s is not supported", key)
+		}
+		convertedValue, err := druidv1alpha1.ParseAdditionalConfigValue(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for etcd configuration option %s: %w", value, key, err)
+		}
+		cfg[key] = convertedValue
+	}
+	return cfg, nil
+}
+
+func getJSONFieldNames(t reflect.Type) sets.Set[string] {
+	names := sets.New[string]()
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		names.Insert(name)
+	}
+	return names
+}
+
 func getSnapshotCount(etcd *druidv1alpha1.Etcd) int64 {
 	if etcd.Spec.Etcd.SnapshotCount != nil {
 		return *etcd.Spec.Etcd.SnapshotCount
@@ -97,8 +140,8 @@ func getSnapshotCount(etcd *druidv1alpha1.Etcd) int64 {
 }
 
 func getDBQuotaBytes(etcd *druidv1alpha1.Etcd) int64 {
-	if etcd.Spec.Etcd.Quota != nil {
-		return etcd.Spec.Etcd.Quota.Value()
+	if quota := druidv1alpha1.GetQuota(etcd); quota != nil {
+		return quota.Value()
 	}
 	return defaultDBQuotaBytes
 }
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// desiredAuth is the desired state of etcd's built-in authentication.
+type desiredAuth struct {
+	rootPassword string
+	// clientCertCommonName is the common name of the client certificate which is used by etcd-druid's components.
+	// It is granted the root role, as etcd uses it as user name for clients which authenticate with the certificate.
+	clientCertCommonName string
+	roles                []druidv1alpha1.EtcdAuthRole
+	users                []druidv1alpha1.EtcdAuthUser
+	// passwords contains the passwords of the users by their names. Users without a password authenticate with a client
+	// certificate only.
+	passwords map[string]string
+}
+
+var permissionTypes = map[druidv1alpha1.EtcdAuthPermissionType]clientv3.PermissionType{
+	druidv1alpha1.EtcdAuthPermissionRead:      clientv3.PermissionType(clientv3.PermRead),
+	druidv1alpha1.EtcdAuthPermissionWrite:     clientv3.PermissionType(clientv3.PermWrite),
+	druidv1alpha1.EtcdAuthPermissionReadWrite: clientv3.PermissionType(clientv3.PermReadWrite),
+}
+
+// syncState is the state of the sync of etcd auth which is persisted in the root secret.
+type syncState struct {
+	// LastSyncTime is the time of the last successful sync.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// Users are the names of the users which have been created by etcd-druid. Only these are removed once they are not
+	// desired anymore, users which have been created by others are left untouched.
+	Users []string `json:"users,omitempty"`
+	// Roles are the names of the roles which have been created by etcd-druid. Only these are removed once they are not
+	// desired anymore, roles which have been created by others are left untouched.
+	Roles []string `json:"roles,omitempty"`
+	// PasswordChecksums are the checksums of the passwords which have last been verified or set for the users, by
+	// their names. The password of a user is only verified against etcd if its checksum has changed.
+	PasswordChecksums map[string]string `json:"passwordChecksums,omitempty"`
+}
+
+// reconcileUsersAndRoles reconciles the users and roles in etcd with the desired ones and enables authentication.
+// Users and roles which have been created by etcd-druid and are not desired anymore are removed. The given state is
+// updated with the users and roles created or removed, also if an error is returned.
+func reconcileUsersAndRoles(ctx context.Context, cl clientv3.Auth, desired desiredAuth, state *syncState) error {
+	status, err := cl.AuthStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get auth status: %w", err)
+	}
+	if err = reconcileRoles(ctx, cl, desired.roles, state); err != nil {
+		return err
+	}
+	internalUsers := map[string]*clientv3.UserAddOptions{rootUser: {NoPassword: false}}
+	if desired.clientCertCommonName != "" && desired.clientCertCommonName != rootUser {
+		internalUsers[desired.clientCertCommonName] = &clientv3.UserAddOptions{NoPassword: true}
+	}
+	if err = reconcileInternalUsers(ctx, cl, internalUsers, desired.rootPassword, status.Enabled, state); err != nil {
+		return err
+	}
+	if err = reconcileUsers(ctx, cl, desired.users, desired.passwords, sets.KeySet(internalUsers), status.Enabled, state); err != nil {
+		return err
+	}
+	if !status.Enabled {
+		if _, err = cl.AuthEnable(ctx); err != nil {
+			return fmt.Errorf("failed to enable auth: %w", err)
+		}
+	}
+	return nil
+}
+
+func disableEtcdAuth(ctx context.Context, cl clientv3.Auth) error {
+	status, err := cl.AuthStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get auth status: %w", err)
+	}
+	if !status.Enabled {
+		return nil
+	}
+	if _, err = cl.AuthDisable(ctx); err != nil {
+		return fmt.Errorf("failed to disable auth: %w", err)
+	}
+	return nil
+}
+
+func reconcileRoles(ctx context.Context, cl clientv3.Auth, roles []druidv1alpha1.EtcdAuthRole, state *syncState) error {
+	resp, err := cl.RoleList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+	existingRoles := sets.New(resp.Roles...)
+	managedRoles := sets.New(state.Roles...).Intersection(existingRoles)
+	defer func() { state.Roles = sets.List(managedRoles) }()
+	desiredRoles := sets.New(rootUser)
+	if !existingRoles.Has(rootUser) {
+		if _, err = cl.RoleAdd(ctx, rootUser); err != nil {
+			return fmt.Errorf("failed to add role %s: %w", rootUser, err)
+		}
+	}
+	for _, role := range roles {
+		desiredRoles.Insert(role.Name)
+		if !existingRoles.Has(role.Name) {
+			if _, err = cl.RoleAdd(ctx, role.Name); err != nil {
+				return fmt.Errorf("failed to add role %s: %w", role.Name, err)
+			}
+			managedRoles.Insert(role.Name)
+		}
+		if err = reconcilePermissions(ctx, cl, role); err != nil {
+			return err
+		}
+	}
+	for _, role := range sets.List(managedRoles.Difference(desiredRoles)) {
+		if _, err = cl.RoleDelete(ctx, role); err != nil {
+			return fmt.Errorf("failed to delete role %s: %w", role, err)
+		}
+		managedRoles.Delete(role)
+	}
+	return nil
+}
+
+func reconcilePermissions(ctx context.Context, cl clientv3.Auth, role druidv1alpha1.EtcdAuthRole) error {
+	resp, err := cl.RoleGet(ctx, role.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get role %s: %w", role.Name, err)
+	}
+	type keyRange struct{ key, rangeEnd string }
+	existingPermissions := make(map[keyRange]clientv3.PermissionType, len(resp.Perm))
+	for _, perm := range resp.Perm {
+		existingPermissions[keyRange{string(perm.Key), string(perm.RangeEnd)}] = clientv3.PermissionType(perm.PermType)
+	}
+	desiredPermissions := make(map[keyRange]struct{}, len(role.Permissions))
+	for _, perm := range role.Permissions {
+		kr := keyRange{perm.KeyPrefix, clientv3.GetPrefixRangeEnd(perm.KeyPrefix)}
+		desiredPermissions[kr] = struct{}{}
+		permType, ok := permissionTypes[perm.Type]
+		if !ok {
+			return fmt.Errorf("unsupported permission type %s of role %s", perm.Type, role.Name)
+		}
+		if existingPermType, exists := existingPermissions[kr]; exists && existingPermType == permType {
+			continue
+		}
+		if _, err = cl.RoleGrantPermission(ctx, role.Name, kr.key, kr.rangeEnd, permType); err != nil {
+			return fmt.Errorf("failed to grant permission on prefix %s to role %s: %w", perm.KeyPrefix, role.Name, err)
+		}
+	}
+	for kr := range existingPermissions {
+		if _, ok := desiredPermissions[kr]; ok {
+			continue
+		}
+		if _, err = cl.RoleRevokePermission(ctx, role.Name, kr.key, kr.rangeEnd); err != nil {
+			return fmt.Errorf("failed to revoke permission on key %s from role %s: %w", kr.key, role.Name, err)
+		}
+	}
+	return nil
+}
+
+// reconcileInternalUsers ensures that the users used by etcd-druid exist and are granted the root role. The password
+// of the root user is only reset while authentication is disabled, as etcd-druid is authenticated as root user otherwise.
+func reconcileInternalUsers(ctx context.Context, cl clientv3.Auth, users map[string]*clientv3.UserAddOptions, rootPassword string, authEnabled bool, state *syncState) error {
+	resp, err := cl.UserList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	existingUsers := sets.New(resp.Users...)
+	for _, user := range sets.List(sets.KeySet(users)) {
+		password := ""
+		if !users[user].NoPassword {
+			password = rootPassword
+		}
+		if !existingUsers.Has(user) {
+			if _, err = cl.UserAddWithOptions(ctx, user, password, users[user]); err != nil {
+				return fmt.Errorf("failed to add user %s: %w", user, err)
+			}
+			state.Users = sets.List(sets.New(state.Users...).Insert(user))
+		} else if !authEnabled && password != "" {
+			if _, err = cl.UserChangePassword(ctx, user, password); err != nil {
+				return fmt.Errorf("failed to change password of user %s: %w", user, err)
+			}
+		}
+		if err = reconcileUserRoles(ctx, cl, user, []string{rootUser}, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func reconcileUsers(ctx context.Context, cl clientv3.Auth, users []druidv1alpha1.EtcdAuthUser, passwords map[string]string, internalUsers sets.Set[string], authEnabled bool, state *syncState) error {
+	resp, err := cl.UserList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	existingUsers := sets.New(resp.Users...)
+	managedUsers := sets.New(state.Users...).Intersection(existingUsers)
+	passwordChecksums := make(map[string]string, len(passwords))
+	defer func() {
+		state.Users = sets.List(managedUsers)
+		state.PasswordChecksums = passwordChecksums
+	}()
+	desiredUsers := internalUsers.Clone()
+	for _, user := range users {
+		desiredUsers.Insert(user.Name)
+		password, hasPassword := passwords[user.Name]
+		checksum := utils.ComputeSHA256Hex([]byte(password))
+		if !existingUsers.Has(user.Name) {
+			if _, err = cl.UserAddWithOptions(ctx, user.Name, password, &clientv3.UserAddOptions{NoPassword: !hasPassword}); err != nil {
+				return fmt.Errorf("failed to add user %s: %w", user.Name, err)
+			}
+			managedUsers.Insert(user.Name)
+		} else if hasPassword && state.PasswordChecksums[user.Name] != checksum {
+			matches, err := passwordMatches(ctx, cl, user.Name, password, authEnabled)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				if _, err = cl.UserChangePassword(ctx, user.Name, password); err != nil {
+					return fmt.Errorf("failed to change password of user %s: %w", user.Name, err)
+				}
+			}
+		}
+		if hasPassword {
+			passwordChecksums[user.Name] = checksum
+		}
+		if err = reconcileUserRoles(ctx, cl, user.Name, user.Roles, true); err != nil {
+			return err
+		}
+	}
+	for _, user := range sets.List(managedUsers.Difference(desiredUsers)) {
+		if _, err = cl.UserDelete(ctx, user); err != nil {
+			return fmt.Errorf("failed to delete user %s: %w", user, err)
+		}
+		managedUsers.Delete(user)
+	}
+	return nil
+}
+
+// passwordMatches checks whether the given password is the current password of the user by authenticating with it.
+// While authentication is disabled this is not possible, therefore the password is considered to differ.
+func passwordMatches(ctx context.Context, cl clientv3.Auth, user, password string, authEnabled bool) (bool, error) {
+	if !authEnabled {
+		return false, nil
+	}
+	if _, err := cl.Authenticate(ctx, user, password); err != nil {
+		if errors.Is(err, rpctypes.ErrAuthFailed) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to authenticate user %s: %w", user, err)
+	}
+	return true, nil
+}
+
+// reconcileUserRoles grants the given roles to the user. If revoke is true, roles which are not given are revoked.
+func reconcileUserRoles(ctx context.Context, cl clientv3.Auth, user string, roles []string, revoke bool) error {
+	resp, err := cl.UserGet(ctx, user)
+	if err != nil {
+		return fmt.Errorf("failed to get user %s: %w", user, err)
+	}
+	existingRoles, desiredRoles := sets.New(resp.Roles...), sets.New(roles...)
+	for _, role := range sets.List(desiredRoles.Difference(existingRoles)) {
+		if _, err = cl.UserGrantRole(ctx, user, role); err != nil {
+			return fmt.Errorf("failed to grant role %s to user %s: %w", role, user, err)
+		}
+	}
+	if !revoke {
+		return nil
+	}
+	for _, role := range sets.List(existingRoles.Difference(desiredRoles)) {
+		if _, err = cl.UserRevokeRole(ctx, user, role); err != nil {
+			return fmt.Errorf("failed to revoke role %s from user %s: %w", role, user, err)
+		}
+	}
+	return nil
+}
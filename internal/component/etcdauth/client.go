@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"crypto/tls"
+
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// authClient is the part of the etcd client which is used to manage etcd's built-in authentication.
+type authClient interface {
+	clientv3.Auth
+	Close() error
+}
+
+// authClientFactory creates an authClient for the given endpoint which authenticates with the given credentials.
+type authClientFactory func(endpoint string, tlsConfig *tls.Config, username, password string) (authClient, error)
+
+func newEtcdAuthClient(endpoint string, tlsConfig *tls.Config, username, password string) (authClient, error) {
+	return clientv3.New(clientv3.Config{
+		Endpoints:   []string{endpoint},
+		TLS:         tlsConfig,
+		Username:    username,
+		Password:    password,
+		DialTimeout: etcdclient.DialTimeout,
+		Logger:      zap.NewNop(),
+	})
+}
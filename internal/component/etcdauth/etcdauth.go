@@ -0,0 +1,382 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ErrGetEtcdAuth indicates an error in getting the resources used to manage etcd's built-in authentication.
+	ErrGetEtcdAuth druidapicommon.ErrorCode = "ERR_GET_ETCD_AUTH"
+	// ErrSyncEtcdAuth indicates an error in syncing the users and roles of etcd's built-in authentication.
+	ErrSyncEtcdAuth druidapicommon.ErrorCode = "ERR_SYNC_ETCD_AUTH"
+	// ErrDeleteEtcdAuth indicates an error in deleting the resources used to manage etcd's built-in authentication.
+	ErrDeleteEtcdAuth druidapicommon.ErrorCode = "ERR_DELETE_ETCD_AUTH"
+)
+
+// SyncPendingAnnotation is set on the root secret while the sync of etcd auth is skipped because the etcd cluster has no
+// quorum, it is removed once the sync has succeeded.
+const SyncPendingAnnotation = "druid.gardener.cloud/etcd-auth-sync-pending"
+
+const (
+	// resyncPeriod is the period after which the users and roles are synced again to correct any drift in etcd.
+	resyncPeriod = 10 * time.Minute
+	// syncStateKey is the key of the root secret which contains the state of the sync of etcd auth.
+	syncStateKey = "sync-state"
+	// rootUser is the name of the etcd root user and role.
+	rootUser = "root"
+	// rootPasswordKey is the key of the root secret which contains the password of the root user.
+	rootPasswordKey = "password"
+	// rootPasswordLength is the number of random bytes of a generated root password.
+	rootPasswordLength = 32
+)
+
+type _resource struct {
+	client        client.Client
+	newAuthClient authClientFactory
+}
+
+// New returns a new etcd auth component operator.
+func New(client client.Client) component.Operator {
+	return &_resource{
+		client:        client,
+		newAuthClient: newEtcdAuthClient,
+	}
+}
+
+// GetExistingResourceNames returns the name of the existing root secret for the given Etcd.
+func (r _resource) GetExistingResourceNames(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) ([]string, error) {
+	resourceNames := make([]string, 0, 1)
+	objectKey := getObjectKey(etcdObjMeta)
+	objMeta := &metav1.PartialObjectMetadata{}
+	objMeta.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	if err := r.client.Get(ctx, objectKey, objMeta); err != nil {
+		if errors.IsNotFound(err) {
+			return resourceNames, nil
+		}
+		return resourceNames, druiderr.WrapError(err,
+			ErrGetEtcdAuth,
+			component.OperationGetExistingResourceNames,
+			fmt.Sprintf("Error getting root secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+	}
+	if metav1.IsControlledBy(objMeta, &etcdObjMeta) {
+		resourceNames = append(resourceNames, objMeta.Name)
+	}
+	return resourceNames, nil
+}
+
+// PreSync is a no-op for the etcd auth component.
+func (r _resource) PreSync(_ component.OperatorContext, _ *druidv1alpha1.Etcd) error { return nil }
+
+// Sync reconciles the users and roles of etcd's built-in authentication for the given Etcd through the etcd auth API.
+// If authentication is not configured (anymore), it is disabled in case it has been enabled by etcd-druid before. As long
+// as the etcd cluster has no quorum, the sync is skipped and marked as pending on the root secret instead.
+func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
+	if etcd.Spec.Etcd.Auth == nil {
+		return r.disableAuth(ctx, etcd)
+	}
+	rootSecret, err := r.getOrCreateRootSecret(ctx, etcd)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error during create of root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if etcd.Spec.Replicas == 0 {
+		return nil
+	}
+	if !HasQuorum(etcd) {
+		ctx.Logger.Info("Skipping sync of etcd auth as the etcd cluster has no quorum, it is retried once the quorum is restored", "component", "etcd-auth")
+		return r.setSyncPending(ctx, etcd, true)
+	}
+	state, err := getSyncState(rootSecret)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error reading sync state of etcd auth from root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if _, ok := rootSecret.Data[syncStateKey]; !ok {
+		// Before the sync state has been tracked, all users and roles of the spec have been managed by etcd-druid.
+		for _, user := range etcd.Spec.Etcd.Auth.Users {
+			state.Users = append(state.Users, user.Name)
+		}
+		for _, role := range etcd.Spec.Etcd.Auth.Roles {
+			state.Roles = append(state.Roles, role.Name)
+		}
+	}
+	syncErr := r.reconcileAuth(ctx, etcd, string(rootSecret.Data[rootPasswordKey]), state)
+	// The state is saved also if the sync has failed, so that users and roles which have been created are tracked.
+	if err = r.saveSyncState(ctx, rootSecret, state, syncErr == nil); err != nil {
+		return err
+	}
+	if syncErr != nil {
+		return druiderr.WrapError(syncErr,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error during reconciliation of etcd auth for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	ctx.Logger.Info("synced", "component", "etcd-auth", "users", len(etcd.Spec.Etcd.Auth.Users), "roles", len(etcd.Spec.Etcd.Auth.Roles))
+	return nil
+}
+
+// HasQuorum returns true if enough members of the given Etcd are ready to form a quorum, which is required to change
+// the users and roles of etcd's built-in authentication.
+func HasQuorum(etcd *druidv1alpha1.Etcd) bool {
+	return etcd.Spec.Replicas > 0 && etcd.Status.ReadyReplicas >= etcd.Spec.Replicas/2+1
+}
+
+// IsSyncDue returns true if the sync of etcd's built-in authentication for the given Etcd has been skipped because its
+// etcd cluster had no quorum, or if the users and roles have not been synced within the resync period, so that any drift
+// in etcd is corrected.
+func IsSyncDue(ctx context.Context, cl client.Client, etcdObjMeta metav1.ObjectMeta) (bool, error) {
+	rootSecret := &corev1.Secret{}
+	if err := cl.Get(ctx, getObjectKey(etcdObjMeta), rootSecret); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if metav1.HasAnnotation(rootSecret.ObjectMeta, SyncPendingAnnotation) {
+		return true, nil
+	}
+	state, err := getSyncState(rootSecret)
+	if err != nil {
+		return false, err
+	}
+	return state.LastSyncTime == nil || time.Since(state.LastSyncTime.Time) >= resyncPeriod, nil
+}
+
+// TriggerDelete triggers the deletion of the root secret for the given Etcd.
+func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) error {
+	objectKey := getObjectKey(etcdObjMeta)
+	ctx.Logger.Info("Triggering deletion of root secret", "objectKey", objectKey)
+	if err := r.client.Delete(ctx, emptySecret(objectKey)); err != nil {
+		if errors.IsNotFound(err) {
+			ctx.Logger.Info("No root secret found, Deletion is a No-Op", "objectKey", objectKey)
+			return nil
+		}
+		return druiderr.WrapError(err,
+			ErrDeleteEtcdAuth,
+			component.OperationTriggerDelete,
+			fmt.Sprintf("Failed to delete root secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+	}
+	ctx.Logger.Info("deleted", "component", "etcd-auth", "objectKey", objectKey)
+	return nil
+}
+
+func (r _resource) reconcileAuth(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, rootPassword string, state *syncState) error {
+	passwords, err := r.getUserPasswords(ctx, etcd)
+	if err != nil {
+		return err
+	}
+	tlsConfig, clientCertCommonName, err := etcdclient.GetClientTLSConfig(ctx, r.client, etcd)
+	if err != nil {
+		return err
+	}
+	authCl, err := r.newAuthClient(etcdclient.GetClientEndpoint(etcd), tlsConfig, rootUser, rootPassword)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = authCl.Close() }()
+
+	return reconcileUsersAndRoles(ctx, authCl, desiredAuth{
+		rootPassword:         rootPassword,
+		clientCertCommonName: clientCertCommonName,
+		roles:                etcd.Spec.Etcd.Auth.Roles,
+		users:                etcd.Spec.Etcd.Auth.Users,
+		passwords:            passwords,
+	}, state)
+}
+
+// disableAuth disables etcd's built-in authentication if it has been enabled by etcd-druid, which is indicated by the
+// existence of the root secret. The root secret is only deleted once authentication has been disabled.
+func (r _resource) disableAuth(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
+	rootSecret := &corev1.Secret{}
+	if err := r.client.Get(ctx, getObjectKey(etcd.ObjectMeta), rootSecret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return druiderr.WrapError(err,
+			ErrGetEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error getting root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if etcd.Spec.Replicas == 0 {
+		return nil
+	}
+	if !HasQuorum(etcd) {
+		ctx.Logger.Info("Skipping disabling of etcd auth as the etcd cluster has no quorum, it is retried once the quorum is restored", "component", "etcd-auth")
+		return r.setSyncPending(ctx, etcd, true)
+	}
+	tlsConfig, _, err := etcdclient.GetClientTLSConfig(ctx, r.client, etcd)
+	if err == nil {
+		var authCl authClient
+		if authCl, err = r.newAuthClient(etcdclient.GetClientEndpoint(etcd), tlsConfig, rootUser, string(rootSecret.Data[rootPasswordKey])); err == nil {
+			err = disableEtcdAuth(ctx, authCl)
+			_ = authCl.Close()
+		}
+	}
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error disabling etcd auth for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if err = client.IgnoreNotFound(r.client.Delete(ctx, rootSecret)); err != nil {
+		return druiderr.WrapError(err,
+			ErrDeleteEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Failed to delete root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	ctx.Logger.Info("disabled etcd auth", "component", "etcd-auth")
+	return nil
+}
+
+// setSyncPending adds or removes the annotation of the root secret which marks the sync of etcd auth as pending.
+func (r _resource) setSyncPending(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, pending bool) error {
+	rootSecret := &corev1.Secret{}
+	if err := r.client.Get(ctx, getObjectKey(etcd.ObjectMeta), rootSecret); err != nil {
+		return druiderr.WrapError(err,
+			ErrGetEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error getting root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if metav1.HasAnnotation(rootSecret.ObjectMeta, SyncPendingAnnotation) == pending {
+		return nil
+	}
+	originalRootSecret := rootSecret.DeepCopy()
+	if pending {
+		metav1.SetMetaDataAnnotation(&rootSecret.ObjectMeta, SyncPendingAnnotation, "true")
+	} else {
+		delete(rootSecret.Annotations, SyncPendingAnnotation)
+	}
+	if err := r.client.Patch(ctx, rootSecret, client.MergeFrom(originalRootSecret)); err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error updating root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	return nil
+}
+
+// saveSyncState stores the given state of the sync of etcd auth in the root secret. If synced is true, the time of the
+// last sync is set to now and the annotation which marks the sync as pending is removed.
+func (r _resource) saveSyncState(ctx component.OperatorContext, rootSecret *corev1.Secret, state *syncState, synced bool) error {
+	originalRootSecret := rootSecret.DeepCopy()
+	if synced {
+		state.LastSyncTime = ptr.To(metav1.Now())
+		delete(rootSecret.Annotations, SyncPendingAnnotation)
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	rootSecret.Data[syncStateKey] = data
+	if err = r.client.Patch(ctx, rootSecret, client.MergeFrom(originalRootSecret)); err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error updating root secret: %v", client.ObjectKeyFromObject(rootSecret)))
+	}
+	return nil
+}
+
+// getSyncState returns the state of the sync of etcd auth which is stored in the given root secret.
+func getSyncState(rootSecret *corev1.Secret) (*syncState, error) {
+	state := &syncState{}
+	if data, ok := rootSecret.Data[syncStateKey]; ok {
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, fmt.Errorf("failed to parse sync state of root secret %s: %w", client.ObjectKeyFromObject(rootSecret), err)
+		}
+	}
+	return state, nil
+}
+
+// getOrCreateRootSecret returns the root secret which contains the password of the root user, it is created with a
+// random password if it does not exist yet. An existing password is never changed, as etcd-druid would otherwise lock
+// itself out.
+func (r _resource) getOrCreateRootSecret(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) (*corev1.Secret, error) {
+	rootSecret := &corev1.Secret{}
+	err := r.client.Get(ctx, getObjectKey(etcd.ObjectMeta), rootSecret)
+	if err == nil {
+		return rootSecret, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	password := make([]byte, rootPasswordLength)
+	if _, err = rand.Read(password); err != nil {
+		return nil, err
+	}
+	rootSecret = emptySecret(getObjectKey(etcd.ObjectMeta))
+	rootSecret.Labels = getLabels(etcd)
+	rootSecret.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	rootSecret.Data = map[string][]byte{rootPasswordKey: []byte(base64.RawURLEncoding.EncodeToString(password))}
+	if err = r.client.Create(ctx, rootSecret); err != nil {
+		return nil, err
+	}
+	ctx.Logger.Info("created root secret", "component", "etcd-auth", "objectKey", client.ObjectKeyFromObject(rootSecret))
+	return rootSecret, nil
+}
+
+// getUserPasswords returns the passwords of the users of the given Etcd by their names. Users which authenticate with a
+// client certificate only have no password.
+func (r _resource) getUserPasswords(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) (map[string]string, error) {
+	passwords := make(map[string]string, len(etcd.Spec.Etcd.Auth.Users))
+	for _, user := range etcd.Spec.Etcd.Auth.Users {
+		if user.PasswordSecretRef == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: user.PasswordSecretRef.Name, Namespace: etcd.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get password secret of user %s: %w", user.Name, err)
+		}
+		password, ok := secret.Data[user.PasswordSecretRef.Key]
+		if !ok || len(password) == 0 {
+			return nil, fmt.Errorf("password secret %s of user %s does not contain key %s", user.PasswordSecretRef.Name, user.Name, user.PasswordSecretRef.Key)
+		}
+		passwords[user.Name] = string(password)
+	}
+	return passwords, nil
+}
+
+func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	secretLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: common.ComponentNameEtcdAuth,
+		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta),
+	}
+	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), secretLabels)
+}
+
+func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
+	return client.ObjectKey{Name: druidv1alpha1.GetAuthRootSecretName(obj), Namespace: obj.Namespace}
+}
+
+func emptySecret(objectKey client.ObjectKey) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectKey.Name,
+			Namespace: objectKey.Namespace,
+		},
+	}
+}
@@ -0,0 +1,744 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/utils"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testClientCertCommonName = "etcd-client"
+	testUserPassword         = "user-password"
+)
+
+// ------------------------ GetExistingResourceNames ------------------------
+func TestGetExistingResourceNames(t *testing.T) {
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+	testCases := []struct {
+		name                string
+		secretExists        bool
+		getErr              *apierrors.StatusError
+		expectedErr         *druiderr.DruidError
+		expectedSecretNames []string
+	}{
+		{
+			name:                "should return empty slice when root secret does not exist",
+			expectedSecretNames: []string{},
+		},
+		{
+			name:                "should return the existing root secret name",
+			secretExists:        true,
+			expectedSecretNames: []string{druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta)},
+		},
+		{
+			name:         "should return error when client get fails",
+			secretExists: true,
+			getErr:       testutils.TestAPIInternalErr,
+			expectedErr: &druiderr.DruidError{
+				Code:      ErrGetEtcdAuth,
+				Cause:     testutils.TestAPIInternalErr,
+				Operation: component.OperationGetExistingResourceNames,
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var existingObjects []client.Object
+			if tc.secretExists {
+				existingObjects = append(existingObjects, newRootSecret(etcd, "root-password"))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(tc.getErr, nil, nil, nil, existingObjects, getObjectKey(etcd.ObjectMeta))
+			operator := New(cl)
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			secretNames, err := operator.GetExistingResourceNames(opCtx, etcd.ObjectMeta)
+			if tc.expectedErr != nil {
+				testutils.CheckDruidError(g, tc.expectedErr, err)
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(secretNames).To(Equal(tc.expectedSecretNames))
+			}
+		})
+	}
+}
+
+// ----------------------------------- Sync -----------------------------------
+func TestSync(t *testing.T) {
+	caCert, clientCert, clientKey := generateClientCertificate(t, testClientCertCommonName)
+	testCases := []struct {
+		name               string
+		withAuth           bool
+		replicas           int32
+		readyReplicas      int32
+		rootSecretExists   bool
+		rootSecretPending  bool
+		authEnabled        bool
+		expectRootSecret   bool
+		expectSyncPending  bool
+		expectAuthEnabled  bool
+		expectUsersSynced  bool
+		expectClientCalled bool
+	}{
+		{
+			name:          "should be a no-op when auth is not configured and has not been enabled",
+			replicas:      3,
+			readyReplicas: 3,
+		},
+		{
+			name:              "should create root secret and mark sync as pending when the etcd cluster has no quorum",
+			withAuth:          true,
+			replicas:          3,
+			readyReplicas:     1,
+			expectRootSecret:  true,
+			expectSyncPending: true,
+		},
+		{
+			name:             "should only create root secret when etcd is scaled down to zero",
+			withAuth:         true,
+			replicas:         0,
+			expectRootSecret: true,
+		},
+		{
+			name:               "should sync users and roles and enable auth when all members are ready",
+			withAuth:           true,
+			replicas:           3,
+			readyReplicas:      3,
+			expectRootSecret:   true,
+			expectAuthEnabled:  true,
+			expectUsersSynced:  true,
+			expectClientCalled: true,
+		},
+		{
+			name:               "should sync pending users and roles when the etcd cluster has regained its quorum",
+			withAuth:           true,
+			replicas:           3,
+			readyReplicas:      2,
+			rootSecretExists:   true,
+			rootSecretPending:  true,
+			expectRootSecret:   true,
+			expectAuthEnabled:  true,
+			expectUsersSynced:  true,
+			expectClientCalled: true,
+		},
+		{
+			name:              "should skip disabling auth and mark it as pending when the etcd cluster has no quorum",
+			replicas:          3,
+			readyReplicas:     1,
+			rootSecretExists:  true,
+			authEnabled:       true,
+			expectRootSecret:  true,
+			expectAuthEnabled: true,
+			expectSyncPending: true,
+		},
+		{
+			name:               "should disable auth and delete root secret when auth is removed",
+			replicas:           3,
+			readyReplicas:      3,
+			rootSecretExists:   true,
+			authEnabled:        true,
+			expectClientCalled: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcdBuilder := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(tc.replicas).WithClientTLS()
+			if tc.withAuth {
+				etcdBuilder = etcdBuilder.WithEtcdAuth()
+			}
+			etcd := etcdBuilder.Build()
+			etcd.Status.ReadyReplicas = tc.readyReplicas
+			existingObjects := []client.Object{
+				newSecret(testutils.ClientTLSCASecretName, map[string][]byte{"ca.crt": caCert}),
+				newSecret(testutils.ClientTLSClientCertSecretName, map[string][]byte{corev1.TLSCertKey: clientCert, corev1.TLSPrivateKeyKey: clientKey}),
+				newSecret(testutils.EtcdAuthUserPasswordSecretName, map[string][]byte{"password": []byte(testUserPassword)}),
+			}
+			if tc.rootSecretExists {
+				rootSecret := newRootSecret(etcd, "root-password")
+				if tc.rootSecretPending {
+					metav1.SetMetaDataAnnotation(&rootSecret.ObjectMeta, SyncPendingAnnotation, "true")
+				}
+				existingObjects = append(existingObjects, rootSecret)
+			}
+			cl := testutils.NewTestClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(existingObjects...).Build()
+			authCl := newFakeAuthClient()
+			authCl.enabled = tc.authEnabled
+			clientCalled := false
+			operator := &_resource{
+				client: cl,
+				newAuthClient: func(endpoint string, tlsConfig *tls.Config, username, password string) (authClient, error) {
+					clientCalled = true
+					g.Expect(endpoint).To(Equal(etcdclient.GetClientEndpoint(etcd)))
+					g.Expect(tlsConfig).ToNot(BeNil())
+					g.Expect(username).To(Equal(rootUser))
+					g.Expect(password).ToNot(BeEmpty())
+					return authCl, nil
+				},
+			}
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			syncErr := operator.Sync(opCtx, etcd)
+			g.Expect(syncErr).ToNot(HaveOccurred())
+			g.Expect(clientCalled).To(Equal(tc.expectClientCalled))
+			g.Expect(authCl.enabled).To(Equal(tc.expectAuthEnabled))
+
+			rootSecret := &corev1.Secret{}
+			getErr := cl.Get(context.Background(), getObjectKey(etcd.ObjectMeta), rootSecret)
+			if tc.expectRootSecret {
+				g.Expect(getErr).ToNot(HaveOccurred())
+				g.Expect(rootSecret.Data).To(HaveKeyWithValue(rootPasswordKey, Not(BeEmpty())))
+				g.Expect(metav1.IsControlledBy(rootSecret, etcd)).To(BeTrue())
+				g.Expect(metav1.HasAnnotation(rootSecret.ObjectMeta, SyncPendingAnnotation)).To(Equal(tc.expectSyncPending))
+			} else {
+				g.Expect(apierrors.IsNotFound(getErr)).To(BeTrue())
+			}
+			if tc.expectUsersSynced {
+				g.Expect(authCl.users).To(HaveKeyWithValue(rootUser, &fakeUser{password: string(rootSecret.Data[rootPasswordKey]), roles: []string{rootUser}}))
+				g.Expect(authCl.users).To(HaveKeyWithValue(testClientCertCommonName, &fakeUser{roles: []string{rootUser}}))
+				g.Expect(authCl.users).To(HaveKeyWithValue("reader", &fakeUser{password: testUserPassword, roles: []string{"reader"}}))
+				state, err := getSyncState(rootSecret)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(state.LastSyncTime).ToNot(BeNil())
+				g.Expect(state.Users).To(ContainElement("reader"))
+			}
+		})
+	}
+}
+
+// ------------------------------- IsSyncDue ---------------------------------
+func TestIsSyncDue(t *testing.T) {
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithEtcdAuth().Build()
+	testCases := []struct {
+		name          string
+		rootSecretFn  func() *corev1.Secret
+		expectSyncDue bool
+	}{
+		{
+			name: "should not be due if the root secret does not exist",
+		},
+		{
+			name: "should be due if the sync is pending",
+			rootSecretFn: func() *corev1.Secret {
+				rootSecret := newRootSecret(etcd, "root-password")
+				metav1.SetMetaDataAnnotation(&rootSecret.ObjectMeta, SyncPendingAnnotation, "true")
+				return rootSecret
+			},
+			expectSyncDue: true,
+		},
+		{
+			name: "should be due if the users and roles have never been synced",
+			rootSecretFn: func() *corev1.Secret {
+				return newRootSecret(etcd, "root-password")
+			},
+			expectSyncDue: true,
+		},
+		{
+			name: "should not be due if the users and roles have been synced within the resync period",
+			rootSecretFn: func() *corev1.Secret {
+				return newRootSecretWithLastSyncTime(t, etcd, time.Now().Add(-time.Minute))
+			},
+		},
+		{
+			name: "should be due if the users and roles have not been synced within the resync period",
+			rootSecretFn: func() *corev1.Secret {
+				return newRootSecretWithLastSyncTime(t, etcd, time.Now().Add(-resyncPeriod))
+			},
+			expectSyncDue: true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			var existingObjects []client.Object
+			if tc.rootSecretFn != nil {
+				existingObjects = append(existingObjects, tc.rootSecretFn())
+			}
+			cl := testutils.NewTestClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(existingObjects...).Build()
+			syncDue, err := IsSyncDue(context.Background(), cl, etcd.ObjectMeta)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(syncDue).To(Equal(tc.expectSyncDue))
+		})
+	}
+}
+
+// ----------------------------- TriggerDelete -------------------------------
+func TestTriggerDelete(t *testing.T) {
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+	testCases := []struct {
+		name         string
+		secretExists bool
+		deleteErr    *apierrors.StatusError
+		expectedErr  *druiderr.DruidError
+	}{
+		{
+			name:         "should delete existing root secret",
+			secretExists: true,
+		},
+		{
+			name: "no-op when root secret does not exist",
+		},
+		{
+			name:         "should return error when client delete fails",
+			secretExists: true,
+			deleteErr:    testutils.TestAPIInternalErr,
+			expectedErr: &druiderr.DruidError{
+				Code:      ErrDeleteEtcdAuth,
+				Cause:     testutils.TestAPIInternalErr,
+				Operation: component.OperationTriggerDelete,
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var existingObjects []client.Object
+			if tc.secretExists {
+				existingObjects = append(existingObjects, newRootSecret(etcd, "root-password"))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, tc.deleteErr, existingObjects, getObjectKey(etcd.ObjectMeta))
+			operator := New(cl)
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			err := operator.TriggerDelete(opCtx, etcd.ObjectMeta)
+			if tc.expectedErr != nil {
+				testutils.CheckDruidError(g, tc.expectedErr, err)
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(apierrors.IsNotFound(cl.Get(context.Background(), getObjectKey(etcd.ObjectMeta), &corev1.Secret{}))).To(BeTrue())
+			}
+		})
+	}
+}
+
+// ------------------------- reconcileUsersAndRoles --------------------------
+func TestReconcileUsersAndRoles(t *testing.T) {
+	desired := desiredAuth{
+		rootPassword:         "root-password",
+		clientCertCommonName: testClientCertCommonName,
+		roles: []druidv1alpha1.EtcdAuthRole{
+			{
+				Name: "reader",
+				Permissions: []druidv1alpha1.EtcdAuthPermission{
+					{KeyPrefix: "/registry/", Type: druidv1alpha1.EtcdAuthPermissionRead},
+					{KeyPrefix: "/events/", Type: druidv1alpha1.EtcdAuthPermissionReadWrite},
+				},
+			},
+		},
+		users: []druidv1alpha1.EtcdAuthUser{
+			{Name: "reader", Roles: []string{"reader"}},
+			{Name: "cert-reader", Roles: []string{"reader"}},
+		},
+		passwords: map[string]string{"reader": testUserPassword},
+	}
+	expectedRoles := map[string][]*authpb.Permission{
+		rootUser: nil,
+		"reader": {
+			{PermType: authpb.READ, Key: []byte("/registry/"), RangeEnd: []byte(clientv3.GetPrefixRangeEnd("/registry/"))},
+			{PermType: authpb.READWRITE, Key: []byte("/events/"), RangeEnd: []byte(clientv3.GetPrefixRangeEnd("/events/"))},
+		},
+	}
+	expectedUsers := map[string]*fakeUser{
+		rootUser:                 {password: "root-password", roles: []string{rootUser}},
+		testClientCertCommonName: {roles: []string{rootUser}},
+		"reader":                 {password: testUserPassword, roles: []string{"reader"}},
+		"cert-reader":            {roles: []string{"reader"}},
+	}
+
+	testCases := []struct {
+		name       string
+		setupFn    func(cl *fakeAuthClient, state *syncState)
+		expectedFn func(g *WithT, cl *fakeAuthClient, state *syncState)
+	}{
+		{
+			name: "should create users and roles and enable auth",
+			expectedFn: func(g *WithT, _ *fakeAuthClient, state *syncState) {
+				g.Expect(state.Users).To(ConsistOf(testClientCertCommonName, "reader", "cert-reader", rootUser))
+				g.Expect(state.Roles).To(ConsistOf("reader"))
+				g.Expect(state.PasswordChecksums).To(HaveKey("reader"))
+			},
+		},
+		{
+			name: "should remove undeclared users, roles and permissions which have been created by etcd-druid",
+			setupFn: func(cl *fakeAuthClient, state *syncState) {
+				state.Users = []string{"stale", "reader"}
+				state.Roles = []string{"stale"}
+				cl.enabled = true
+				cl.roles[rootUser] = nil
+				cl.roles["stale"] = nil
+				cl.roles["reader"] = []*authpb.Permission{
+					{PermType: authpb.READ, Key: []byte("/registry/"), RangeEnd: []byte(clientv3.GetPrefixRangeEnd("/registry/"))},
+					{PermType: authpb.READ, Key: []byte("/events/"), RangeEnd: []byte(clientv3.GetPrefixRangeEnd("/events/"))},
+					{PermType: authpb.WRITE, Key: []byte("/stale/"), RangeEnd: []byte(clientv3.GetPrefixRangeEnd("/stale/"))},
+				}
+				cl.users[rootUser] = &fakeUser{password: "root-password", roles: []string{rootUser}}
+				cl.users["stale"] = &fakeUser{password: "stale", roles: []string{"reader"}}
+				cl.users["reader"] = &fakeUser{password: "old-password", roles: []string{"reader", "stale"}}
+			},
+			expectedFn: func(g *WithT, cl *fakeAuthClient, state *syncState) {
+				g.Expect(cl.enableCalls).To(BeZero())
+				g.Expect(state.Users).To(ConsistOf(testClientCertCommonName, "reader", "cert-reader"))
+				g.Expect(state.Roles).To(BeEmpty())
+			},
+		},
+		{
+			name: "should reset password of existing root user when auth is disabled",
+			setupFn: func(cl *fakeAuthClient, _ *syncState) {
+				cl.roles[rootUser] = nil
+				cl.users[rootUser] = &fakeUser{password: "unknown", roles: []string{rootUser}}
+			},
+		},
+		{
+			name: "should not touch unchanged users when auth is enabled",
+			setupFn: func(cl *fakeAuthClient, _ *syncState) {
+				cl.enabled = true
+				cl.roles[rootUser] = nil
+				cl.roles["reader"] = expectedRoles["reader"]
+				for name, user := range expectedUsers {
+					cl.users[name] = &fakeUser{password: user.password, roles: user.roles}
+				}
+			},
+			expectedFn: func(g *WithT, cl *fakeAuthClient, _ *syncState) {
+				g.Expect(cl.changePasswordCalls).To(BeZero())
+				g.Expect(cl.enableCalls).To(BeZero())
+				g.Expect(cl.authenticateCalls).To(Equal(1))
+			},
+		},
+		{
+			name: "should not verify passwords which have not changed since the last sync",
+			setupFn: func(cl *fakeAuthClient, state *syncState) {
+				cl.enabled = true
+				cl.roles[rootUser] = nil
+				cl.roles["reader"] = expectedRoles["reader"]
+				for name, user := range expectedUsers {
+					cl.users[name] = &fakeUser{password: user.password, roles: user.roles}
+				}
+				state.PasswordChecksums = map[string]string{"reader": utils.ComputeSHA256Hex([]byte(testUserPassword))}
+			},
+			expectedFn: func(g *WithT, cl *fakeAuthClient, _ *syncState) {
+				g.Expect(cl.authenticateCalls).To(BeZero())
+			},
+		},
+		{
+			name: "should verify passwords which have changed since the last sync",
+			setupFn: func(cl *fakeAuthClient, state *syncState) {
+				cl.enabled = true
+				cl.roles[rootUser] = nil
+				cl.users["reader"] = &fakeUser{password: "old-password", roles: []string{"reader"}}
+				state.PasswordChecksums = map[string]string{"reader": utils.ComputeSHA256Hex([]byte("old-password"))}
+			},
+			expectedFn: func(g *WithT, cl *fakeAuthClient, state *syncState) {
+				g.Expect(cl.authenticateCalls).To(Equal(1))
+				g.Expect(cl.changePasswordCalls).To(Equal(1))
+				g.Expect(state.PasswordChecksums).To(Equal(map[string]string{"reader": utils.ComputeSHA256Hex([]byte(testUserPassword))}))
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cl := newFakeAuthClient()
+			state := &syncState{}
+			if tc.setupFn != nil {
+				tc.setupFn(cl, state)
+			}
+			g.Expect(reconcileUsersAndRoles(context.Background(), cl, desired, state)).To(Succeed())
+			g.Expect(cl.enabled).To(BeTrue())
+			g.Expect(cl.roles).To(HaveLen(len(expectedRoles)))
+			for name, perms := range expectedRoles {
+				g.Expect(cl.roles).To(HaveKey(name))
+				g.Expect(cl.roles[name]).To(ConsistOf(perms))
+			}
+			g.Expect(cl.users).To(Equal(expectedUsers))
+			if tc.expectedFn != nil {
+				tc.expectedFn(g, cl, state)
+			}
+		})
+	}
+}
+
+func TestReconcileUsersAndRolesKeepsUnmanagedUsersAndRoles(t *testing.T) {
+	g := NewWithT(t)
+	t.Parallel()
+	cl := newFakeAuthClient()
+	cl.roles["external"] = nil
+	cl.users["external"] = &fakeUser{password: "external", roles: []string{"external"}}
+	state := &syncState{}
+	g.Expect(reconcileUsersAndRoles(context.Background(), cl, desiredAuth{rootPassword: "root-password"}, state)).To(Succeed())
+	g.Expect(cl.roles).To(HaveKey("external"))
+	g.Expect(cl.users).To(HaveKeyWithValue("external", &fakeUser{password: "external", roles: []string{"external"}}))
+	g.Expect(state.Users).To(ConsistOf(rootUser))
+	g.Expect(state.Roles).To(BeEmpty())
+}
+
+func TestDisableEtcdAuth(t *testing.T) {
+	g := NewWithT(t)
+	t.Parallel()
+	for _, enabled := range []bool{true, false} {
+		cl := newFakeAuthClient()
+		cl.enabled = enabled
+		g.Expect(disableEtcdAuth(context.Background(), cl)).To(Succeed())
+		g.Expect(cl.enabled).To(BeFalse())
+	}
+}
+
+// ---------------------------- Helper Functions -----------------------------
+
+func newRootSecret(etcd *druidv1alpha1.Etcd, password string) *corev1.Secret {
+	secret := emptySecret(getObjectKey(etcd.ObjectMeta))
+	secret.Labels = getLabels(etcd)
+	secret.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	secret.Data = map[string][]byte{rootPasswordKey: []byte(password)}
+	return secret
+}
+
+func newRootSecretWithLastSyncTime(t *testing.T, etcd *druidv1alpha1.Etcd, lastSyncTime time.Time) *corev1.Secret {
+	secret := newRootSecret(etcd, "root-password")
+	state, err := json.Marshal(&syncState{LastSyncTime: &metav1.Time{Time: lastSyncTime}})
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+	secret.Data[syncStateKey] = state
+	return secret
+}
+
+func newSecret(name string, data map[string][]byte) *corev1.Secret {
+	secret := emptySecret(client.ObjectKey{Name: name, Namespace: testutils.TestNamespace})
+	secret.Data = data
+	return secret
+}
+
+// generateClientCertificate generates a self-signed CA and a client certificate with the given common name, all PEM encoded.
+func generateClientCertificate(t *testing.T, commonName string) (caCert, clientCert, clientKey []byte) {
+	g := NewWithT(t)
+	newKey := func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		g.Expect(err).ToNot(HaveOccurred())
+		return key
+	}
+	caKey, key := newKey(), newKey()
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &key.PublicKey, caKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	g.Expect(err).ToNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+type fakeUser struct {
+	password string
+	roles    []string
+}
+
+// fakeAuthClient is an in-memory implementation of authClient.
+type fakeAuthClient struct {
+	clientv3.Auth
+	enabled             bool
+	users               map[string]*fakeUser
+	roles               map[string][]*authpb.Permission
+	enableCalls         int
+	changePasswordCalls int
+	authenticateCalls   int
+}
+
+func newFakeAuthClient() *fakeAuthClient {
+	return &fakeAuthClient{
+		users: map[string]*fakeUser{},
+		roles: map[string][]*authpb.Permission{},
+	}
+}
+
+func (f *fakeAuthClient) Close() error { return nil }
+
+func (f *fakeAuthClient) Authenticate(_ context.Context, name, password string) (*clientv3.AuthenticateResponse, error) {
+	f.authenticateCalls++
+	if user, ok := f.users[name]; !ok || user.password != password {
+		return nil, rpctypes.ErrAuthFailed
+	}
+	return &clientv3.AuthenticateResponse{}, nil
+}
+
+func (f *fakeAuthClient) AuthEnable(_ context.Context) (*clientv3.AuthEnableResponse, error) {
+	f.enabled = true
+	f.enableCalls++
+	return &clientv3.AuthEnableResponse{}, nil
+}
+
+func (f *fakeAuthClient) AuthDisable(_ context.Context) (*clientv3.AuthDisableResponse, error) {
+	f.enabled = false
+	return &clientv3.AuthDisableResponse{}, nil
+}
+
+func (f *fakeAuthClient) AuthStatus(_ context.Context) (*clientv3.AuthStatusResponse, error) {
+	return &clientv3.AuthStatusResponse{Enabled: f.enabled}, nil
+}
+
+func (f *fakeAuthClient) UserAdd(ctx context.Context, name, password string) (*clientv3.AuthUserAddResponse, error) {
+	return f.UserAddWithOptions(ctx, name, password, nil)
+}
+
+func (f *fakeAuthClient) UserAddWithOptions(_ context.Context, name, password string, _ *clientv3.UserAddOptions) (*clientv3.AuthUserAddResponse, error) {
+	if _, ok := f.users[name]; ok {
+		return nil, rpctypes.ErrUserAlreadyExist
+	}
+	f.users[name] = &fakeUser{password: password}
+	return &clientv3.AuthUserAddResponse{}, nil
+}
+
+func (f *fakeAuthClient) UserDelete(_ context.Context, name string) (*clientv3.AuthUserDeleteResponse, error) {
+	delete(f.users, name)
+	return &clientv3.AuthUserDeleteResponse{}, nil
+}
+
+func (f *fakeAuthClient) UserChangePassword(_ context.Context, name, password string) (*clientv3.AuthUserChangePasswordResponse, error) {
+	f.users[name].password = password
+	f.changePasswordCalls++
+	return &clientv3.AuthUserChangePasswordResponse{}, nil
+}
+
+func (f *fakeAuthClient) UserGrantRole(_ context.Context, name, role string) (*clientv3.AuthUserGrantRoleResponse, error) {
+	if _, ok := f.roles[role]; !ok {
+		return nil, rpctypes.ErrRoleNotFound
+	}
+	f.users[name].roles = append(f.users[name].roles, role)
+	return &clientv3.AuthUserGrantRoleResponse{}, nil
+}
+
+func (f *fakeAuthClient) UserGet(_ context.Context, name string) (*clientv3.AuthUserGetResponse, error) {
+	user, ok := f.users[name]
+	if !ok {
+		return nil, rpctypes.ErrUserNotFound
+	}
+	return &clientv3.AuthUserGetResponse{Roles: user.roles}, nil
+}
+
+func (f *fakeAuthClient) UserList(_ context.Context) (*clientv3.AuthUserListResponse, error) {
+	resp := &clientv3.AuthUserListResponse{}
+	for name := range f.users {
+		resp.Users = append(resp.Users, name)
+	}
+	return resp, nil
+}
+
+func (f *fakeAuthClient) UserRevokeRole(_ context.Context, name, role string) (*clientv3.AuthUserRevokeRoleResponse, error) {
+	var roles []string
+	for _, r := range f.users[name].roles {
+		if r != role {
+			roles = append(roles, r)
+		}
+	}
+	f.users[name].roles = roles
+	return &clientv3.AuthUserRevokeRoleResponse{}, nil
+}
+
+func (f *fakeAuthClient) RoleAdd(_ context.Context, name string) (*clientv3.AuthRoleAddResponse, error) {
+	if _, ok := f.roles[name]; ok {
+		return nil, rpctypes.ErrRoleAlreadyExist
+	}
+	f.roles[name] = nil
+	return &clientv3.AuthRoleAddResponse{}, nil
+}
+
+func (f *fakeAuthClient) RoleGrantPermission(ctx context.Context, name, key, rangeEnd string, permType clientv3.PermissionType) (*clientv3.AuthRoleGrantPermissionResponse, error) {
+	_, _ = f.RoleRevokePermission(ctx, name, key, rangeEnd)
+	f.roles[name] = append(f.roles[name], &authpb.Permission{PermType: authpb.Permission_Type(permType), Key: []byte(key), RangeEnd: []byte(rangeEnd)})
+	return &clientv3.AuthRoleGrantPermissionResponse{}, nil
+}
+
+func (f *fakeAuthClient) RoleGet(_ context.Context, name string) (*clientv3.AuthRoleGetResponse, error) {
+	perms, ok := f.roles[name]
+	if !ok {
+		return nil, rpctypes.ErrRoleNotFound
+	}
+	return &clientv3.AuthRoleGetResponse{Perm: perms}, nil
+}
+
+func (f *fakeAuthClient) RoleList(_ context.Context) (*clientv3.AuthRoleListResponse, error) {
+	resp := &clientv3.AuthRoleListResponse{}
+	for name := range f.roles {
+		resp.Roles = append(resp.Roles, name)
+	}
+	return resp, nil
+}
+
+func (f *fakeAuthClient) RoleRevokePermission(_ context.Context, name, key, rangeEnd string) (*clientv3.AuthRoleRevokePermissionResponse, error) {
+	var perms []*authpb.Permission
+	for _, perm := range f.roles[name] {
+		if string(perm.Key) != key || string(perm.RangeEnd) != rangeEnd {
+			perms = append(perms, perm)
+		}
+	}
+	f.roles[name] = perms
+	return &clientv3.AuthRoleRevokePermissionResponse{}, nil
+}
+
+func (f *fakeAuthClient) RoleDelete(ctx context.Context, name string) (*clientv3.AuthRoleDeleteResponse, error) {
+	delete(f.roles, name)
+	for user := range f.users {
+		_, _ = f.UserRevokeRole(ctx, user, name)
+	}
+	return &clientv3.AuthRoleDeleteResponse{}, nil
+}
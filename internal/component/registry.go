@@ -39,6 +39,8 @@ const (
 	ClientServiceKind Kind = "ClientService"
 	// PodDisruptionBudgetKind indicates that the kind of component is a PodDisruptionBudget.
 	PodDisruptionBudgetKind Kind = "PodDisruptionBudget"
+	// EtcdAuthKind indicates that the kind of component is etcd's built-in authentication, i.e. its users and roles.
+	EtcdAuthKind Kind = "EtcdAuth"
 )
 
 type registry struct {
@@ -109,6 +109,15 @@ func buildResource(etcd *druidv1alpha1.Etcd, sa *corev1.ServiceAccount, autoMoun
 	sa.Labels = getLabels(etcd)
 	sa.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
 	sa.AutomountServiceAccountToken = ptr.To(autoMountServiceAccountToken)
+	sa.Annotations = getAnnotations(etcd)
+}
+
+// getAnnotations returns the annotations required to access the backup store with workload identity, if configured.
+func getAnnotations(etcd *druidv1alpha1.Etcd) map[string]string {
+	if !etcd.IsBackupStoreEnabled() || etcd.Spec.Backup.Store.WorkloadIdentity == nil {
+		return nil
+	}
+	return etcd.Spec.Backup.Store.WorkloadIdentity.ServiceAccountAnnotations
 }
 
 func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
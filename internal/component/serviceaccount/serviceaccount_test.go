@@ -90,6 +90,7 @@ func TestSync(t *testing.T) {
 	testCases := []struct {
 		name             string
 		disableAutoMount bool
+		workloadIdentity *druidv1alpha1.WorkloadIdentity
 		createErr        *apierrors.StatusError
 		expectedErr      *druiderr.DruidError
 	}{
@@ -100,6 +101,12 @@ func TestSync(t *testing.T) {
 			name:             "create service account with disabled auto mount",
 			disableAutoMount: true,
 		},
+		{
+			name: "create service account with workload identity annotations",
+			workloadIdentity: &druidv1alpha1.WorkloadIdentity{
+				ServiceAccountAnnotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/etcd-backup"},
+			},
+		},
 		{
 			name:      "should return err when client create fails",
 			createErr: testutils.TestAPIInternalErr,
@@ -117,6 +124,7 @@ func TestSync(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			etcd.Spec.Backup.Store.WorkloadIdentity = tc.workloadIdentity
 			cl := testutils.CreateTestFakeClientForObjects(nil, tc.createErr, nil, nil, nil, getObjectKey(etcd.ObjectMeta))
 			operator := New(cl, tc.disableAutoMount)
 			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
@@ -209,6 +217,7 @@ func matchServiceAccount(g *WithT, etcd *druidv1alpha1.Etcd, actualSA corev1.Ser
 			"Namespace":       Equal(etcd.Namespace),
 			"Labels":          testutils.MatchResourceLabels(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)),
 			"OwnerReferences": testutils.MatchEtcdOwnerReference(etcd.Name, etcd.UID),
+			"Annotations":     Equal(getAnnotations(etcd)),
 		}),
 		"AutomountServiceAccountToken": PointTo(Equal(!disableAutoMount)),
 	}))
@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
@@ -74,6 +76,16 @@ func (r _resource) GetExistingResourceNames(ctx component.OperatorContext, etcdO
 	if fullSnapshotLease != nil && metav1.IsControlledBy(fullSnapshotLease, &etcdObjMeta) {
 		resourceNames = append(resourceNames, fullSnapshotLease.Name)
 	}
+	// Leases of secondary stores have always been labeled, therefore they can be listed.
+	secondaryStoreLeaseNames, err := r.getExistingSecondaryStoreLeaseNames(ctx, etcdObjMeta)
+	if err != nil {
+		return resourceNames, druiderr.WrapError(err,
+			ErrGetSnapshotLease,
+			component.OperationGetExistingResourceNames,
+			fmt.Sprintf("Error listing secondary store snapshot leases for etcd: %v", druidv1alpha1.GetNamespaceName(etcdObjMeta)),
+		)
+	}
+	resourceNames = append(resourceNames, secondaryStoreLeaseNames...)
 	return resourceNames, nil
 }
 
@@ -104,7 +116,10 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 			},
 		}
 	}
-	return errors.Join(utils.RunConcurrently(ctx, syncTasks)...)
+	if err := errors.Join(utils.RunConcurrently(ctx, syncTasks)...); err != nil {
+		return err
+	}
+	return r.deleteStaleSecondaryStoreLeases(ctx, etcd, objectKeys)
 }
 
 // TriggerDelete triggers the deletion of the snapshot leases for the given Etcd.
@@ -132,6 +147,50 @@ func (r _resource) deleteAllSnapshotLeases(ctx component.OperatorContext, etcdOb
 	return nil
 }
 
+func (r _resource) getExistingSecondaryStoreLeaseNames(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) ([]string, error) {
+	objMetaList := &metav1.PartialObjectMetadataList{}
+	objMetaList.SetGroupVersionKind(coordinationv1.SchemeGroupVersion.WithKind("LeaseList"))
+	if err := r.client.List(ctx,
+		objMetaList,
+		client.InNamespace(etcdObjMeta.Namespace),
+		client.MatchingLabels(getSelectorLabelsForAllSnapshotLeases(etcdObjMeta)),
+	); err != nil {
+		return nil, err
+	}
+	secondaryStoreLeasePrefix := druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcdObjMeta, "")
+	var leaseNames []string
+	for _, lease := range objMetaList.Items {
+		if strings.HasPrefix(lease.Name, secondaryStoreLeasePrefix) && metav1.IsControlledBy(&lease, &etcdObjMeta) {
+			leaseNames = append(leaseNames, lease.Name)
+		}
+	}
+	return leaseNames, nil
+}
+
+// deleteStaleSecondaryStoreLeases deletes the snapshot leases of secondary stores which have been removed from the Etcd.
+func (r _resource) deleteStaleSecondaryStoreLeases(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, objectKeys []client.ObjectKey) error {
+	existingLeaseNames, err := r.getExistingSecondaryStoreLeaseNames(ctx, etcd.ObjectMeta)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncSnapshotLease,
+			component.OperationSync,
+			fmt.Sprintf("Error listing secondary store snapshot leases for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	for _, leaseName := range existingLeaseNames {
+		if slices.ContainsFunc(objectKeys, func(objectKey client.ObjectKey) bool { return objectKey.Name == leaseName }) {
+			continue
+		}
+		if err = client.IgnoreNotFound(r.client.Delete(ctx, emptySnapshotLease(client.ObjectKey{Name: leaseName, Namespace: etcd.Namespace}))); err != nil {
+			return druiderr.WrapError(err,
+				ErrSyncSnapshotLease,
+				component.OperationSync,
+				fmt.Sprintf("Error deleting stale secondary store snapshot lease: %s for etcd: %v", leaseName, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		ctx.Logger.Info("deleted stale secondary store snapshot lease", "name", leaseName)
+	}
+	return nil
+}
+
 func (r _resource) getLeasePartialObjectMetadata(ctx context.Context, objectKey client.ObjectKey) (*metav1.PartialObjectMetadata, error) {
 	objMeta := &metav1.PartialObjectMetadata{}
 	objMeta.SetGroupVersionKind(coordinationv1.SchemeGroupVersion.WithKind("Lease"))
@@ -182,7 +241,7 @@ func getLabels(etcd *druidv1alpha1.Etcd, leaseName string) map[string]string {
 }
 
 func getObjectKeys(etcd *druidv1alpha1.Etcd) []client.ObjectKey {
-	return []client.ObjectKey{
+	objectKeys := []client.ObjectKey{
 		{
 			Name:      druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta),
 			Namespace: etcd.Namespace,
@@ -192,6 +251,13 @@ func getObjectKeys(etcd *druidv1alpha1.Etcd) []client.ObjectKey {
 			Namespace: etcd.Namespace,
 		},
 	}
+	for _, secondaryStore := range etcd.Spec.Backup.SecondaryStores {
+		objectKeys = append(objectKeys, client.ObjectKey{
+			Name:      druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcd.ObjectMeta, secondaryStore.Name),
+			Namespace: etcd.Namespace,
+		})
+	}
+	return objectKeys
 }
 
 func emptySnapshotLease(objectKey client.ObjectKey) *coordinationv1.Lease {
@@ -138,6 +138,31 @@ func TestSyncWhenBackupIsEnabled(t *testing.T) {
 	}
 }
 
+func TestSyncWithSecondaryStores(t *testing.T) {
+	g := NewWithT(t)
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithSecondaryBackupStore("dr").Build()
+	staleLease := buildLease(etcd, druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcd.ObjectMeta, "removed"))
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{staleLease}, getObjectKeys(etcd)...)
+	operator := New(cl)
+	opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+	g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+	latestSnapshotLeases, err := getLatestSnapshotLeases(cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(latestSnapshotLeases).To(ConsistOf(
+		matchLease(druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta), etcd),
+		matchLease(druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta), etcd),
+		matchLease(druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcd.ObjectMeta, "dr"), etcd),
+	))
+
+	leaseNames, err := operator.GetExistingResourceNames(opCtx, etcd.ObjectMeta)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(leaseNames).To(ConsistOf(
+		druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta),
+		druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta),
+		druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcd.ObjectMeta, "dr"),
+	))
+}
+
 func TestSyncWhenBackupHasBeenDisabled(t *testing.T) {
 	nonTargetEtcd := testutils.EtcdBuilderWithDefaults(nonTargetEtcdName, testutils.TestNamespace).Build()
 	existingEtcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()   // backup is enabled
@@ -6,10 +6,12 @@ package statefulset
 
 import (
 	"fmt"
+	"reflect"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/recommender"
 	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
@@ -21,6 +23,7 @@ import (
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +45,20 @@ const (
 	rootUser                             = int64(0)
 	nonRootUser                          = int64(65532)
 	etcdWrapperReadyEndpoint             = "/readyz"
+	etcdHealthEndpoint                   = "/health?exclude=NOSPACE&serializable=true"
+	backupRestoreHealthEndpoint          = "/healthz"
+	// maxTopologySpreadMinDomains is the maximum number of zones across which the MultiZonal topology policy requires
+	// the etcd pods to be spread.
+	maxTopologySpreadMinDomains int32 = 3
+)
+
+// defaults for the liveness and startup probes
+const (
+	defaultProbePeriodSeconds       int32 = 10
+	defaultProbeTimeoutSeconds      int32 = 5
+	defaultLivenessFailureThreshold int32 = 6
+	// defaultStartupFailureThreshold allows the startup to take up to 24h, which covers restorations of large databases.
+	defaultStartupFailureThreshold int32 = 8640
 )
 
 var (
@@ -176,12 +193,15 @@ func (b *stsBuilder) createPodTemplateSpec(ctx component.OperatorContext) error
 			},
 			SecurityContext:           b.getPodSecurityContext(),
 			Affinity:                  b.etcd.Spec.SchedulingConstraints.Affinity,
-			TopologySpreadConstraints: b.etcd.Spec.SchedulingConstraints.TopologySpreadConstraints,
+			TopologySpreadConstraints: b.getTopologySpreadConstraints(),
 			Volumes:                   podVolumes,
 			PriorityClassName:         ptr.Deref(b.etcd.Spec.PriorityClassName, ""),
 		},
 	}
 	podTemplateSpec.Spec.ServiceAccountName = druidv1alpha1.GetServiceAccountName(b.etcd.ObjectMeta)
+	if err = b.applyPodTemplateOverrides(&podTemplateSpec.Spec); err != nil {
+		return err
+	}
 
 	podTemplateLabels := b.getStatefulSetPodLabels()
 	selectorMatchesLabels, err := kubernetes.DoesLabelSelectorMatchLabels(b.sts.Spec.Selector, podTemplateLabels)
@@ -199,6 +219,168 @@ func (b *stsBuilder) createPodTemplateSpec(ctx component.OperatorContext) error
 	return nil
 }
 
+// applyPodTemplateOverrides applies the pod template overrides configured in the Etcd spec to the given pod spec.
+// Overrides are only allowed to add to the pod spec generated by etcd-druid, an error is returned if any of them
+// clashes with a container, volume, volume mount, env var or port that is managed by etcd-druid.
+func (b *stsBuilder) applyPodTemplateOverrides(podSpec *corev1.PodSpec) error {
+	overrides := b.etcd.Spec.PodTemplateOverrides
+	if overrides == nil {
+		return nil
+	}
+
+	podSpec.Tolerations = append(podSpec.Tolerations, overrides.Tolerations...)
+	if len(overrides.NodeSelector) > 0 {
+		podSpec.NodeSelector = utils.MergeMaps(podSpec.NodeSelector, overrides.NodeSelector)
+	}
+	if overrides.RuntimeClassName != nil {
+		podSpec.RuntimeClassName = overrides.RuntimeClassName
+	}
+	if overrides.SecurityContext != nil {
+		podSpec.SecurityContext = mergePodSecurityContext(podSpec.SecurityContext, overrides.SecurityContext)
+	}
+
+	// The data volume is not part of the pod spec, it is added to the pods from the volume claim template.
+	volumeNames := sets.New(ptr.Deref(b.etcd.Spec.VolumeClaimTemplate, b.etcd.Name))
+	for _, volume := range podSpec.Volumes {
+		volumeNames.Insert(volume.Name)
+	}
+	for _, volume := range overrides.AdditionalVolumes {
+		if volumeNames.Has(volume.Name) {
+			return fmt.Errorf("additional volume %s clashes with an existing volume of the pod", volume.Name)
+		}
+		volumeNames.Insert(volume.Name)
+		podSpec.Volumes = append(podSpec.Volumes, toVolume(volume))
+	}
+
+	for _, containerOverrides := range overrides.Containers {
+		container := findContainer(podSpec.Containers, containerOverrides.Name)
+		if container == nil {
+			return fmt.Errorf("cannot override unknown container %s", containerOverrides.Name)
+		}
+		if err := applyContainerOverrides(container, containerOverrides, volumeNames); err != nil {
+			return err
+		}
+	}
+
+	containerNames := sets.New[string]()
+	usedPorts := sets.New(b.clientPort, b.serverPort, b.backupPort, b.wrapperPort)
+	if b.etcd.Spec.Etcd.Probes != nil {
+		usedPorts.Insert(common.DefaultPortEtcdMetrics)
+	}
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for _, container := range containers {
+			containerNames.Insert(container.Name)
+			for _, port := range container.Ports {
+				usedPorts.Insert(port.ContainerPort)
+			}
+		}
+	}
+	for _, container := range overrides.AdditionalContainers {
+		if containerNames.Has(container.Name) {
+			return fmt.Errorf("additional container %s clashes with an existing container of the pod", container.Name)
+		}
+		containerNames.Insert(container.Name)
+		for _, port := range container.Ports {
+			if usedPorts.Has(port.ContainerPort) {
+				return fmt.Errorf("port %d of additional container %s is already in use", port.ContainerPort, container.Name)
+			}
+			usedPorts.Insert(port.ContainerPort)
+		}
+		for _, volumeMount := range container.VolumeMounts {
+			if !volumeNames.Has(volumeMount.Name) {
+				return fmt.Errorf("volume mount %s of additional container %s does not refer to a volume of the pod", volumeMount.Name, container.Name)
+			}
+		}
+		podSpec.Containers = append(podSpec.Containers, toContainer(container))
+	}
+	return nil
+}
+
+// mergePodSecurityContext returns a copy of the given pod security context in which the fields that are set in the
+// overrides are replaced.
+func mergePodSecurityContext(securityContext, overrides *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	merged := ptr.Deref(securityContext.DeepCopy(), corev1.PodSecurityContext{})
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	overridesValue := reflect.ValueOf(overrides.DeepCopy()).Elem()
+	for i := range overridesValue.NumField() {
+		if field := overridesValue.Field(i); !field.IsZero() {
+			mergedValue.Field(i).Set(field)
+		}
+	}
+	return &merged
+}
+
+// toContainer converts the given additional container to a container of the pod spec.
+func toContainer(additionalContainer druidv1alpha1.AdditionalContainer) corev1.Container {
+	c := additionalContainer.DeepCopy()
+	return corev1.Container{
+		Name:            c.Name,
+		Image:           c.Image,
+		ImagePullPolicy: c.ImagePullPolicy,
+		Command:         c.Command,
+		Args:            c.Args,
+		Ports:           c.Ports,
+		Env:             c.Env,
+		Resources:       ptr.Deref(c.Resources, corev1.ResourceRequirements{}),
+		VolumeMounts:    c.VolumeMounts,
+	}
+}
+
+// toVolume converts the given additional volume to a volume of the pod spec.
+func toVolume(additionalVolume druidv1alpha1.AdditionalVolume) corev1.Volume {
+	v := additionalVolume.DeepCopy()
+	return corev1.Volume{
+		Name: v.Name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap:             v.ConfigMap,
+			Secret:                v.Secret,
+			EmptyDir:              v.EmptyDir,
+			PersistentVolumeClaim: v.PersistentVolumeClaim,
+		},
+	}
+}
+
+func applyContainerOverrides(container *corev1.Container, containerOverrides druidv1alpha1.ContainerOverrides, volumeNames sets.Set[string]) error {
+	envNames := sets.New[string]()
+	for _, env := range container.Env {
+		envNames.Insert(env.Name)
+	}
+	for _, env := range containerOverrides.Env {
+		if envNames.Has(env.Name) {
+			return fmt.Errorf("env var %s clashes with an existing env var of container %s", env.Name, container.Name)
+		}
+		envNames.Insert(env.Name)
+		container.Env = append(container.Env, env)
+	}
+
+	mountNames, mountPaths := sets.New[string](), sets.New[string]()
+	for _, volumeMount := range container.VolumeMounts {
+		mountNames.Insert(volumeMount.Name)
+		mountPaths.Insert(volumeMount.MountPath)
+	}
+	for _, volumeMount := range containerOverrides.VolumeMounts {
+		if !volumeNames.Has(volumeMount.Name) {
+			return fmt.Errorf("volume mount %s of container %s does not refer to a volume of the pod", volumeMount.Name, container.Name)
+		}
+		if mountNames.Has(volumeMount.Name) || mountPaths.Has(volumeMount.MountPath) {
+			return fmt.Errorf("volume mount %s at %s clashes with an existing volume mount of container %s", volumeMount.Name, volumeMount.MountPath, container.Name)
+		}
+		mountNames.Insert(volumeMount.Name)
+		mountPaths.Insert(volumeMount.MountPath)
+		container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+	}
+	return nil
+}
+
+func findContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
 func (b *stsBuilder) getStatefulSetPodLabels() map[string]string {
 	return utils.MergeMaps(
 		b.etcd.Spec.Labels,
@@ -215,12 +397,16 @@ func (b *stsBuilder) getHostAliases() []corev1.HostAlias {
 }
 
 func (b *stsBuilder) getPodTemplateAnnotations(ctx component.OperatorContext) map[string]string {
-	if configMapCheckSum, ok := ctx.Data[common.CheckSumKeyConfigMap]; ok {
-		return utils.MergeMaps(b.etcd.Spec.Annotations, map[string]string{
-			common.CheckSumKeyConfigMap: configMapCheckSum,
-		})
+	checkSumAnnotations := make(map[string]string, 2)
+	for _, checkSumKey := range []string{common.CheckSumKeyConfigMap, common.CheckSumKeySecrets} {
+		if checkSum, ok := ctx.Data[checkSumKey]; ok {
+			checkSumAnnotations[checkSumKey] = checkSum
+		}
+	}
+	if len(checkSumAnnotations) == 0 {
+		return b.etcd.Spec.Annotations
 	}
-	return b.etcd.Spec.Annotations
+	return utils.MergeMaps(b.etcd.Spec.Annotations, checkSumAnnotations)
 }
 
 func (b *stsBuilder) getVolumeClaimTemplates() []corev1.PersistentVolumeClaim {
@@ -299,6 +485,19 @@ func (b *stsBuilder) getBackupRestoreContainerVolumeMounts() []corev1.VolumeMoun
 		if etcdBackupVolumeMount != nil {
 			brVolumeMounts = append(brVolumeMounts, *etcdBackupVolumeMount)
 		}
+		if druidstore.GetWorkloadIdentityTokenAudience(b.etcd.Spec.Backup.Store, *b.provider) != nil {
+			brVolumeMounts = append(brVolumeMounts, corev1.VolumeMount{
+				Name:      common.VolumeNameWorkloadIdentityToken,
+				MountPath: common.VolumeMountPathWorkloadIdentityToken,
+				ReadOnly:  true,
+			})
+		}
+		if encryptionVolumeMount := druidstore.GetEncryptionVolumeMount(b.etcd.Spec.Backup.Encryption, common.VolumeNameBackupEncryption, common.VolumeMountPathBackupEncryption); encryptionVolumeMount != nil {
+			brVolumeMounts = append(brVolumeMounts, *encryptionVolumeMount)
+		}
+		for _, secondaryStore := range b.etcd.Spec.Backup.SecondaryStores {
+			brVolumeMounts = append(brVolumeMounts, druidstore.GetSecondaryStoreVolumeMount(secondaryStore))
+		}
 	}
 	return brVolumeMounts
 }
@@ -330,6 +529,9 @@ func getBackupRestoreContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []cor
 }
 
 func (b *stsBuilder) getEtcdBackupVolumeMount() *corev1.VolumeMount {
+	if *b.provider != druidstore.Local && !druidstore.UsesSecretCredentials(b.etcd.Spec.Backup.Store) {
+		return nil
+	}
 	switch *b.provider {
 	case druidstore.Local:
 		if b.etcd.Spec.Backup.Store.Container != nil {
@@ -367,6 +569,8 @@ func (b *stsBuilder) getEtcdContainer() corev1.Container {
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Args:            b.getEtcdContainerCommandArgs(),
 		ReadinessProbe:  b.getEtcdContainerReadinessProbe(),
+		LivenessProbe:   getLivenessProbe(b.etcd.Spec.Etcd.Probes, b.getEtcdContainerProbeHandler()),
+		StartupProbe:    getStartupProbe(b.etcd.Spec.Etcd.Probes, b.getEtcdContainerProbeHandler()),
 		Ports: []corev1.ContainerPort{
 			{
 				Name:          serverPortName,
@@ -379,7 +583,7 @@ func (b *stsBuilder) getEtcdContainer() corev1.Container {
 				ContainerPort: b.clientPort,
 			},
 		},
-		Resources: ptr.Deref(b.etcd.Spec.Etcd.Resources, defaultResourceRequirements),
+		Resources: recommender.GetContainerResources(b.etcd, common.ContainerNameEtcd, ptr.Deref(b.etcd.Spec.Etcd.Resources, defaultResourceRequirements)),
 		SecurityContext: &corev1.SecurityContext{
 			AllowPrivilegeEscalation: ptr.To(false),
 		},
@@ -398,12 +602,23 @@ func (b *stsBuilder) getBackupRestoreContainer() (corev1.Container, error) {
 		return corev1.Container{}, err
 	}
 	env = append(env, providerEnv...)
+	if err = b.checkBackupRestoreImageSupportsSpec(); err != nil {
+		return corev1.Container{}, err
+	}
+	args := b.getBackupRestoreContainerCommandArgs()
+	secondaryStoreArgs, err := b.getSecondaryStoreCommandArgs()
+	if err != nil {
+		return corev1.Container{}, err
+	}
+	args = append(args, secondaryStoreArgs...)
 
 	return corev1.Container{
 		Name:            common.ContainerNameEtcdBackupRestore,
 		Image:           b.etcdBackupRestoreImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
-		Args:            b.getBackupRestoreContainerCommandArgs(),
+		Args:            args,
+		LivenessProbe:   getLivenessProbe(b.etcd.Spec.Backup.Probes, b.getBackupRestoreContainerProbeHandler()),
+		StartupProbe:    getStartupProbe(b.etcd.Spec.Backup.Probes, b.getBackupRestoreContainerProbeHandler()),
 		Ports: []corev1.ContainerPort{
 			{
 				Name:          serverPortName,
@@ -412,7 +627,7 @@ func (b *stsBuilder) getBackupRestoreContainer() (corev1.Container, error) {
 			},
 		},
 		Env:       env,
-		Resources: ptr.Deref(b.etcd.Spec.Backup.Resources, defaultResourceRequirements),
+		Resources: recommender.GetContainerResources(b.etcd, common.ContainerNameEtcdBackupRestore, ptr.Deref(b.etcd.Spec.Backup.Resources, defaultResourceRequirements)),
 		SecurityContext: &corev1.SecurityContext{
 			AllowPrivilegeEscalation: ptr.To(false),
 		},
@@ -496,8 +711,8 @@ func (b *stsBuilder) getBackupRestoreContainerCommandArgs() []string {
 	commandArgs = append(commandArgs, fmt.Sprintf("--k8s-heartbeat-duration=%s", heartbeatDuration))
 
 	var quota = defaultQuota
-	if b.etcd.Spec.Etcd.Quota != nil {
-		quota = b.etcd.Spec.Etcd.Quota.Value()
+	if etcdQuota := druidv1alpha1.GetQuota(b.etcd); etcdQuota != nil {
+		quota = etcdQuota.Value()
 	}
 	commandArgs = append(commandArgs, fmt.Sprintf("--embedded-etcd-quota-bytes=%d", quota))
 	if ptr.Deref(b.etcd.Spec.Backup.EnableProfiling, false) {
@@ -516,6 +731,44 @@ func (b *stsBuilder) getBackupRestoreContainerCommandArgs() []string {
 	return commandArgs
 }
 
+// checkBackupRestoreImageSupportsSpec returns an error if the Etcd uses features of etcd-backup-restore which are not
+// supported by the version of the etcd-backup-restore image, which would otherwise fail to start because of unknown
+// command line arguments.
+func (b *stsBuilder) checkBackupRestoreImageSupportsSpec() error {
+	if !b.etcd.IsBackupStoreEnabled() {
+		return nil
+	}
+	if err := druidstore.CheckGarbageCollectionPolicySupported(b.etcd.Spec.Backup.GarbageCollectionPolicy, b.etcdBackupRestoreImage); err != nil {
+		return err
+	}
+	if err := druidstore.CheckCompressionSupported(b.etcd.Spec.Backup.SnapshotCompression, b.etcdBackupRestoreImage); err != nil {
+		return err
+	}
+	return druidstore.CheckEncryptionSupported(b.etcd.Spec.Backup.Encryption, b.etcdBackupRestoreImage)
+}
+
+// getSecondaryStoreCommandArgs returns the command line arguments which configure the replication of snapshots to the
+// secondary stores. They are left out for etcd-backup-restore images which do not support secondary stores, so that
+// the snapshots are still taken and uploaded to the primary store.
+func (b *stsBuilder) getSecondaryStoreCommandArgs() ([]string, error) {
+	if !b.etcd.IsBackupStoreEnabled() || len(b.etcd.Spec.Backup.SecondaryStores) == 0 {
+		return nil, nil
+	}
+	if !druidstore.SupportsSecondaryStores(b.etcdBackupRestoreImage) {
+		b.logger.Info("Snapshots are not replicated to the secondary stores as they are not supported by the etcd-backup-restore image", "image", b.etcdBackupRestoreImage)
+		return nil, nil
+	}
+	commandArgs := make([]string, 0, len(b.etcd.Spec.Backup.SecondaryStores))
+	for _, secondaryStore := range b.etcd.Spec.Backup.SecondaryStores {
+		arg, err := druidstore.GetSecondaryStoreArg(b.etcd.ObjectMeta, secondaryStore)
+		if err != nil {
+			return nil, err
+		}
+		commandArgs = append(commandArgs, arg)
+	}
+	return commandArgs, nil
+}
+
 func (b *stsBuilder) getBackupStoreCommandArgs() []string {
 	var commandArgs []string
 
@@ -558,20 +811,17 @@ func (b *stsBuilder) getBackupStoreCommandArgs() []string {
 	if garbageCollectionPolicy == "LimitBased" {
 		commandArgs = append(commandArgs, fmt.Sprintf("--max-backups=%d", ptr.Deref(b.etcd.Spec.Backup.MaxBackupsLimitBasedGC, defaultMaxBackupsLimitBasedGC)))
 	}
+	if garbageCollectionPolicy == druidv1alpha1.GarbageCollectionPolicyTiered {
+		commandArgs = append(commandArgs, druidstore.GetGarbageCollectionTierArgs(b.etcd.Spec.Backup.GarbageCollectionTiers)...)
+	}
 	if b.etcd.Spec.Backup.GarbageCollectionPeriod != nil {
 		commandArgs = append(commandArgs, fmt.Sprintf("--garbage-collection-period=%s", b.etcd.Spec.Backup.GarbageCollectionPeriod.Duration.String()))
 	}
 
 	// Snapshot compression and timeout command line args
 	// -----------------------------------------------------------------------------------------------------------------
-	compressionEnabled, compressionPolicy := druidv1alpha1.DefaultCompressionEnabled, druidv1alpha1.DefaultCompression
-	if b.etcd.Spec.Backup.SnapshotCompression != nil {
-		compressionEnabled = ptr.Deref(b.etcd.Spec.Backup.SnapshotCompression.Enabled, druidv1alpha1.DefaultCompressionEnabled)
-		compressionPolicy = ptr.Deref(b.etcd.Spec.Backup.SnapshotCompression.Policy, druidv1alpha1.DefaultCompression)
-	}
-
-	commandArgs = append(commandArgs, fmt.Sprintf("--compress-snapshots=%t", compressionEnabled))
-	commandArgs = append(commandArgs, fmt.Sprintf("--compression-policy=%s", compressionPolicy))
+	commandArgs = append(commandArgs, druidstore.GetCompressionArgs(b.etcd.Spec.Backup.SnapshotCompression)...)
+	commandArgs = append(commandArgs, druidstore.GetEncryptionArgs(b.etcd.Spec.Backup.Encryption, "", common.VolumeMountPathBackupEncryption)...)
 
 	etcdSnapshotTimeout := defaultEtcdSnapshotTimeout
 	if b.etcd.Spec.Backup.EtcdSnapshotTimeout != nil {
@@ -603,6 +853,60 @@ func (b *stsBuilder) getEtcdContainerReadinessHandler() corev1.ProbeHandler {
 	}
 }
 
+// getEtcdContainerProbeHandler returns the handler for the liveness and startup probes of the etcd container.
+// It targets the health endpoint of etcd on its metrics listener. The readiness endpoint of the etcd-wrapper fails
+// whenever the cluster has lost its quorum, which would restart all members at once. A serializable health check only
+// requires the member itself to serve requests, not a leader. NOSPACE alarms are excluded, since they are disarmed by
+// etcd-druid once the quota has been expanded and a restart would not resolve them.
+func (b *stsBuilder) getEtcdContainerProbeHandler() corev1.ProbeHandler {
+	return corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path:   etcdHealthEndpoint,
+			Port:   intstr.FromInt32(common.DefaultPortEtcdMetrics),
+			Scheme: corev1.URISchemeHTTP,
+		},
+	}
+}
+
+// getBackupRestoreContainerProbeHandler returns the handler for the liveness and startup probes of the backup-restore container.
+// It targets the health endpoint of backup-restore, which only reports healthy once the data directory has been
+// validated or restored. Hence, the startup probe has to cover the longest expected restoration.
+func (b *stsBuilder) getBackupRestoreContainerProbeHandler() corev1.ProbeHandler {
+	return corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path:   backupRestoreHealthEndpoint,
+			Port:   intstr.FromInt32(b.backupPort),
+			Scheme: utils.IfConditionOr(b.etcd.Spec.Backup.TLS == nil, corev1.URISchemeHTTP, corev1.URISchemeHTTPS),
+		},
+	}
+}
+
+func getLivenessProbe(probes *druidv1alpha1.ContainerProbes, handler corev1.ProbeHandler) *corev1.Probe {
+	if probes == nil || probes.Liveness == nil {
+		return nil
+	}
+	return buildProbe(*probes.Liveness, handler, defaultLivenessFailureThreshold)
+}
+
+// getStartupProbe returns a startup probe whenever either a startup or a liveness probe is configured, as the liveness
+// probe must not take effect before the container has started, which for etcd includes restoring its data directory.
+func getStartupProbe(probes *druidv1alpha1.ContainerProbes, handler corev1.ProbeHandler) *corev1.Probe {
+	if probes == nil || (probes.Startup == nil && probes.Liveness == nil) {
+		return nil
+	}
+	return buildProbe(ptr.Deref(probes.Startup, druidv1alpha1.ProbeThresholds{}), handler, defaultStartupFailureThreshold)
+}
+
+func buildProbe(thresholds druidv1alpha1.ProbeThresholds, handler corev1.ProbeHandler, defaultFailureThreshold int32) *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: ptr.Deref(thresholds.InitialDelaySeconds, 0),
+		PeriodSeconds:       ptr.Deref(thresholds.PeriodSeconds, defaultProbePeriodSeconds),
+		TimeoutSeconds:      ptr.Deref(thresholds.TimeoutSeconds, defaultProbeTimeoutSeconds),
+		FailureThreshold:    ptr.Deref(thresholds.FailureThreshold, defaultFailureThreshold),
+	}
+}
+
 func (b *stsBuilder) getEtcdContainerCommandArgs() []string {
 	commandArgs := []string{"start-etcd"}
 	commandArgs = append(commandArgs, fmt.Sprintf("--backup-restore-host-port=%s-local:%d", b.etcd.Name, b.backupPort))
@@ -651,6 +955,46 @@ func (b *stsBuilder) getPodSecurityContext() *corev1.PodSecurityContext {
 	}
 }
 
+// getTopologySpreadConstraints returns the topology spread constraints configured in the Etcd spec together with the
+// constraints generated for the topology policy. A constraint is only generated for a topology key that is not
+// already covered by a configured constraint, and not at all for a single member etcd cluster.
+func (b *stsBuilder) getTopologySpreadConstraints() []corev1.TopologySpreadConstraint {
+	constraints := b.etcd.Spec.SchedulingConstraints.TopologySpreadConstraints
+	topologyPolicy := b.etcd.Spec.SchedulingConstraints.TopologyPolicy
+	if topologyPolicy == nil || b.replicas <= 1 {
+		return constraints
+	}
+
+	configuredTopologyKeys := sets.New[string]()
+	for _, constraint := range constraints {
+		configuredTopologyKeys.Insert(constraint.TopologyKey)
+	}
+	generated := []corev1.TopologySpreadConstraint{b.newTopologySpreadConstraint(corev1.LabelHostname, nil)}
+	if *topologyPolicy == druidv1alpha1.TopologyPolicyMultiZonal {
+		generated = append(generated, b.newTopologySpreadConstraint(corev1.LabelTopologyZone, ptr.To(min(b.replicas, maxTopologySpreadMinDomains))))
+	}
+
+	result := append([]corev1.TopologySpreadConstraint{}, constraints...)
+	for _, constraint := range generated {
+		if !configuredTopologyKeys.Has(constraint.TopologyKey) {
+			result = append(result, constraint)
+		}
+	}
+	return result
+}
+
+func (b *stsBuilder) newTopologySpreadConstraint(topologyKey string, minDomains *int32) corev1.TopologySpreadConstraint {
+	return corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       topologyKey,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: druidv1alpha1.GetDefaultLabels(b.etcd.ObjectMeta),
+		},
+		MinDomains: minDomains,
+	}
+}
+
 func getEtcdContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
 	secretVolumeMounts := make([]corev1.VolumeMount, 0, 6)
 	if etcd.Spec.Etcd.ClientUrlTLS != nil {
@@ -737,6 +1081,21 @@ func (b *stsBuilder) getPodVolumes(ctx component.OperatorContext) ([]corev1.Volu
 		if backupVolume != nil {
 			volumes = append(volumes, *backupVolume)
 		}
+		if b.provider != nil {
+			if tokenVolume := druidstore.GetWorkloadIdentityTokenVolume(b.etcd.Spec.Backup.Store, *b.provider, common.VolumeNameWorkloadIdentityToken); tokenVolume != nil {
+				volumes = append(volumes, *tokenVolume)
+			}
+		}
+		if encryptionVolume := druidstore.GetEncryptionVolume(b.etcd.Spec.Backup.Encryption, common.VolumeNameBackupEncryption); encryptionVolume != nil {
+			volumes = append(volumes, *encryptionVolume)
+		}
+		for _, secondaryStore := range b.etcd.Spec.Backup.SecondaryStores {
+			secondaryStoreVolume, err := druidstore.GetSecondaryStoreVolume(secondaryStore)
+			if err != nil {
+				return nil, err
+			}
+			volumes = append(volumes, *secondaryStoreVolume)
+		}
 	}
 	return volumes, nil
 }
@@ -854,6 +1213,9 @@ func (b *stsBuilder) getBackupVolume(ctx component.OperatorContext) (*corev1.Vol
 			},
 		}, nil
 	case druidstore.GCS, druidstore.S3, druidstore.OSS, druidstore.ABS, druidstore.Swift, druidstore.OCS:
+		if !druidstore.UsesSecretCredentials(store) {
+			return nil, nil
+		}
 		if store.SecretRef == nil {
 			return nil, fmt.Errorf("etcd: %v, no secretRef configured for backup store", druidv1alpha1.GetNamespaceName(b.etcd.ObjectMeta))
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
@@ -23,6 +24,7 @@ import (
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -44,6 +46,8 @@ const (
 	ErrCreateEtcdOpsTask druidapicommon.ErrorCode = "ERR_CREATE_ETCDOPSTASK"
 	// ErrGetEtcdWrapperImage indicates an error in getting the etcd wrapper image from the image vector.
 	ErrGetEtcdWrapperImage druidapicommon.ErrorCode = "ERR_GET_ETCD_WRAPPER_IMAGE"
+	// ErrCheckBackupEncryptionKeys indicates that the keys required to restore from the encrypted backups are not available.
+	ErrCheckBackupEncryptionKeys druidapicommon.ErrorCode = "ERR_CHECK_BACKUP_ENCRYPTION_KEYS"
 
 	// Pre-sync snapshot task constants
 	preSyncTaskPrefixHibernation = "presync-snapshot-hibernation-"
@@ -104,6 +108,12 @@ func (r _resource) PreSync(ctx component.OperatorContext, etcd *druidv1alpha1.Et
 	}
 
 	if etcd.Spec.Replicas == 0 {
+		// A hibernated etcd cluster is restored from its backups when it is woken up, therefore all keys which are
+		// required to decrypt them need to be present before the cluster is hibernated.
+		if err = druidstore.CheckEncryptionKeys(ctx, r.client, etcd.Namespace, etcd.Spec.Backup.Encryption, etcd.Status.BackupEncryptionKeyIDs); err != nil {
+			return druiderr.WrapError(err, ErrCheckBackupEncryptionKeys, component.OperationPreSync,
+				fmt.Sprintf("Backup encryption keys required for restoration are not available for etcd: %v", client.ObjectKeyFromObject(etcd)))
+		}
 		return r.ensurePreSyncSnapshot(ctx, etcd, preSyncTaskPrefixHibernation)
 	}
 
@@ -234,6 +244,9 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 			component.OperationSync,
 			fmt.Sprintf("Error getting StatefulSet: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
 	}
+	if err = r.syncSecretsCheckSum(ctx, etcd, existingSTS); err != nil {
+		return err
+	}
 	// There is no StatefulSet present. Create one.
 	if existingSTS == nil {
 		// Check etcd observed generation to determine if the etcd cluster is new or not.
@@ -449,6 +462,81 @@ func (r _resource) handleTLSChanges(ctx component.OperatorContext, etcd *druidv1
 	}
 }
 
+// syncSecretsCheckSum computes the checksum of the secrets referenced by the Etcd if it opted in to rolling its pods on
+// secret changes, and sets it in the operator context to be reflected on the pod template. A changed checksum causes a
+// rolling update of the StatefulSet, which is deferred while another rollout is still in progress. It is not deferred
+// for members which are not ready, as the changed secrets may be required for them to become ready again.
+func (r _resource) syncSecretsCheckSum(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) error {
+	if !ptr.Deref(etcd.Spec.RollPodsOnSecretChange, false) {
+		return nil
+	}
+	checkSum, err := kubernetes.ComputeSecretsCheckSum(ctx, r.client, etcd)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncStatefulSet,
+			component.OperationSync,
+			fmt.Sprintf("Error computing checksum of secrets for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if existingSts != nil &&
+		existingSts.Spec.Template.Annotations[common.CheckSumKeySecrets] != checkSum &&
+		isRolloutInProgress(existingSts) {
+		return druiderr.New(
+			druiderr.ErrRequeueAfter,
+			component.OperationSync,
+			fmt.Sprintf("A rollout of StatefulSet %v is in progress. Rolling the pods for secret changes is deferred until it has completed. CurrentRevision: %s, UpdateRevision: %s", client.ObjectKeyFromObject(existingSts), existingSts.Status.CurrentRevision, existingSts.Status.UpdateRevision))
+	}
+	ctx.Data[common.CheckSumKeySecrets] = checkSum
+	return nil
+}
+
+// GetPendingDisruptiveOperation determines whether a Sync of the StatefulSet for the given Etcd would roll its pods.
+// It returns druidv1alpha1.DeferredOperationTypeCertificateRotation if only the checksum of the referenced secrets
+// changes, druidv1alpha1.DeferredOperationTypeRollingUpdate for any other change of the pod template and an empty
+// operation type if the pods are not rolled. The checksum of the configmap is expected to be set in the operator
+// context. Changes are not considered disruptive if the StatefulSet does not exist yet or has no replicas.
+func GetPendingDisruptiveOperation(ctx component.OperatorContext, cl client.Client, imageVector imagevector.ImageVector, etcd *druidv1alpha1.Etcd) (druidv1alpha1.DeferredOperationType, error) {
+	r := _resource{client: cl, imageVector: imageVector, logger: ctx.Logger}
+	existingSts, err := r.getExistingStatefulSet(ctx, etcd.ObjectMeta)
+	if err != nil {
+		return "", err
+	}
+	if existingSts == nil || ptr.Deref(existingSts.Spec.Replicas, 0) == 0 || etcd.Spec.Replicas == 0 {
+		return "", nil
+	}
+	if ptr.Deref(etcd.Spec.RollPodsOnSecretChange, false) {
+		checkSum, err := kubernetes.ComputeSecretsCheckSum(ctx, cl, etcd)
+		if err != nil {
+			return "", err
+		}
+		ctx.Data[common.CheckSumKeySecrets] = checkSum
+	}
+	desiredSts := existingSts.DeepCopy()
+	builder, err := newStsBuilder(cl, ctx.Logger, etcd, *existingSts.Spec.Replicas, imageVector, false, desiredSts)
+	if err != nil {
+		return "", err
+	}
+	if err = builder.Build(ctx); err != nil {
+		return "", err
+	}
+	// The patch is only dry-run so that the pod template is defaulted by the API server before it is compared.
+	if err = cl.Patch(ctx, desiredSts, client.MergeFrom(existingSts), client.DryRunAll); err != nil {
+		return "", err
+	}
+	if apiequality.Semantic.DeepEqual(existingSts.Spec.Template, desiredSts.Spec.Template) {
+		return "", nil
+	}
+	desiredTemplate := desiredSts.Spec.Template.DeepCopy()
+	if checkSum, ok := existingSts.Spec.Template.Annotations[common.CheckSumKeySecrets]; ok {
+		desiredTemplate.Annotations = utils.MergeMaps(desiredTemplate.Annotations, map[string]string{common.CheckSumKeySecrets: checkSum})
+	} else {
+		delete(desiredTemplate.Annotations, common.CheckSumKeySecrets)
+	}
+	if apiequality.Semantic.DeepEqual(existingSts.Spec.Template, *desiredTemplate) {
+		return druidv1alpha1.DeferredOperationTypeCertificateRotation, nil
+	}
+	return druidv1alpha1.DeferredOperationTypeRollingUpdate, nil
+}
+
 func shouldRequeueForMultiNodeEtcdIfPodsNotReady(sts *appsv1.StatefulSet) bool {
 	return sts.Spec.Replicas != nil &&
 		*sts.Spec.Replicas > 1 &&
@@ -456,6 +544,12 @@ func shouldRequeueForMultiNodeEtcdIfPodsNotReady(sts *appsv1.StatefulSet) bool {
 		sts.Status.ReadyReplicas < *sts.Spec.Replicas
 }
 
+// isRolloutInProgress checks if the StatefulSet controller has not yet observed the latest spec of the given StatefulSet
+// or has not yet rolled all of its pods to the latest revision of the pod template.
+func isRolloutInProgress(sts *appsv1.StatefulSet) bool {
+	return sts.Status.ObservedGeneration < sts.Generation || sts.Status.CurrentRevision != sts.Status.UpdateRevision
+}
+
 func (r _resource) hasTLSEnablementForPeerURLReflectedOnSTS(etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) bool {
 	newEtcdWrapperPeerTLSVolMounts := getEtcdContainerPeerVolumeMounts(etcd)
 	containerPeerTLSVolMounts := kubernetes.GetEtcdContainerPeerTLSVolumeMounts(existingSts)
@@ -6,8 +6,10 @@ package statefulset
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
@@ -18,6 +20,7 @@ import (
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
+	k8sutils "github.com/gardener/etcd-druid/internal/utils/kubernetes"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
 	"github.com/go-logr/logr"
@@ -25,7 +28,9 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -103,6 +108,8 @@ func TestPreSync(t *testing.T) {
 		etcdReplicas       int32
 		etcdWrapperImage   string
 		existingTasks      []*druidv1alpha1.EtcdOpsTask
+		encryptionKeyIDs   []string
+		encryptionKeys     map[string][]byte
 		expectedErrCode    *druidapicommon.ErrorCode
 	}{
 		{
@@ -167,6 +174,31 @@ func TestPreSync(t *testing.T) {
 			etcdWrapperImage:   oldImage,
 			existingTasks:      []*druidv1alpha1.EtcdOpsTask{buildPreSyncTask(preSyncTaskPrefixHibernation, maxPreSyncRetries-1, ptr.To(druidv1alpha1.TaskStateFailed))},
 		},
+		{
+			name:               "hibernation succeeds when all backup encryption keys are available",
+			backupEnabled:      true,
+			stsExists:          true,
+			featureGateEnabled: false,
+			stsReplicas:        3,
+			etcdReplicas:       0,
+			etcdWrapperImage:   currentImage,
+			existingTasks:      []*druidv1alpha1.EtcdOpsTask{buildPreSyncTask(preSyncTaskPrefixHibernation, 0, ptr.To(druidv1alpha1.TaskStateSucceeded))},
+			encryptionKeyIDs:   []string{"key-1", "key-2"},
+			encryptionKeys:     map[string][]byte{"key-1": []byte("old-key"), "key-2": []byte("new-key")},
+		},
+		{
+			name:               "hibernation fails when a backup encryption key is missing",
+			backupEnabled:      true,
+			stsExists:          true,
+			featureGateEnabled: false,
+			stsReplicas:        3,
+			etcdReplicas:       0,
+			etcdWrapperImage:   currentImage,
+			existingTasks:      []*druidv1alpha1.EtcdOpsTask{buildPreSyncTask(preSyncTaskPrefixHibernation, 0, ptr.To(druidv1alpha1.TaskStateSucceeded))},
+			encryptionKeyIDs:   []string{"key-1", "key-2"},
+			encryptionKeys:     map[string][]byte{"key-2": []byte("new-key")},
+			expectedErrCode:    ptr.To(ErrCheckBackupEncryptionKeys),
+		},
 		{
 			name:               "upgrade succeeds when task completed",
 			backupEnabled:      true,
@@ -267,6 +299,13 @@ func TestPreSync(t *testing.T) {
 				etcdBuilder = etcdBuilder.WithoutProvider()
 			}
 			etcd := etcdBuilder.Build()
+			if tc.encryptionKeyIDs != nil {
+				etcd.Spec.Backup.Encryption = &druidv1alpha1.EncryptionSpec{
+					KeyID:     tc.encryptionKeyIDs[len(tc.encryptionKeyIDs)-1],
+					SecretRef: &corev1.LocalObjectReference{Name: testutils.BackupEncryptionSecretName},
+				}
+				etcd.Status.BackupEncryptionKeyIDs = tc.encryptionKeyIDs
+			}
 
 			iv := testutils.CreateImageVector(true, true)
 
@@ -283,6 +322,12 @@ func TestPreSync(t *testing.T) {
 			for _, task := range tc.existingTasks {
 				existingObjects = append(existingObjects, task)
 			}
+			if tc.encryptionKeys != nil {
+				existingObjects = append(existingObjects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: testutils.BackupEncryptionSecretName, Namespace: etcd.Namespace},
+					Data:       tc.encryptionKeys,
+				})
+			}
 
 			cl := testutils.NewTestClientBuilder().
 				WithScheme(kubernetes.Scheme).
@@ -384,6 +429,596 @@ func TestSyncWhenNoSTSExists(t *testing.T) {
 	}
 }
 
+func TestSyncSecretsCheckSum(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		rollPodsOnSecretChange bool
+		stsExists              bool
+		stsReadyReplicas       int32
+		stsRolloutInProgress   bool
+		expectCheckSum         bool
+		expectRequeue          bool
+	}{
+		{
+			name:           "does not compute a checksum if the etcd did not opt in",
+			stsExists:      true,
+			expectCheckSum: false,
+		},
+		{
+			name:                   "computes a checksum if the statefulset does not exist yet",
+			rollPodsOnSecretChange: true,
+			expectCheckSum:         true,
+		},
+		{
+			name:                   "computes a checksum if all members are ready",
+			rollPodsOnSecretChange: true,
+			stsExists:              true,
+			stsReadyReplicas:       3,
+			expectCheckSum:         true,
+		},
+		{
+			name:                   "computes a checksum if the checksum has changed and not all members are ready",
+			rollPodsOnSecretChange: true,
+			stsExists:              true,
+			stsReadyReplicas:       2,
+			expectCheckSum:         true,
+		},
+		{
+			name:                   "requeues if the checksum has changed and another rollout is in progress",
+			rollPodsOnSecretChange: true,
+			stsExists:              true,
+			stsReadyReplicas:       3,
+			stsRolloutInProgress:   true,
+			expectRequeue:          true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).WithClientTLS().Build()
+			etcd.Spec.RollPodsOnSecretChange = ptr.To(tc.rollPodsOnSecretChange)
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			g.Expect(testutils.CreateSecrets(context.Background(), cl, etcd.Namespace, k8sutils.GetReferencedSecretNames(etcd)...)).To(Succeed())
+			var existingSts *appsv1.StatefulSet
+			if tc.stsExists {
+				existingSts = buildStatefulSetWithImage(etcd.ObjectMeta, 3, "")
+				existingSts.Status.ReadyReplicas = tc.stsReadyReplicas
+				if tc.stsRolloutInProgress {
+					existingSts.Status.CurrentRevision = "etcd-test-1"
+					existingSts.Status.UpdateRevision = "etcd-test-2"
+				}
+			}
+			r := _resource{client: cl, logger: logr.Discard()}
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+
+			err := r.syncSecretsCheckSum(opCtx, etcd, existingSts)
+			if tc.expectRequeue {
+				g.Expect(druiderr.AsDruidError(err)).To(HaveField("Code", druidapicommon.ErrorCode(druiderr.ErrRequeueAfter)))
+				g.Expect(opCtx.Data).ToNot(HaveKey(common.CheckSumKeySecrets))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			if tc.expectCheckSum {
+				g.Expect(opCtx.Data).To(HaveKeyWithValue(common.CheckSumKeySecrets, Not(BeEmpty())))
+			} else {
+				g.Expect(opCtx.Data).ToNot(HaveKey(common.CheckSumKeySecrets))
+			}
+		})
+	}
+}
+
+func TestGetPendingDisruptiveOperation(t *testing.T) {
+	testCases := []struct {
+		name       string
+		noSts      bool
+		mutateEtcd func(etcd *druidv1alpha1.Etcd)
+		rotateCert bool
+		expected   druidv1alpha1.DeferredOperationType
+	}{
+		{
+			name:  "no disruptive operation if the statefulset does not exist",
+			noSts: true,
+			mutateEtcd: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Spec.Annotations = map[string]string{"foo": "bar"}
+			},
+		},
+		{
+			name: "no disruptive operation if the pod template does not change",
+		},
+		{
+			name: "rolling update if the pod template changes",
+			mutateEtcd: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Spec.Annotations = map[string]string{"foo": "bar"}
+			},
+			expected: druidv1alpha1.DeferredOperationTypeRollingUpdate,
+		},
+		{
+			name:       "certificate rotation if only the referenced secrets change",
+			rotateCert: true,
+			expected:   druidv1alpha1.DeferredOperationTypeCertificateRotation,
+		},
+		{
+			name: "no disruptive operation if the etcd is scaled down to zero",
+			mutateEtcd: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Spec.Replicas = 0
+				etcd.Spec.Annotations = map[string]string{"foo": "bar"}
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).WithClientTLS().Build()
+			etcd.Spec.RollPodsOnSecretChange = ptr.To(true)
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			secretNames := k8sutils.GetReferencedSecretNames(etcd)
+			g.Expect(testutils.CreateSecrets(context.Background(), cl, etcd.Namespace, secretNames...)).To(Succeed())
+			if !tc.noSts {
+				opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+				opCtx.Data[common.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
+				g.Expect(New(cl, iv).Sync(opCtx, etcd)).To(Succeed())
+			}
+			if tc.mutateEtcd != nil {
+				tc.mutateEtcd(etcd)
+			}
+			if tc.rotateCert {
+				secret := &corev1.Secret{}
+				g.Expect(cl.Get(context.Background(), client.ObjectKey{Name: secretNames[0], Namespace: etcd.Namespace}, secret)).To(Succeed())
+				secret.Data = map[string][]byte{"ca.crt": []byte("rotated")}
+				g.Expect(cl.Update(context.Background(), secret)).To(Succeed())
+			}
+
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			opCtx.Data[common.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
+			opType, err := GetPendingDisruptiveOperation(opCtx, cl, iv, etcd)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(opType).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestBuildWithPodTemplateOverrides(t *testing.T) {
+	testCases := []struct {
+		name        string
+		overrides   *druidv1alpha1.PodTemplateOverrides
+		expectedErr bool
+	}{
+		{
+			name: "applies valid overrides",
+			overrides: &druidv1alpha1.PodTemplateOverrides{
+				Tolerations:          []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "etcd", Effect: corev1.TaintEffectNoSchedule}},
+				NodeSelector:         map[string]string{"pool": "etcd"},
+				RuntimeClassName:     ptr.To("gvisor"),
+				SecurityContext:      &corev1.PodSecurityContext{FSGroup: ptr.To[int64](1000)},
+				AdditionalVolumes:    []druidv1alpha1.AdditionalVolume{{Name: "logs", EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				AdditionalContainers: []druidv1alpha1.AdditionalContainer{{Name: "log-shipper", Image: "log-shipper:v1", VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/logs"}}}},
+				Containers: []druidv1alpha1.ContainerOverrides{
+					{
+						Name:         common.ContainerNameEtcd,
+						Env:          []corev1.EnvVar{{Name: "GOMAXPROCS", Value: "2"}},
+						VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/var/log/etcd"}},
+					},
+				},
+			},
+		},
+		{
+			name: "fails when an additional volume clashes with a volume managed by etcd-druid",
+			overrides: &druidv1alpha1.PodTemplateOverrides{
+				AdditionalVolumes: []druidv1alpha1.AdditionalVolume{{Name: common.VolumeNameEtcdConfig, EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "fails when an additional volume clashes with the data volume",
+			overrides: &druidv1alpha1.PodTemplateOverrides{
+				AdditionalVolumes: []druidv1alpha1.AdditionalVolume{{Name: "etcd-main", EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "fails when an additional container uses a port managed by etcd-druid",
+			overrides: &druidv1alpha1.PodTemplateOverrides{
+				AdditionalContainers: []druidv1alpha1.AdditionalContainer{{Name: "proxy", Image: "proxy:v1", Ports: []corev1.ContainerPort{{ContainerPort: common.DefaultPortEtcdClient}}}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "fails when a volume mount override clashes with a mount path managed by etcd-druid",
+			overrides: &druidv1alpha1.PodTemplateOverrides{
+				AdditionalVolumes: []druidv1alpha1.AdditionalVolume{{Name: "logs", EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				Containers: []druidv1alpha1.ContainerOverrides{
+					{
+						Name:         common.ContainerNameEtcdBackupRestore,
+						VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: etcdConfigFileMountPath}},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+			etcd.Spec.PodTemplateOverrides = tc.overrides
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			sts := &appsv1.StatefulSet{}
+			builder, err := newStsBuilder(cl, logr.Discard(), etcd, 3, iv, false, sts)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			err = builder.Build(opCtx)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			podSpec := sts.Spec.Template.Spec
+			g.Expect(podSpec.Tolerations).To(Equal(tc.overrides.Tolerations))
+			g.Expect(podSpec.NodeSelector).To(Equal(tc.overrides.NodeSelector))
+			g.Expect(podSpec.RuntimeClassName).To(Equal(tc.overrides.RuntimeClassName))
+			g.Expect(podSpec.SecurityContext.FSGroup).To(Equal(ptr.To[int64](1000)))
+			g.Expect(podSpec.SecurityContext.RunAsNonRoot).To(Equal(ptr.To(true)))
+			g.Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{Name: "logs", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}))
+			g.Expect(podSpec.Containers).To(HaveLen(3))
+			g.Expect(podSpec.Containers[2]).To(Equal(corev1.Container{Name: "log-shipper", Image: "log-shipper:v1", VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/logs"}}}))
+			g.Expect(podSpec.Containers[0].Env).To(ContainElement(tc.overrides.Containers[0].Env[0]))
+			g.Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(tc.overrides.Containers[0].VolumeMounts[0]))
+		})
+	}
+}
+
+func TestBuildWithProbes(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		probes                *druidv1alpha1.ContainerProbes
+		expectedLivenessProbe *corev1.Probe
+		expectedStartupProbe  *corev1.Probe
+	}{
+		{
+			name: "does not configure probes if none are specified",
+		},
+		{
+			name:                  "configures a startup probe with default thresholds if only a liveness probe is specified",
+			probes:                &druidv1alpha1.ContainerProbes{Liveness: &druidv1alpha1.ProbeThresholds{}},
+			expectedLivenessProbe: &corev1.Probe{PeriodSeconds: defaultProbePeriodSeconds, TimeoutSeconds: defaultProbeTimeoutSeconds, FailureThreshold: defaultLivenessFailureThreshold},
+			expectedStartupProbe:  &corev1.Probe{PeriodSeconds: defaultProbePeriodSeconds, TimeoutSeconds: defaultProbeTimeoutSeconds, FailureThreshold: defaultStartupFailureThreshold},
+		},
+		{
+			name:                 "configures only a startup probe if no liveness probe is specified",
+			probes:               &druidv1alpha1.ContainerProbes{Startup: &druidv1alpha1.ProbeThresholds{PeriodSeconds: ptr.To[int32](30)}},
+			expectedStartupProbe: &corev1.Probe{PeriodSeconds: 30, TimeoutSeconds: defaultProbeTimeoutSeconds, FailureThreshold: defaultStartupFailureThreshold},
+		},
+		{
+			name: "configures probes with the specified thresholds",
+			probes: &druidv1alpha1.ContainerProbes{
+				Liveness: &druidv1alpha1.ProbeThresholds{InitialDelaySeconds: ptr.To[int32](5), PeriodSeconds: ptr.To[int32](20), TimeoutSeconds: ptr.To[int32](3), FailureThreshold: ptr.To[int32](3)},
+				Startup:  &druidv1alpha1.ProbeThresholds{FailureThreshold: ptr.To[int32](100)},
+			},
+			expectedLivenessProbe: &corev1.Probe{InitialDelaySeconds: 5, PeriodSeconds: 20, TimeoutSeconds: 3, FailureThreshold: 3},
+			expectedStartupProbe:  &corev1.Probe{PeriodSeconds: defaultProbePeriodSeconds, TimeoutSeconds: defaultProbeTimeoutSeconds, FailureThreshold: 100},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+			etcd.Spec.Etcd.Probes = tc.probes
+			etcd.Spec.Backup.Probes = tc.probes
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			sts := &appsv1.StatefulSet{}
+			builder, err := newStsBuilder(cl, logr.Discard(), etcd, 3, iv, false, sts)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(builder.Build(component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString()))).To(Succeed())
+
+			containers := sts.Spec.Template.Spec.Containers
+			g.Expect(containers).To(HaveLen(2))
+			for _, container := range containers {
+				handler := utils.IfConditionOr(container.Name == common.ContainerNameEtcd, builder.getEtcdContainerProbeHandler(), builder.getBackupRestoreContainerProbeHandler())
+				g.Expect(container.LivenessProbe).To(Equal(withProbeHandler(tc.expectedLivenessProbe, handler)))
+				g.Expect(container.StartupProbe).To(Equal(withProbeHandler(tc.expectedStartupProbe, handler)))
+				if container.Name == common.ContainerNameEtcd {
+					g.Expect(handler.HTTPGet).To(Equal(&corev1.HTTPGetAction{Path: etcdHealthEndpoint, Port: intstr.FromInt32(common.DefaultPortEtcdMetrics), Scheme: corev1.URISchemeHTTP}))
+				} else {
+					g.Expect(handler.HTTPGet).To(Equal(&corev1.HTTPGetAction{Path: backupRestoreHealthEndpoint, Port: intstr.FromInt32(common.DefaultPortEtcdBackupRestore), Scheme: corev1.URISchemeHTTP}))
+				}
+			}
+		})
+	}
+}
+
+func TestBuildWithBackupRestoreImageVersion(t *testing.T) {
+	const (
+		oldImage = "etcdbrctl:v0.41.2"
+		newImage = "etcdbrctl:v0.43.0"
+	)
+	withTieredGarbageCollection := func(backup *druidv1alpha1.BackupSpec) {
+		backup.GarbageCollectionPolicy = ptr.To[druidv1alpha1.GarbageCollectionPolicy](druidv1alpha1.GarbageCollectionPolicyTiered)
+		backup.GarbageCollectionTiers = []druidv1alpha1.GarbageCollectionTier{{Interval: metav1.Duration{Duration: time.Hour}, Count: ptr.To[int32](24)}}
+	}
+	withSecondaryStore := func(backup *druidv1alpha1.BackupSpec) {
+		backup.SecondaryStores = []druidv1alpha1.SecondaryStoreSpec{{
+			Name: "dr",
+			Store: druidv1alpha1.StoreSpec{
+				Provider:  ptr.To[druidv1alpha1.StorageProvider]("aws"),
+				Prefix:    "etcd-dr",
+				SecretRef: &corev1.SecretReference{Name: "etcd-dr-backup"},
+			},
+		}}
+	}
+	testCases := []struct {
+		name         string
+		image        string
+		backupFn     func(backup *druidv1alpha1.BackupSpec)
+		expectErr    bool
+		expectedArgs []string
+		// unexpectedArgPrefix is the prefix of arguments which must not be passed to the backup-restore container.
+		unexpectedArgPrefix string
+	}{
+		{
+			name:  "builds the backup-restore container for an image which does not support any new features if none are used",
+			image: oldImage,
+		},
+		{
+			name:  "fails for encryption with an image which does not support it",
+			image: oldImage,
+			backupFn: func(backup *druidv1alpha1.BackupSpec) {
+				backup.Encryption = &druidv1alpha1.EncryptionSpec{KeyID: "key-1", KMS: &druidv1alpha1.KMSSpec{Endpoint: "http://kms"}}
+			},
+			expectErr: true,
+		},
+		{
+			name:  "passes the encryption to an image which supports it",
+			image: newImage,
+			backupFn: func(backup *druidv1alpha1.BackupSpec) {
+				backup.Encryption = &druidv1alpha1.EncryptionSpec{KeyID: "key-1", KMS: &druidv1alpha1.KMSSpec{Endpoint: "http://kms"}}
+			},
+			expectedArgs: []string{"--encryption-key-id=key-1", "--encryption-kms-endpoint=http://kms"},
+		},
+		{
+			name:  "fails for the zstd compression policy with an image which does not support it",
+			image: oldImage,
+			backupFn: func(backup *druidv1alpha1.BackupSpec) {
+				backup.SnapshotCompression = &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.ZstdCompression)}
+			},
+			expectErr: true,
+		},
+		{
+			name:  "passes the zstd compression policy to an image which supports it",
+			image: newImage,
+			backupFn: func(backup *druidv1alpha1.BackupSpec) {
+				backup.SnapshotCompression = &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.ZstdCompression)}
+			},
+			expectedArgs: []string{"--compression-policy=zstd"},
+		},
+		{
+			name:      "fails for the Tiered garbage collection policy with an image which does not support it",
+			image:     oldImage,
+			backupFn:  withTieredGarbageCollection,
+			expectErr: true,
+		},
+		{
+			name:         "passes the Tiered garbage collection policy to an image which supports it",
+			image:        newImage,
+			backupFn:     withTieredGarbageCollection,
+			expectedArgs: []string{"--garbage-collection-policy=Tiered", "--garbage-collection-tier=interval=1h0m0s,count=24"},
+		},
+		{
+			name:                "leaves out the secondary stores for an image which does not support them",
+			image:               oldImage,
+			backupFn:            withSecondaryStore,
+			unexpectedArgPrefix: "--secondary-store=",
+		},
+		{
+			name:         "passes the secondary stores to an image which supports them",
+			image:        newImage,
+			backupFn:     withSecondaryStore,
+			expectedArgs: []string{"--secondary-store=name=dr,storage-provider=S3,store-prefix=etcd-dr,credentials-dir=/var/etcdbr/secondary-stores/dr,snapshot-lease-name=etcd-test-replicated-snap-dr,replicate-delta-snapshots=false"},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+			etcd.Spec.Backup.Image = ptr.To(tc.image)
+			if tc.backupFn != nil {
+				tc.backupFn(&etcd.Spec.Backup)
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			sts := &appsv1.StatefulSet{}
+			builder, err := newStsBuilder(cl, logr.Discard(), etcd, 3, iv, false, sts)
+			g.Expect(err).ToNot(HaveOccurred())
+			buildErr := builder.Build(component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString()))
+			if tc.expectErr {
+				g.Expect(buildErr).To(HaveOccurred())
+				return
+			}
+			g.Expect(buildErr).ToNot(HaveOccurred())
+			container := sts.Spec.Template.Spec.Containers[1]
+			g.Expect(container.Name).To(Equal(common.ContainerNameEtcdBackupRestore))
+			g.Expect(container.Args).To(ContainElements(tc.expectedArgs))
+			if tc.unexpectedArgPrefix != "" {
+				g.Expect(container.Args).ToNot(ContainElement(HavePrefix(tc.unexpectedArgPrefix)))
+			}
+		})
+	}
+}
+
+func TestBuildWithTopologyPolicy(t *testing.T) {
+	hostnameConstraint := func(etcd *druidv1alpha1.Etcd) corev1.TopologySpreadConstraint {
+		return corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelHostname,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)},
+		}
+	}
+	zoneConstraint := func(etcd *druidv1alpha1.Etcd, minDomains int32) corev1.TopologySpreadConstraint {
+		return corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)},
+			MinDomains:        ptr.To(minDomains),
+		}
+	}
+	userZoneConstraint := corev1.TopologySpreadConstraint{MaxSkew: 2, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway}
+
+	testCases := []struct {
+		name                string
+		replicas            int32
+		topologyPolicy      *druidv1alpha1.TopologyPolicy
+		constraints         []corev1.TopologySpreadConstraint
+		expectedConstraints func(etcd *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint
+	}{
+		{
+			name:        "does not generate constraints if no topology policy is set",
+			replicas:    3,
+			constraints: []corev1.TopologySpreadConstraint{userZoneConstraint},
+			expectedConstraints: func(_ *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint {
+				return []corev1.TopologySpreadConstraint{userZoneConstraint}
+			},
+		},
+		{
+			name:                "does not generate constraints for a single member etcd cluster",
+			replicas:            1,
+			topologyPolicy:      ptr.To(druidv1alpha1.TopologyPolicyMultiZonal),
+			expectedConstraints: func(_ *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint { return nil },
+		},
+		{
+			name:           "generates a hostname constraint for the Zonal topology policy",
+			replicas:       3,
+			topologyPolicy: ptr.To(druidv1alpha1.TopologyPolicyZonal),
+			expectedConstraints: func(etcd *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint {
+				return []corev1.TopologySpreadConstraint{hostnameConstraint(etcd)}
+			},
+		},
+		{
+			name:           "generates hostname and zone constraints for the MultiZonal topology policy",
+			replicas:       5,
+			topologyPolicy: ptr.To(druidv1alpha1.TopologyPolicyMultiZonal),
+			expectedConstraints: func(etcd *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint {
+				return []corev1.TopologySpreadConstraint{hostnameConstraint(etcd), zoneConstraint(etcd, 3)}
+			},
+		},
+		{
+			name:           "limits the minimum number of zones to the number of replicas",
+			replicas:       2,
+			topologyPolicy: ptr.To(druidv1alpha1.TopologyPolicyMultiZonal),
+			expectedConstraints: func(etcd *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint {
+				return []corev1.TopologySpreadConstraint{hostnameConstraint(etcd), zoneConstraint(etcd, 2)}
+			},
+		},
+		{
+			name:           "does not generate a constraint for a topology key which is already configured",
+			replicas:       3,
+			topologyPolicy: ptr.To(druidv1alpha1.TopologyPolicyMultiZonal),
+			constraints:    []corev1.TopologySpreadConstraint{userZoneConstraint},
+			expectedConstraints: func(etcd *druidv1alpha1.Etcd) []corev1.TopologySpreadConstraint {
+				return []corev1.TopologySpreadConstraint{userZoneConstraint, hostnameConstraint(etcd)}
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(tc.replicas).Build()
+			etcd.Spec.SchedulingConstraints.TopologyPolicy = tc.topologyPolicy
+			etcd.Spec.SchedulingConstraints.TopologySpreadConstraints = tc.constraints
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			sts := &appsv1.StatefulSet{}
+			builder, err := newStsBuilder(cl, logr.Discard(), etcd, tc.replicas, iv, false, sts)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(builder.Build(component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString()))).To(Succeed())
+
+			g.Expect(sts.Spec.Template.Spec.TopologySpreadConstraints).To(Equal(tc.expectedConstraints(etcd)))
+		})
+	}
+}
+
+func TestBuildWithAppliedResourceRecommendation(t *testing.T) {
+	applied := &druidv1alpha1.AppliedResourceRecommendation{
+		Etcd: &druidv1alpha1.ContainerResources{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("300m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		},
+		BackupRestore: &druidv1alpha1.ContainerResources{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+		},
+	}
+	testCases := []struct {
+		name                  string
+		apply                 bool
+		expectedEtcd          corev1.ResourceRequirements
+		expectedBackupRestore corev1.ResourceRequirements
+	}{
+		{
+			name:                  "uses the configured resources if the recommendation is not applied",
+			expectedEtcd:          defaultResourceRequirements,
+			expectedBackupRestore: defaultResourceRequirements,
+		},
+		{
+			name:                  "uses the applied recommended resources",
+			apply:                 true,
+			expectedEtcd:          corev1.ResourceRequirements{Requests: applied.Etcd.Requests, Limits: applied.Etcd.Limits},
+			expectedBackupRestore: corev1.ResourceRequirements{Requests: applied.BackupRestore.Requests},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			etcd.Spec.Etcd.Resources = nil
+			etcd.Spec.Backup.Resources = nil
+			etcd.Spec.ResourceRecommendation = &druidv1alpha1.ResourceRecommendationSpec{Apply: ptr.To(tc.apply)}
+			appliedJSON, err := json.Marshal(applied)
+			g.Expect(err).ToNot(HaveOccurred())
+			metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.AppliedResourceRecommendationAnnotation, string(appliedJSON))
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()})
+			sts := &appsv1.StatefulSet{}
+			builder, err := newStsBuilder(cl, logr.Discard(), etcd, etcd.Spec.Replicas, iv, false, sts)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(builder.Build(component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString()))).To(Succeed())
+
+			for _, container := range sts.Spec.Template.Spec.Containers {
+				switch container.Name {
+				case common.ContainerNameEtcd:
+					g.Expect(container.Resources).To(Equal(tc.expectedEtcd))
+				case common.ContainerNameEtcdBackupRestore:
+					g.Expect(container.Resources).To(Equal(tc.expectedBackupRestore))
+				}
+			}
+		})
+	}
+}
+
 // ----------------------------- TriggerDelete -------------------------------
 // ---------------------------- Helper Functions -----------------------------
 
@@ -406,6 +1041,15 @@ func buildBackupSecret() *corev1.Secret {
 	}
 }
 
+func withProbeHandler(probe *corev1.Probe, handler corev1.ProbeHandler) *corev1.Probe {
+	if probe == nil {
+		return nil
+	}
+	probe = probe.DeepCopy()
+	probe.ProbeHandler = handler
+	return probe
+}
+
 func buildPreSyncTask(prefix string, index int, state *druidv1alpha1.TaskState) *druidv1alpha1.EtcdOpsTask {
 	taskName := fmt.Sprintf("%s%d", prefix, index)
 	builder := testutils.EtcdOpsTaskBuilderWithDefaults(taskName, testutils.TestNamespace).
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"fmt"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// maxRecentCompactionFailures is the maximum number of compaction job failures that are retained in the Etcd status.
+	maxRecentCompactionFailures = 5
+
+	// compactionBackoffReasonConsecutiveFailures is the reason for the SnapshotCompactionBackoff condition when compaction is backing off.
+	compactionBackoffReasonConsecutiveFailures = "ConsecutiveCompactionFailures"
+	// compactionBackoffReasonNoConsecutiveFailures is the reason for the SnapshotCompactionBackoff condition when compaction is not backing off.
+	compactionBackoffReasonNoConsecutiveFailures = "NoConsecutiveCompactionFailures"
+
+	// eventReasonCompactionFailureThresholdReached is the reason for the event emitted when compaction is given up in favour of a full snapshot.
+	eventReasonCompactionFailureThresholdReached = "CompactionFailureThresholdReached"
+)
+
+// classifyCompactionFailure returns the failure class for the given compaction job failure reason.
+func classifyCompactionFailure(failureReason string) druidv1alpha1.SnapshotCompactionFailureClass {
+	switch failureReason {
+	case druidv1alpha1.PodFailureReasonPreemptionByScheduler,
+		druidv1alpha1.PodFailureReasonDeletionByTaintManager,
+		druidv1alpha1.PodFailureReasonEvictionByEvictionAPI,
+		druidv1alpha1.PodFailureReasonTerminationByKubelet:
+		return druidv1alpha1.SnapshotCompactionFailureClassDisruption
+	case druidv1alpha1.JobFailureReasonDeadlineExceeded:
+		return druidv1alpha1.SnapshotCompactionFailureClassDeadlineExceeded
+	case druidv1alpha1.PodFailureReasonProcessFailure:
+		return druidv1alpha1.SnapshotCompactionFailureClassProcessFailure
+	default:
+		return druidv1alpha1.SnapshotCompactionFailureClassUnknown
+	}
+}
+
+// computeCompactionBackoff returns the duration to wait before creating a new compaction job after the given number
+// of consecutive compaction job failures. The delay starts at the configured initial delay and doubles with every
+// further failure, capped at the configured maximum delay.
+func computeCompactionBackoff(consecutiveFailures int32, backoffConfig druidconfigv1alpha1.CompactionFailureBackoffConfiguration) time.Duration {
+	if consecutiveFailures <= 0 {
+		return 0
+	}
+	delay := backoffConfig.InitialDelay.Duration
+	for i := int32(1); i < consecutiveFailures && delay < backoffConfig.MaxDelay.Duration; i++ {
+		delay *= 2
+	}
+	return min(delay, backoffConfig.MaxDelay.Duration)
+}
+
+// recordCompactionJobFailure records the failure of a compaction job in the snapshot compaction status and computes
+// the earliest time at which the next compaction job can be created.
+func recordCompactionJobFailure(status *druidv1alpha1.EtcdStatus, failureReason string, backoffConfig druidconfigv1alpha1.CompactionFailureBackoffConfiguration, now time.Time) {
+	if status.SnapshotCompaction == nil {
+		status.SnapshotCompaction = &druidv1alpha1.SnapshotCompactionStatus{}
+	}
+	compactionStatus := status.SnapshotCompaction
+	failureClass := classifyCompactionFailure(failureReason)
+	compactionStatus.RecentFailures = append(compactionStatus.RecentFailures, druidv1alpha1.SnapshotCompactionFailure{
+		Class:      failureClass,
+		Reason:     failureReason,
+		ObservedAt: metav1.NewTime(now),
+	})
+	if len(compactionStatus.RecentFailures) > maxRecentCompactionFailures {
+		compactionStatus.RecentFailures = compactionStatus.RecentFailures[len(compactionStatus.RecentFailures)-maxRecentCompactionFailures:]
+	}
+	// Disruptions such as preemptions or evictions are not caused by the compaction itself, so the next compaction
+	// job is not delayed because of them.
+	if failureClass == druidv1alpha1.SnapshotCompactionFailureClassDisruption {
+		return
+	}
+	compactionStatus.ConsecutiveFailures++
+	compactionStatus.NextAttemptTime = ptr.To(metav1.NewTime(now.Add(computeCompactionBackoff(compactionStatus.ConsecutiveFailures, backoffConfig))))
+}
+
+// resetCompactionFailures resets the consecutive compaction job failures after a successful compaction job or full snapshot.
+// The recent failures are retained for later inspection.
+func resetCompactionFailures(status *druidv1alpha1.EtcdStatus) {
+	if status.SnapshotCompaction == nil {
+		return
+	}
+	status.SnapshotCompaction.ConsecutiveFailures = 0
+	status.SnapshotCompaction.NextAttemptTime = nil
+}
+
+// computeSnapshotCompactionBackoffCondition computes the SnapshotCompactionBackoff condition from the snapshot compaction status.
+func computeSnapshotCompactionBackoffCondition(status *druidv1alpha1.EtcdStatus) druidv1alpha1.Condition {
+	compactionStatus := status.SnapshotCompaction
+	if compactionStatus == nil || compactionStatus.NextAttemptTime == nil {
+		return druidv1alpha1.Condition{
+			Type:    druidv1alpha1.ConditionTypeSnapshotCompactionBackoff,
+			Status:  druidv1alpha1.ConditionFalse,
+			Reason:  compactionBackoffReasonNoConsecutiveFailures,
+			Message: "No consecutive compaction job failures",
+		}
+	}
+	return druidv1alpha1.Condition{
+		Type:    druidv1alpha1.ConditionTypeSnapshotCompactionBackoff,
+		Status:  druidv1alpha1.ConditionTrue,
+		Reason:  compactionBackoffReasonConsecutiveFailures,
+		Message: fmt.Sprintf("%d consecutive compaction job(s) failed, next compaction job will not be created before %s", compactionStatus.ConsecutiveFailures, compactionStatus.NextAttemptTime.UTC().Format(time.RFC3339)),
+	}
+}
+
+// getRemainingCompactionBackoff returns the duration for which the creation of a new compaction job has to be delayed.
+func getRemainingCompactionBackoff(etcd *druidv1alpha1.Etcd, now time.Time) time.Duration {
+	compactionStatus := etcd.Status.SnapshotCompaction
+	if compactionStatus == nil || compactionStatus.NextAttemptTime == nil {
+		return 0
+	}
+	return max(compactionStatus.NextAttemptTime.Sub(now), 0)
+}
+
+// hasReachedCompactionFailureThreshold checks if the number of consecutive compaction job failures has reached the given threshold.
+func hasReachedCompactionFailureThreshold(etcd *druidv1alpha1.Etcd, failureThreshold int32) bool {
+	compactionStatus := etcd.Status.SnapshotCompaction
+	return compactionStatus != nil && failureThreshold > 0 && compactionStatus.ConsecutiveFailures >= failureThreshold
+}
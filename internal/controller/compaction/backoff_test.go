@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"testing"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+var testBackoffConfig = druidconfigv1alpha1.CompactionFailureBackoffConfiguration{
+	InitialDelay:     metav1.Duration{Duration: 5 * time.Minute},
+	MaxDelay:         metav1.Duration{Duration: 30 * time.Minute},
+	FailureThreshold: 3,
+}
+
+func TestClassifyCompactionFailure(t *testing.T) {
+	tests := []struct {
+		failureReason string
+		expectedClass druidv1alpha1.SnapshotCompactionFailureClass
+	}{
+		{druidv1alpha1.PodFailureReasonPreemptionByScheduler, druidv1alpha1.SnapshotCompactionFailureClassDisruption},
+		{druidv1alpha1.PodFailureReasonDeletionByTaintManager, druidv1alpha1.SnapshotCompactionFailureClassDisruption},
+		{druidv1alpha1.PodFailureReasonEvictionByEvictionAPI, druidv1alpha1.SnapshotCompactionFailureClassDisruption},
+		{druidv1alpha1.PodFailureReasonTerminationByKubelet, druidv1alpha1.SnapshotCompactionFailureClassDisruption},
+		{druidv1alpha1.JobFailureReasonDeadlineExceeded, druidv1alpha1.SnapshotCompactionFailureClassDeadlineExceeded},
+		{druidv1alpha1.PodFailureReasonProcessFailure, druidv1alpha1.SnapshotCompactionFailureClassProcessFailure},
+		{druidv1alpha1.PodFailureReasonUnknown, druidv1alpha1.SnapshotCompactionFailureClassUnknown},
+		{"", druidv1alpha1.SnapshotCompactionFailureClassUnknown},
+	}
+	for _, test := range tests {
+		t.Run(test.failureReason, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(classifyCompactionFailure(test.failureReason)).To(Equal(test.expectedClass))
+		})
+	}
+}
+
+func TestComputeCompactionBackoff(t *testing.T) {
+	tests := []struct {
+		name                string
+		consecutiveFailures int32
+		expectedBackoff     time.Duration
+	}{
+		{"no failures", 0, 0},
+		{"first failure", 1, 5 * time.Minute},
+		{"second failure", 2, 10 * time.Minute},
+		{"third failure", 3, 20 * time.Minute},
+		{"capped at max delay", 4, 30 * time.Minute},
+		{"stays capped at max delay", 50, 30 * time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(computeCompactionBackoff(test.consecutiveFailures, testBackoffConfig)).To(Equal(test.expectedBackoff))
+		})
+	}
+}
+
+func TestRecordCompactionJobFailure(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should count failures and compute the next attempt time", func(t *testing.T) {
+		g := NewWithT(t)
+		status := &druidv1alpha1.EtcdStatus{}
+		recordCompactionJobFailure(status, druidv1alpha1.PodFailureReasonProcessFailure, testBackoffConfig, now)
+		recordCompactionJobFailure(status, druidv1alpha1.JobFailureReasonDeadlineExceeded, testBackoffConfig, now)
+
+		g.Expect(status.SnapshotCompaction).ToNot(BeNil())
+		g.Expect(status.SnapshotCompaction.ConsecutiveFailures).To(Equal(int32(2)))
+		g.Expect(status.SnapshotCompaction.NextAttemptTime.Time).To(Equal(now.Add(10 * time.Minute)))
+		g.Expect(status.SnapshotCompaction.RecentFailures).To(HaveLen(2))
+		g.Expect(status.SnapshotCompaction.RecentFailures[1].Class).To(Equal(druidv1alpha1.SnapshotCompactionFailureClassDeadlineExceeded))
+	})
+
+	t.Run("should not count disruptions as consecutive failures", func(t *testing.T) {
+		g := NewWithT(t)
+		status := &druidv1alpha1.EtcdStatus{}
+		recordCompactionJobFailure(status, druidv1alpha1.PodFailureReasonPreemptionByScheduler, testBackoffConfig, now)
+
+		g.Expect(status.SnapshotCompaction.ConsecutiveFailures).To(BeZero())
+		g.Expect(status.SnapshotCompaction.NextAttemptTime).To(BeNil())
+		g.Expect(status.SnapshotCompaction.RecentFailures).To(HaveLen(1))
+		g.Expect(status.SnapshotCompaction.RecentFailures[0].Class).To(Equal(druidv1alpha1.SnapshotCompactionFailureClassDisruption))
+	})
+
+	t.Run("should retain only the most recent failures", func(t *testing.T) {
+		g := NewWithT(t)
+		status := &druidv1alpha1.EtcdStatus{}
+		for i := range maxRecentCompactionFailures + 2 {
+			recordCompactionJobFailure(status, druidv1alpha1.PodFailureReasonProcessFailure, testBackoffConfig, now.Add(time.Duration(i)*time.Minute))
+		}
+
+		g.Expect(status.SnapshotCompaction.RecentFailures).To(HaveLen(maxRecentCompactionFailures))
+		g.Expect(status.SnapshotCompaction.RecentFailures[0].ObservedAt.Time).To(Equal(now.Add(2 * time.Minute)))
+		g.Expect(status.SnapshotCompaction.ConsecutiveFailures).To(Equal(int32(maxRecentCompactionFailures + 2)))
+	})
+}
+
+func TestResetCompactionFailures(t *testing.T) {
+	g := NewWithT(t)
+	status := &druidv1alpha1.EtcdStatus{}
+	resetCompactionFailures(status)
+	g.Expect(status.SnapshotCompaction).To(BeNil())
+
+	recordCompactionJobFailure(status, druidv1alpha1.PodFailureReasonProcessFailure, testBackoffConfig, time.Now())
+	resetCompactionFailures(status)
+	g.Expect(status.SnapshotCompaction.ConsecutiveFailures).To(BeZero())
+	g.Expect(status.SnapshotCompaction.NextAttemptTime).To(BeNil())
+	g.Expect(status.SnapshotCompaction.RecentFailures).To(HaveLen(1))
+}
+
+func TestComputeSnapshotCompactionBackoffCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	condition := computeSnapshotCompactionBackoffCondition(&druidv1alpha1.EtcdStatus{})
+	g.Expect(condition.Type).To(Equal(druidv1alpha1.ConditionTypeSnapshotCompactionBackoff))
+	g.Expect(condition.Status).To(Equal(druidv1alpha1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(compactionBackoffReasonNoConsecutiveFailures))
+
+	condition = computeSnapshotCompactionBackoffCondition(&druidv1alpha1.EtcdStatus{
+		SnapshotCompaction: &druidv1alpha1.SnapshotCompactionStatus{
+			ConsecutiveFailures: 2,
+			NextAttemptTime:     ptr.To(metav1.NewTime(time.Now())),
+		},
+	})
+	g.Expect(condition.Status).To(Equal(druidv1alpha1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(compactionBackoffReasonConsecutiveFailures))
+}
+
+func TestGetRemainingCompactionBackoff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name              string
+		compactionStatus  *druidv1alpha1.SnapshotCompactionStatus
+		expectedRemaining time.Duration
+	}{
+		{"no compaction status", nil, 0},
+		{"no next attempt time", &druidv1alpha1.SnapshotCompactionStatus{}, 0},
+		{"next attempt time in the past", &druidv1alpha1.SnapshotCompactionStatus{NextAttemptTime: ptr.To(metav1.NewTime(now.Add(-time.Minute)))}, 0},
+		{"next attempt time in the future", &druidv1alpha1.SnapshotCompactionStatus{NextAttemptTime: ptr.To(metav1.NewTime(now.Add(time.Minute)))}, time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			etcd := &druidv1alpha1.Etcd{Status: druidv1alpha1.EtcdStatus{SnapshotCompaction: test.compactionStatus}}
+			g.Expect(getRemainingCompactionBackoff(etcd, now)).To(Equal(test.expectedRemaining))
+		})
+	}
+}
+
+func TestHasReachedCompactionFailureThreshold(t *testing.T) {
+	tests := []struct {
+		name             string
+		compactionStatus *druidv1alpha1.SnapshotCompactionStatus
+		failureThreshold int32
+		expected         bool
+	}{
+		{"no compaction status", nil, 3, false},
+		{"below threshold", &druidv1alpha1.SnapshotCompactionStatus{ConsecutiveFailures: 2}, 3, false},
+		{"threshold reached", &druidv1alpha1.SnapshotCompactionStatus{ConsecutiveFailures: 3}, 3, true},
+		{"threshold not configured", &druidv1alpha1.SnapshotCompactionStatus{ConsecutiveFailures: 3}, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			etcd := &druidv1alpha1.Etcd{Status: druidv1alpha1.EtcdStatus{SnapshotCompaction: test.compactionStatus}}
+			g.Expect(hasReachedCompactionFailureThreshold(etcd, test.failureThreshold)).To(Equal(test.expected))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -70,6 +71,7 @@ type Reconciler struct {
 	client.Client
 	config           druidconfigv1alpha1.CompactionControllerConfiguration
 	imageVector      imagevector.ImageVector
+	recorder         record.EventRecorder
 	logger           logr.Logger
 	EtcdbrHTTPClient httpClientInterface
 }
@@ -90,6 +92,7 @@ func NewReconcilerWithImageVector(mgr manager.Manager, config druidconfigv1alpha
 		Client:      mgr.GetClient(),
 		config:      config,
 		imageVector: imageVector,
+		recorder:    mgr.GetEventRecorderFor(controllerName),
 		logger:      log.Log.WithName("compaction-lease-controller"),
 	}
 }
@@ -98,6 +101,7 @@ func NewReconcilerWithImageVector(mgr manager.Manager, config druidconfigv1alpha
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods,verbs=list;watch;delete;get
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile reconciles the compaction job.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -365,12 +369,31 @@ func (r *Reconciler) triggerFullSnapshotOrCreateCompactionJob(ctx context.Contex
 	logger.Info("Compaction thresholds", "eventsThreshold", eventsThreshold, "triggerFullSnapshotThreshold", triggerFullSnapshotThreshold)
 
 	// Trigger full snapshot if the delta revisions over the last full snapshot are more than the configured upper threshold
-	// or if the last job completion reason is DeadlineExceeded or last full snapshot failed.
+	// or if the last job completion reason is DeadlineExceeded or last full snapshot failed
+	// or if the number of consecutive compaction job failures has reached the configured failure threshold.
 	// This is to ensure that we avoid spinning up compaction jobs even when we know that the probability of it getting succeeded is very low due to the large number of revisions.
 	// This avoids unnecessary resource consumption and delays in the compaction process
-	if isLastCompactionConditionDeadlineExceededOrFullSnapshotFailure(etcd) || accumulatedEtcdRevisions >= triggerFullSnapshotThreshold {
+	failureThresholdReached := hasReachedCompactionFailureThreshold(etcd, r.config.FailureBackoff.FailureThreshold)
+	if isLastCompactionConditionDeadlineExceededOrFullSnapshotFailure(etcd) || accumulatedEtcdRevisions >= triggerFullSnapshotThreshold || failureThresholdReached {
+		if failureThresholdReached {
+			r.recorder.Eventf(etcd, v1.EventTypeWarning, eventReasonCompactionFailureThresholdReached,
+				"%d consecutive compaction jobs have failed, triggering a full snapshot instead of another compaction job", etcd.Status.SnapshotCompaction.ConsecutiveFailures)
+		}
+		// A full snapshot is taken instead of a compaction job, so a previously deferred compaction is not pending any longer.
+		if err := r.updateDeferredCompactionEtcdStatus(ctx, etcd, nil, time.Now().UTC()); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error while removing the deferred compaction from the etcd status: %w", err)
+		}
 		return r.triggerFullSnapshotAndUpdateStatus(ctx, logger, etcd, accumulatedEtcdRevisions, triggerFullSnapshotThreshold)
 	}
+
+	// Delay the creation of a new compaction job if the previous compaction jobs have failed consecutively.
+	if accumulatedEtcdRevisions >= eventsThreshold {
+		if remainingBackoff := getRemainingCompactionBackoff(etcd, time.Now().UTC()); remainingBackoff > 0 {
+			logger.Info("Delaying creation of compaction job due to consecutive compaction job failures",
+				"consecutiveFailures", etcd.Status.SnapshotCompaction.ConsecutiveFailures, "requeueAfter", remainingBackoff)
+			return ctrl.Result{RequeueAfter: remainingBackoff}, nil
+		}
+	}
 	return r.checkAndTriggerCompactionJob(ctx, logger, etcd, accumulatedEtcdRevisions, eventsThreshold)
 }
 
@@ -397,7 +420,11 @@ func (r *Reconciler) triggerFullSnapshotAndUpdateStatus(ctx context.Context, log
 		if err := r.Get(ctx, types.NamespacedName{Namespace: etcd.Namespace, Name: etcd.Name}, latestEtcd); err != nil {
 			return fmt.Errorf("error while fetching etcd %s/%s: %w", etcd.Namespace, etcd.Name, err)
 		}
-		return r.updateCompactionJobEtcdStatusCondition(ctx, latestEtcd, latestCondition)
+		// A successful full snapshot makes up for the failed compaction jobs.
+		if fullSnapErr == nil {
+			resetCompactionFailures(&latestEtcd.Status)
+		}
+		return r.updateCompactionJobEtcdStatusCondition(ctx, latestEtcd, latestCondition, computeSnapshotCompactionBackoffCondition(&latestEtcd.Status))
 	})
 	// If the etcd status update was successful, we will wait for the condition to be reflected in the cache.
 	if etcdStatusUpdateErr == nil {
@@ -423,11 +450,29 @@ func (r *Reconciler) triggerFullSnapshotAndUpdateStatus(ctx context.Context, log
 }
 
 // checkAndTriggerCompactionJob creates compaction job only when number of accumulated revisions over the last full snapshot is more than the configured events threshold.
+// The creation of the job is deferred while the maintenance window of the Etcd is closed. A deferred compaction is
+// removed from the status of the Etcd once it has been carried out or is not pending any longer.
 func (r *Reconciler) checkAndTriggerCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions, eventsThreshold int64) (ctrl.Result, error) {
-	var err error
 	job := &batchv1.Job{}
 	compactionJobName := druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta)
-	if accumulatedEtcdRevisions >= eventsThreshold {
+	now := time.Now().UTC()
+	if accumulatedEtcdRevisions < eventsThreshold {
+		// No compaction is pending, e.g. because a full snapshot has been taken since the compaction has been deferred.
+		if err := r.updateDeferredCompactionEtcdStatus(ctx, etcd, nil, now); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error while removing the deferred compaction from the etcd status: %w", err)
+		}
+	} else {
+		deferred, next, err := utils.ShouldDeferToMaintenanceWindow(etcd, now)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error while checking the maintenance window: %w", err)
+		}
+		if deferred {
+			logger.Info("Deferring creation of compaction job until the maintenance window opens", "jobName", compactionJobName, "maintenanceWindowStart", next)
+			if err = r.updateDeferredCompactionEtcdStatus(ctx, etcd, &next, now); err != nil {
+				return ctrl.Result{}, fmt.Errorf("error while recording the deferred compaction in the etcd status: %w", err)
+			}
+			return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+		}
 		logger.Info("Creating etcd compaction job", "jobName", compactionJobName)
 		job, err = r.createCompactionJob(ctx, logger, etcd)
 		if err != nil {
@@ -435,6 +480,12 @@ func (r *Reconciler) checkAndTriggerCompactionJob(ctx context.Context, logger lo
 			return ctrl.Result{}, fmt.Errorf("error during compaction job creation: %w", err)
 		}
 		metricJobsCurrent.With(prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace}).Set(1)
+		if err = r.updateDeferredCompactionEtcdStatus(ctx, etcd, nil, now); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error while removing the deferred compaction from the etcd status: %w", err)
+		}
+		if err = r.removeIgnoreMaintenanceWindowAnnotation(ctx, logger, etcd); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error while removing the ignore maintenance window annotation: %w", err)
+		}
 	}
 
 	if isJobPresent(job) {
@@ -443,6 +494,27 @@ func (r *Reconciler) checkAndTriggerCompactionJob(ctx context.Context, logger lo
 	return ctrl.Result{}, nil
 }
 
+// removeIgnoreMaintenanceWindowAnnotation removes the annotation which bypasses the maintenance window once the
+// compaction job has been created, unless another operation which the bypass applies to is still pending, e.g. a spec
+// change which has not been reconciled yet. The annotation is then removed by the etcd controller instead.
+func (r *Reconciler) removeIgnoreMaintenanceWindowAnnotation(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) error {
+	if !druidv1alpha1.IsMaintenanceWindowIgnored(etcd.ObjectMeta) {
+		return nil
+	}
+	// Fetch the latest etcd resource since the deferred operations may have been changed by the etcd controller.
+	latestEtcd := &druidv1alpha1.Etcd{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(etcd), latestEtcd); err != nil {
+		return err
+	}
+	if !druidv1alpha1.IsMaintenanceWindowIgnored(latestEtcd.ObjectMeta) || utils.IsDeferrableOperationPending(latestEtcd) {
+		return nil
+	}
+	logger.Info("Removing ignore maintenance window annotation")
+	withIgnoreAnnotation := latestEtcd.DeepCopy()
+	delete(latestEtcd.Annotations, druidv1alpha1.IgnoreMaintenanceWindowAnnotation)
+	return r.Patch(ctx, latestEtcd, client.MergeFrom(withIgnoreAnnotation))
+}
+
 func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) (*batchv1.Job, error) {
 	activeDeadlineSeconds := r.config.ActiveDeadlineDuration.Seconds()
 
@@ -450,6 +522,12 @@ func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger
 	if err != nil {
 		return nil, fmt.Errorf("couldn't fetch etcd backup image: %w", err)
 	}
+	if err = druidstore.CheckCompressionSupported(etcd.Spec.Backup.SnapshotCompression, etcdBackupImage); err != nil {
+		return nil, err
+	}
+	if err = druidstore.CheckEncryptionSupported(etcd.Spec.Backup.Encryption, etcdBackupImage); err != nil {
+		return nil, err
+	}
 
 	var cpuRequests resource.Quantity
 	var memoryRequests resource.Quantity
@@ -608,7 +686,12 @@ func (r *Reconciler) updateMetricsAndStatusForCompletedJob(ctx context.Context,
 	if err := r.Get(ctx, types.NamespacedName{Namespace: etcd.Namespace, Name: etcd.Name}, latestEtcd); err != nil {
 		return fmt.Errorf("error while fetching etcd %s/%s: %w", etcd.Namespace, etcd.Name, err)
 	}
-	if err := r.updateCompactionJobEtcdStatusCondition(ctx, latestEtcd, latestCondition); err != nil {
+	if jobCompletionState == jobSucceeded {
+		resetCompactionFailures(&latestEtcd.Status)
+	} else {
+		recordCompactionJobFailure(&latestEtcd.Status, latestCondition.Reason, r.config.FailureBackoff, time.Now().UTC())
+	}
+	if err := r.updateCompactionJobEtcdStatusCondition(ctx, latestEtcd, latestCondition, computeSnapshotCompactionBackoffCondition(&latestEtcd.Status)); err != nil {
 		logger.Error(err, "Error while updating etcd status condition for compaction job", "jobName", job.Name)
 		return fmt.Errorf("error while updating etcd status condition for compaction job: %w", err)
 	}
@@ -741,16 +824,30 @@ func getCompactionJobVolumeMounts(etcd *druidv1alpha1.Etcd) ([]v1.VolumeMount, e
 			MountPath: kubernetes.MountPathLocalStore(etcd, &provider),
 		})
 	case druidstore.GCS:
-		vms = append(vms, v1.VolumeMount{
-			Name:      common.VolumeNameProviderBackupSecret,
-			MountPath: common.VolumeMountPathGCSBackupSecret,
-		})
+		if druidstore.UsesSecretCredentials(etcd.Spec.Backup.Store) {
+			vms = append(vms, v1.VolumeMount{
+				Name:      common.VolumeNameProviderBackupSecret,
+				MountPath: common.VolumeMountPathGCSBackupSecret,
+			})
+		}
 	case druidstore.S3, druidstore.ABS, druidstore.OSS, druidstore.Swift, druidstore.OCS:
+		if druidstore.UsesSecretCredentials(etcd.Spec.Backup.Store) {
+			vms = append(vms, v1.VolumeMount{
+				Name:      common.VolumeNameProviderBackupSecret,
+				MountPath: common.VolumeMountPathNonGCSProviderBackupSecret,
+			})
+		}
+	}
+	if druidstore.GetWorkloadIdentityTokenAudience(etcd.Spec.Backup.Store, provider) != nil {
 		vms = append(vms, v1.VolumeMount{
-			Name:      common.VolumeNameProviderBackupSecret,
-			MountPath: common.VolumeMountPathNonGCSProviderBackupSecret,
+			Name:      common.VolumeNameWorkloadIdentityToken,
+			MountPath: common.VolumeMountPathWorkloadIdentityToken,
+			ReadOnly:  true,
 		})
 	}
+	if encryptionVolumeMount := druidstore.GetEncryptionVolumeMount(etcd.Spec.Backup.Encryption, common.VolumeNameBackupEncryption, common.VolumeMountPathBackupEncryption); encryptionVolumeMount != nil {
+		vms = append(vms, *encryptionVolumeMount)
+	}
 
 	return vms, nil
 }
@@ -788,6 +885,9 @@ func getCompactionJobVolumes(ctx context.Context, cl client.Client, logger logr.
 			},
 		})
 	case druidstore.GCS, druidstore.S3, druidstore.OSS, druidstore.ABS, druidstore.Swift, druidstore.OCS:
+		if !druidstore.UsesSecretCredentials(storeValues) {
+			break
+		}
 		if storeValues.SecretRef == nil {
 			return vs, fmt.Errorf("could not configure secretRef for backup store %v", provider)
 		}
@@ -801,6 +901,12 @@ func getCompactionJobVolumes(ctx context.Context, cl client.Client, logger logr.
 			},
 		})
 	}
+	if tokenVolume := druidstore.GetWorkloadIdentityTokenVolume(storeValues, provider, common.VolumeNameWorkloadIdentityToken); tokenVolume != nil {
+		vs = append(vs, *tokenVolume)
+	}
+	if encryptionVolume := druidstore.GetEncryptionVolume(etcd.Spec.Backup.Encryption, common.VolumeNameBackupEncryption); encryptionVolume != nil {
+		vs = append(vs, *encryptionVolume)
+	}
 
 	return vs, nil
 }
@@ -848,6 +954,8 @@ func getCompactionJobArgs(etcd *druidv1alpha1.Etcd, metricsScrapeWaitDuration st
 			command = append(command, fmt.Sprintf("--store-endpoint-override=%s", *storeValues.EndpointOverride))
 		}
 	}
+	command = append(command, druidstore.GetCompressionArgs(backupValues.SnapshotCompression)...)
+	command = append(command, druidstore.GetEncryptionArgs(backupValues.Encryption, "", common.VolumeMountPathBackupEncryption)...)
 
 	return command
 }
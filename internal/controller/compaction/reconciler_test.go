@@ -10,13 +10,17 @@ import (
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
 	"github.com/gardener/etcd-druid/internal/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
+	"github.com/go-logr/logr"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -304,6 +308,8 @@ func TestGetCompactionJobArgs(t *testing.T) {
 		storeEndpointOverride       *string
 		etcdDefragTimeout           *metav1.Duration
 		etcdSnapshotTimeout         *metav1.Duration
+		encryption                  *druidv1alpha1.EncryptionSpec
+		compression                 *druidv1alpha1.CompressionSpec
 		expectedArgsContains        []string
 		expectedArgsNotContainFlags []string
 	}{
@@ -393,6 +399,37 @@ func TestGetCompactionJobArgs(t *testing.T) {
 				"--etcd-snapshot-timeout=20m0s",
 			},
 		},
+		{
+			name:              "args with backup encryption",
+			etcdName:          testEtcdName,
+			namespace:         testNamespace,
+			metricsScrapeWait: testMetricsScrape,
+			storeProvider:     &s3Provider,
+			storePrefix:       testPrefix,
+			storeContainer:    ptr.To(testContainer),
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-1",
+				SecretRef: &corev1.LocalObjectReference{Name: "etcd-backup-encryption"},
+			},
+			expectedArgsContains: []string{
+				"--encryption-key-id=key-1",
+				"--encryption-keys-dir=/var/etcdbr/encryption",
+			},
+		},
+		{
+			name:              "args with zstd snapshot compression",
+			etcdName:          testEtcdName,
+			namespace:         testNamespace,
+			metricsScrapeWait: testMetricsScrape,
+			storeProvider:     &s3Provider,
+			storePrefix:       testPrefix,
+			storeContainer:    ptr.To(testContainer),
+			compression:       &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.ZstdCompression)},
+			expectedArgsContains: []string{
+				"--compress-snapshots=true",
+				"--compression-policy=zstd",
+			},
+		},
 		{
 			name:              "args without store values",
 			etcdName:          testEtcdName,
@@ -436,6 +473,9 @@ func TestGetCompactionJobArgs(t *testing.T) {
 				etcd.Spec.Backup.EtcdSnapshotTimeout = tc.etcdSnapshotTimeout
 			}
 
+			etcd.Spec.Backup.Encryption = tc.encryption
+			etcd.Spec.Backup.SnapshotCompression = tc.compression
+
 			if tc.storeProvider != nil {
 				etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
 					Provider:         tc.storeProvider,
@@ -558,3 +598,101 @@ func TestGetPodForJob(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckAndTriggerCompactionJobWithMaintenanceWindow(t *testing.T) {
+	testCases := []struct {
+		name              string
+		maintenanceWindow *druidv1alpha1.MaintenanceWindow
+		ignoreWindow      bool
+		specPending       bool
+		noPending         bool
+		expectDeferred    bool
+		expectIgnored     bool
+	}{
+		{
+			name: "should create the compaction job without maintenance window",
+		},
+		{
+			name:              "should defer the compaction job while the maintenance window is closed",
+			maintenanceWindow: &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}},
+			expectDeferred:    true,
+		},
+		{
+			name:              "should create the compaction job if the maintenance window is ignored",
+			maintenanceWindow: &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}},
+			ignoreWindow:      true,
+		},
+		{
+			name:              "should retain the annotation which ignores the maintenance window while a spec change is pending",
+			maintenanceWindow: &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}},
+			ignoreWindow:      true,
+			specPending:       true,
+			expectIgnored:     true,
+		},
+		{
+			name:              "should remove the deferred compaction once no compaction is pending",
+			maintenanceWindow: &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}},
+			noPending:         true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			etcd.Spec.MaintenanceWindow = tc.maintenanceWindow
+			etcd.Status.ObservedGeneration = ptr.To(etcd.Generation)
+			if tc.specPending {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile)
+			}
+			if tc.ignoreWindow {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.IgnoreMaintenanceWindowAnnotation, "true")
+			}
+			// a compaction which has been deferred before is removed from the status once the job is created
+			etcd.Status.DeferredOperations = []druidv1alpha1.DeferredOperation{
+				{Type: druidv1alpha1.DeferredOperationTypeCompaction, DeferredSince: metav1.Now()},
+			}
+			backupSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: etcd.Spec.Backup.Store.SecretRef.Name, Namespace: etcd.Namespace},
+				Data:       map[string][]byte{"hostPath": []byte("/var/data/etcd-backup")},
+			}
+			cl := testutils.NewTestClientBuilder().
+				WithScheme(kubernetes.Scheme).
+				WithObjects(etcd.DeepCopy(), backupSecret).
+				WithStatusSubresource(etcd).
+				Build()
+			r := &Reconciler{
+				Client:      cl,
+				imageVector: testutils.CreateImageVector(true, true),
+				recorder:    record.NewFakeRecorder(10),
+			}
+
+			accumulatedRevisions := int64(10)
+			if tc.noPending {
+				accumulatedRevisions = 1
+			}
+			result, err := r.checkAndTriggerCompactionJob(context.Background(), logr.Discard(), etcd, accumulatedRevisions, 5)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			job := &batchv1.Job{}
+			jobErr := cl.Get(context.Background(), client.ObjectKey{Name: druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta), Namespace: etcd.Namespace}, job)
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			if tc.expectDeferred {
+				g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+				g.Expect(apierrors.IsNotFound(jobErr)).To(BeTrue())
+				g.Expect(latestEtcd.Status.DeferredOperations).To(ConsistOf(And(
+					HaveField("Type", druidv1alpha1.DeferredOperationTypeCompaction),
+					HaveField("ScheduledTime", Not(BeNil())),
+				)))
+			} else {
+				g.Expect(result.RequeueAfter).To(BeZero())
+				g.Expect(apierrors.IsNotFound(jobErr)).To(Equal(tc.noPending))
+				g.Expect(latestEtcd.Status.DeferredOperations).To(BeEmpty())
+			}
+			g.Expect(druidv1alpha1.IsMaintenanceWindowIgnored(latestEtcd.ObjectMeta)).To(Equal(tc.expectIgnored))
+		})
+	}
+}
@@ -36,6 +36,8 @@ func (r *Reconciler) triggerFullSnapshot(ctx context.Context, logger logr.Logger
 	var reason string
 	if isLastCompactionConditionDeadlineExceededOrFullSnapshotFailure(etcd) {
 		reason = "either previous compaction got job deadline exceeded or full snapshot failed"
+	} else if hasReachedCompactionFailureThreshold(etcd, r.config.FailureBackoff.FailureThreshold) {
+		reason = "consecutive compaction job failures have reached the failure threshold"
 	} else {
 		reason = "delta revisions have crossed the upper threshold"
 	}
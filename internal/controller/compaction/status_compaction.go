@@ -6,38 +6,76 @@ package compaction
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// updateCompactionJobEtcdStatusCondition updates the Etcd status condition LastSnapshotCompactionSucceeded with the latest job/fullSnapshot status.
-func (r *Reconciler) updateCompactionJobEtcdStatusCondition(ctx context.Context, latestEtcd *druidv1alpha1.Etcd, latestCondition druidv1alpha1.Condition) error {
+const deferredCompactionDescription = "Compaction job for the snapshots accumulated since the last full snapshot"
+
+// updateCompactionJobEtcdStatusCondition updates the Etcd status condition LastSnapshotCompactionSucceeded with the latest job/fullSnapshot status,
+// along with any further compaction related conditions that are passed.
+func (r *Reconciler) updateCompactionJobEtcdStatusCondition(ctx context.Context, latestEtcd *druidv1alpha1.Etcd, latestConditions ...druidv1alpha1.Condition) error {
 	oldEtcdStatus := latestEtcd.Status.DeepCopy()
-	var isSnapshotCompactionConditionPresent bool
-	for i, condition := range oldEtcdStatus.Conditions {
-		if condition.Type == druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded {
+	for _, latestCondition := range latestConditions {
+		oldEtcdStatus.Conditions = mergeCompactionCondition(oldEtcdStatus.Conditions, latestCondition)
+	}
+	latestEtcd.Status = *oldEtcdStatus
+	return r.Status().Update(ctx, latestEtcd)
+}
+
+// updateDeferredCompactionEtcdStatus records the compaction as deferred until the given scheduled time in the Etcd
+// status, or removes it from the deferred operations if no scheduled time is passed.
+func (r *Reconciler) updateDeferredCompactionEtcdStatus(ctx context.Context, etcd *druidv1alpha1.Etcd, scheduledTime *time.Time, now time.Time) error {
+	if scheduledTime == nil && !slices.ContainsFunc(etcd.Status.DeferredOperations, func(op druidv1alpha1.DeferredOperation) bool {
+		return op.Type == druidv1alpha1.DeferredOperationTypeCompaction
+	}) {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Fetch the latest etcd resource to avoid conflict errors
+		latestEtcd := &druidv1alpha1.Etcd{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(etcd), latestEtcd); err != nil {
+			return err
+		}
+		var changed bool
+		if scheduledTime != nil {
+			changed = utils.SetDeferredOperation(&latestEtcd.Status, druidv1alpha1.DeferredOperationTypeCompaction, deferredCompactionDescription, *scheduledTime, now)
+		} else {
+			changed = utils.RemoveDeferredOperation(&latestEtcd.Status, druidv1alpha1.DeferredOperationTypeCompaction)
+		}
+		if !changed {
+			return nil
+		}
+		return r.Status().Update(ctx, latestEtcd)
+	})
+}
+
+// mergeCompactionCondition replaces the condition of the same type in the given conditions with the latest condition,
+// or appends it if no such condition exists yet.
+func mergeCompactionCondition(conditions []druidv1alpha1.Condition, latestCondition druidv1alpha1.Condition) []druidv1alpha1.Condition {
+	now := metav1.NewTime(time.Now().UTC())
+	for i, condition := range conditions {
+		if condition.Type == latestCondition.Type {
 			latestCondition.LastTransitionTime = condition.LastTransitionTime
-			latestCondition.LastUpdateTime = metav1.NewTime(time.Now().UTC())
+			latestCondition.LastUpdateTime = now
 			// Update the LastTransitionTime if the status or reason has changed
 			if condition.Status != latestCondition.Status || condition.Reason != latestCondition.Reason {
-				latestCondition.LastTransitionTime = metav1.NewTime(time.Now().UTC())
+				latestCondition.LastTransitionTime = now
 			}
-			// Update the condition in the old status
-			oldEtcdStatus.Conditions[i] = latestCondition
-			isSnapshotCompactionConditionPresent = true
-			break
+			conditions[i] = latestCondition
+			return conditions
 		}
 	}
-	if !isSnapshotCompactionConditionPresent {
-		latestCondition.LastTransitionTime = metav1.NewTime(time.Now().UTC())
-		latestCondition.LastUpdateTime = metav1.NewTime(time.Now().UTC())
-		oldEtcdStatus.Conditions = append(oldEtcdStatus.Conditions, latestCondition)
-	}
-	latestEtcd.Status = *oldEtcdStatus
-	return r.Status().Update(ctx, latestEtcd)
+	latestCondition.LastTransitionTime = now
+	latestCondition.LastUpdateTime = now
+	return append(conditions, latestCondition)
 }
 
 func computeSnapshotCompactionJobStatus(jobCompletionState int) druidv1alpha1.ConditionStatus {
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespaceEtcdDruid = "etcddruid"
+	subsystemEtcd      = "etcd"
+)
+
+// metricLeaderChangesTotal is the metric used to count the leader changes of an etcd cluster observed by the etcd controller.
+var metricLeaderChangesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: namespaceEtcdDruid,
+		Subsystem: subsystemEtcd,
+		Name:      "leader_changes_total",
+		Help:      "Total number of leader changes of an etcd cluster observed by the etcd controller.",
+	},
+	[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+)
+
+func init() {
+	// Metrics have to be registered to be exposed:
+	metrics.Registry.MustRegister(metricLeaderChangesTotal)
+}
+
+// recordLeaderChange increments the leader change metric of the given etcd.
+func recordLeaderChange(etcd *druidv1alpha1.Etcd) {
+	metricLeaderChangesTotal.With(prometheus.Labels{
+		druidmetrics.LabelEtcdNamespace: etcd.Namespace,
+		druidmetrics.LabelEtcdName:      etcd.Name,
+	}).Inc()
+}
+
+// deleteEtcdMetrics removes all metric series of the given etcd.
+func deleteEtcdMetrics(etcd *druidv1alpha1.Etcd) {
+	metricLeaderChangesTotal.DeletePartialMatch(prometheus.Labels{
+		druidmetrics.LabelEtcdNamespace: etcd.Namespace,
+		druidmetrics.LabelEtcdName:      etcd.Name,
+	})
+}
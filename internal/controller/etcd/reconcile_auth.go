@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/component/etcdauth"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+
+	"github.com/go-logr/logr"
+)
+
+// syncEtcdAuth syncs the users and roles of etcd's built-in authentication if the sync has been skipped before because
+// the etcd cluster had no quorum, or if they have not been synced within the resync period, so that drift in etcd is
+// corrected without waiting for a spec reconciliation. Failures are logged but do not fail the reconciliation of the status.
+func (r *Reconciler) syncEtcdAuth(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+	if druidv1alpha1.HasReconcileOperationAnnotation(etcd.ObjectMeta) ||
+		druidv1alpha1.GetSuspendEtcdSpecReconcileAnnotationKey(etcd.ObjectMeta) != nil ||
+		!etcdauth.HasQuorum(etcd) {
+		return ctrlutils.ContinueReconcile()
+	}
+	due, err := etcdauth.IsSyncDue(ctx, r.client, etcd.ObjectMeta)
+	if err != nil {
+		logger.Error(err, "failed to check whether the sync of etcd auth is due")
+		return ctrlutils.ContinueReconcile()
+	}
+	if !due {
+		return ctrlutils.ContinueReconcile()
+	}
+	if err = r.operatorRegistry.GetOperator(component.EtcdAuthKind).Sync(ctx, etcd); err != nil {
+		logger.Error(err, "failed to sync etcd auth")
+		return ctrlutils.ContinueReconcile()
+	}
+	logger.Info("Synced etcd auth")
+	return ctrlutils.ContinueReconcile()
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/component/etcdauth"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSyncEtcdAuth(t *testing.T) {
+	testCases := []struct {
+		name          string
+		readyReplicas int32
+		syncPending   bool
+		suspended     bool
+		expectSynced  bool
+	}{
+		{
+			name:          "should not sync etcd auth while the etcd cluster has no quorum",
+			readyReplicas: 1,
+			syncPending:   true,
+		},
+		{
+			name:          "should not sync etcd auth while the spec reconciliation is suspended",
+			readyReplicas: 3,
+			syncPending:   true,
+			suspended:     true,
+		},
+		{
+			name:          "should sync etcd auth once the etcd cluster has regained its quorum",
+			readyReplicas: 2,
+			syncPending:   true,
+			expectSynced:  true,
+		},
+		{
+			name:          "should sync etcd auth if it has not been synced within the resync period",
+			readyReplicas: 3,
+			expectSynced:  true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).WithEtcdAuth().Build()
+			etcd.Status.ReadyReplicas = tc.readyReplicas
+			if tc.suspended {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.SuspendEtcdSpecReconcileAnnotation, "")
+			}
+			rootSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta),
+					Namespace: etcd.Namespace,
+				},
+			}
+			if tc.syncPending {
+				metav1.SetMetaDataAnnotation(&rootSecret.ObjectMeta, etcdauth.SyncPendingAnnotation, "true")
+			}
+			cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, nil, nil, nil, []client.Object{etcd.DeepCopy(), rootSecret})
+			authOperator := &fakeSyncOperator{}
+			registry := component.NewRegistry()
+			registry.Register(component.EtcdAuthKind, authOperator)
+			r := &Reconciler{client: cl, logger: logr.Discard(), operatorRegistry: registry}
+
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), "test-run")
+			result := r.syncEtcdAuth(opCtx, etcd, logr.Discard())
+			g.Expect(result.HasErrors()).To(BeFalse())
+			g.Expect(authOperator.syncCalls == 1).To(Equal(tc.expectSynced))
+
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(opCtx, client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			g.Expect(druidv1alpha1.HasReconcileOperationAnnotation(latestEtcd.ObjectMeta)).To(BeFalse())
+		})
+	}
+}
+
+// fakeSyncOperator is a component.Operator which only counts the calls of Sync.
+type fakeSyncOperator struct {
+	syncCalls int
+}
+
+func (f *fakeSyncOperator) GetExistingResourceNames(_ component.OperatorContext, _ metav1.ObjectMeta) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeSyncOperator) TriggerDelete(_ component.OperatorContext, _ metav1.ObjectMeta) error {
+	return nil
+}
+
+func (f *fakeSyncOperator) PreSync(_ component.OperatorContext, _ *druidv1alpha1.Etcd) error {
+	return nil
+}
+
+func (f *fakeSyncOperator) Sync(_ component.OperatorContext, _ *druidv1alpha1.Etcd) error {
+	f.syncCalls++
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/component"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/utils"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -19,6 +20,7 @@ func (r *Reconciler) completeReconcile(ctx component.OperatorContext, etcd *drui
 	reconcileCompletionStepFns := []reconcileFn{
 		r.updateObservedGeneration,
 		r.removeOperationAnnotation,
+		r.removeIgnoreMaintenanceWindowAnnotation,
 	}
 
 	for _, fn := range reconcileCompletionStepFns {
@@ -53,3 +55,25 @@ func (r *Reconciler) removeOperationAnnotation(ctx component.OperatorContext, et
 	}
 	return ctrlutils.ContinueReconcile()
 }
+
+// removeIgnoreMaintenanceWindowAnnotation removes the annotation which bypasses the maintenance window once the spec
+// has been reconciled, unless another operation which the bypass applies to, e.g. a deferred compaction, is still
+// pending. The bypass therefore applies to every operation which is pending when it is set, and later disruptive
+// operations are deferred again.
+func (r *Reconciler) removeIgnoreMaintenanceWindowAnnotation(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) ctrlutils.ReconcileStepResult {
+	if !druidv1alpha1.IsMaintenanceWindowIgnored(etcd.ObjectMeta) {
+		return ctrlutils.ContinueReconcile()
+	}
+	if utils.IsDeferrableOperationPending(etcd) {
+		ctx.Logger.Info("Retaining ignore maintenance window annotation since deferred operations are still pending", "deferredOperations", len(etcd.Status.DeferredOperations))
+		return ctrlutils.ContinueReconcile()
+	}
+	ctx.Logger.Info("Removing ignore maintenance window annotation")
+	withIgnoreAnnotation := etcd.DeepCopy()
+	delete(etcd.Annotations, druidv1alpha1.IgnoreMaintenanceWindowAnnotation)
+	if err := r.client.Patch(ctx, etcd, client.MergeFrom(withIgnoreAnnotation)); err != nil {
+		ctx.Logger.Error(err, "failed to remove ignore maintenance window annotation")
+		return ctrlutils.ReconcileWithError(err)
+	}
+	return ctrlutils.ContinueReconcile()
+}
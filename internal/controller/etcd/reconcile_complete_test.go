@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRemoveIgnoreMaintenanceWindowAnnotation(t *testing.T) {
+	testCases := []struct {
+		name               string
+		ignoreWindow       bool
+		deferredOperations []druidv1alpha1.DeferredOperation
+		expectRemoved      bool
+	}{
+		{
+			name: "should do nothing if the maintenance window is not ignored",
+		},
+		{
+			name:          "should remove the annotation which ignores the maintenance window",
+			ignoreWindow:  true,
+			expectRemoved: true,
+		},
+		{
+			name:         "should retain the annotation which ignores the maintenance window while another operation is deferred",
+			ignoreWindow: true,
+			deferredOperations: []druidv1alpha1.DeferredOperation{
+				{Type: druidv1alpha1.DeferredOperationTypeCompaction, DeferredSince: metav1.Now()},
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, "foo", "bar")
+			etcd.Status.ObservedGeneration = ptr.To(etcd.Generation)
+			etcd.Status.DeferredOperations = tc.deferredOperations
+			if tc.ignoreWindow {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.IgnoreMaintenanceWindowAnnotation, "true")
+			}
+			cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, nil, nil, nil, []client.Object{etcd.DeepCopy()})
+			r := &Reconciler{client: cl, logger: logr.Discard()}
+
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), "test-run")
+			result := r.removeIgnoreMaintenanceWindowAnnotation(opCtx, etcd)
+			g.Expect(result.HasErrors()).To(BeFalse())
+
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(opCtx, client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			g.Expect(latestEtcd.Annotations).To(HaveKeyWithValue("foo", "bar"))
+			g.Expect(druidv1alpha1.IsMaintenanceWindowIgnored(latestEtcd.ObjectMeta)).To(Equal(tc.ignoreWindow && !tc.expectRemoved))
+			g.Expect(druidv1alpha1.IsMaintenanceWindowIgnored(etcd.ObjectMeta)).To(Equal(tc.ignoreWindow && !tc.expectRemoved))
+		})
+	}
+}
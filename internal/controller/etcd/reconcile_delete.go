@@ -76,6 +76,8 @@ func (r *Reconciler) removeFinalizer(ctx component.OperatorContext, etcd *druidv
 	if err := kubernetes.RemoveFinalizers(ctx, r.client, etcd, druidapicommon.EtcdFinalizerName); client.IgnoreNotFound(err) != nil {
 		return ctrlutils.ReconcileWithError(err)
 	}
+	deleteEtcdMetrics(etcd)
+	r.transitionEventLimiter.forget(etcd)
 	return ctrlutils.ContinueReconcile()
 }
 
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+
+	"github.com/go-logr/logr"
+)
+
+// trackLeaderChanges records the elections of a leader of the etcd cluster which are reported in the member status and
+// counts the leader changes within the configured window. The LeaderStable condition is computed from the same count
+// by the condition checks.
+func (r *Reconciler) trackLeaderChanges(_ component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+	previousLeader := ""
+	if etcd.Status.LeaderElection != nil {
+		previousLeader = etcd.Status.LeaderElection.Leader
+	}
+	leaderElection, changed := condition.UpdateLeaderElection(*etcd, r.config.LeaderStability.Window.Duration, time.Now().UTC())
+	if changed {
+		logger.Info("Observed election of the etcd leader", "previousLeader", previousLeader, "leader", leaderElection.Leader, "raftTerm", leaderElection.RaftTerm)
+		recordLeaderChange(etcd)
+	}
+	etcd.Status.LeaderElection = leaderElection
+	return ctrlutils.ContinueReconcile()
+}
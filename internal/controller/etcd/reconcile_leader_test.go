@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTrackLeaderChanges(t *testing.T) {
+	now := time.Now().UTC()
+	testCases := []struct {
+		name                string
+		leader              string
+		leaderRaftTerm      int64
+		leaderElection      *druidv1alpha1.LeaderElectionStatus
+		expectedLeader      string
+		expectedChangeCount int32
+		expectLeaderChange  bool
+	}{
+		{
+			name:           "no leader should not record a leader",
+			leaderElection: nil,
+		},
+		{
+			name:           "first observed leader should not be counted as a change",
+			leader:         "etcd-test-0",
+			leaderRaftTerm: 2,
+			expectedLeader: "etcd-test-0",
+		},
+		{
+			name:           "leader in the same raft term should not be counted as a change",
+			leader:         "etcd-test-0",
+			leaderRaftTerm: 2,
+			leaderElection: &druidv1alpha1.LeaderElectionStatus{Leader: "etcd-test-0", RaftTerm: ptr.To[int64](2)},
+			expectedLeader: "etcd-test-0",
+		},
+		{
+			name:                "changed leader should be counted as a change",
+			leader:              "etcd-test-1",
+			leaderRaftTerm:      3,
+			leaderElection:      &druidv1alpha1.LeaderElectionStatus{Leader: "etcd-test-0", RaftTerm: ptr.To[int64](2)},
+			expectedLeader:      "etcd-test-1",
+			expectedChangeCount: 1,
+			expectLeaderChange:  true,
+		},
+		{
+			name:                "re-elected leader should be counted as a change",
+			leader:              "etcd-test-0",
+			leaderRaftTerm:      3,
+			leaderElection:      &druidv1alpha1.LeaderElectionStatus{Leader: "etcd-test-0", RaftTerm: ptr.To[int64](2)},
+			expectedLeader:      "etcd-test-0",
+			expectedChangeCount: 1,
+			expectLeaderChange:  true,
+		},
+		{
+			name:           "leader changes outside of the window should not be counted",
+			leader:         "etcd-test-1",
+			leaderRaftTerm: 3,
+			leaderElection: &druidv1alpha1.LeaderElectionStatus{
+				Leader:            "etcd-test-0",
+				RaftTerm:          ptr.To[int64](2),
+				LeaderChangeTimes: []metav1.Time{{Time: now.Add(-3 * time.Hour)}, {Time: now.Add(-2 * time.Hour)}},
+			},
+			expectedLeader:      "etcd-test-1",
+			expectedChangeCount: 1,
+			expectLeaderChange:  true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithoutDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+			for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+				role := druidv1alpha1.EtcdRoleMember
+				if podName == tc.leader {
+					role = druidv1alpha1.EtcdRoleLeader
+				}
+				etcd.Status.Members = append(etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+					Name:     podName,
+					Status:   druidv1alpha1.EtcdMemberStatusReady,
+					Role:     ptr.To(role),
+					RaftTerm: ptr.To(tc.leaderRaftTerm),
+				})
+			}
+			etcd.Status.LeaderElection = tc.leaderElection
+			r := &Reconciler{
+				config: druidconfigv1alpha1.EtcdControllerConfiguration{
+					LeaderStability: druidconfigv1alpha1.LeaderStabilityConfiguration{
+						Window:           metav1.Duration{Duration: time.Hour},
+						MaxLeaderChanges: 2,
+					},
+				},
+				logger: logr.Discard(),
+			}
+
+			result := r.trackLeaderChanges(component.NewOperatorContext(context.Background(), logr.Discard(), "test-run"), etcd, logr.Discard())
+
+			g.Expect(result.HasErrors()).To(BeFalse())
+			g.Expect(etcd.Status.LeaderElection).ToNot(BeNil())
+			g.Expect(etcd.Status.LeaderElection.Leader).To(Equal(tc.expectedLeader))
+			g.Expect(etcd.Status.LeaderElection.LeaderChangeCount).To(Equal(tc.expectedChangeCount))
+			g.Expect(etcd.Status.LeaderElection.LeaderChangeTimes).To(HaveLen(int(tc.expectedChangeCount)))
+			if tc.expectLeaderChange {
+				g.Expect(etcd.Status.LeaderElection.LastLeaderChangeTime).ToNot(BeNil())
+				g.Expect(etcd.Status.LeaderElection.LastLeaderChangeTime.Time).To(BeTemporally(">=", now))
+			} else {
+				g.Expect(etcd.Status.LeaderElection.LastLeaderChangeTime).To(BeNil())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/component/configmap"
+	"github.com/gardener/etcd-druid/internal/component/statefulset"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonOperationDeferred        = "OperationDeferred"
+	eventReasonInvalidMaintenanceWindow = "InvalidMaintenanceWindow"
+)
+
+// deferredSpecOperationDescriptions describes the disruptive operations which are deferred by the spec reconciliation.
+var deferredSpecOperationDescriptions = map[druidv1alpha1.DeferredOperationType]string{
+	druidv1alpha1.DeferredOperationTypeRollingUpdate:       "Spec changes which roll the pods of the StatefulSet",
+	druidv1alpha1.DeferredOperationTypeCertificateRotation: "Changes of the referenced secrets which roll the pods of the StatefulSet",
+}
+
+// deferDisruptiveChanges defers the reconciliation of the spec until the maintenance window of the Etcd opens if it
+// would roll the pods of the StatefulSet. The spec is reconciled as a whole once the window opens, which also defers
+// the pre-sync snapshot taken before the pods are rolled. The deferred operation is recorded in the status of the Etcd.
+func (r *Reconciler) deferDisruptiveChanges(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) ctrlutils.ReconcileStepResult {
+	now := time.Now().UTC()
+	opType, next, err := r.getDeferredSpecOperation(ctx, etcd, now)
+	if err != nil {
+		ctx.Logger.Error(err, "failed to determine whether the spec changes have to be deferred until the maintenance window opens")
+		return ctrlutils.ReconcileWithError(err)
+	}
+
+	originalEtcd := etcd.DeepCopy()
+	var changed bool
+	for deferredOpType, description := range deferredSpecOperationDescriptions {
+		if deferredOpType == opType {
+			changed = utils.SetDeferredOperation(&etcd.Status, deferredOpType, description, next, now) || changed
+		} else {
+			changed = utils.RemoveDeferredOperation(&etcd.Status, deferredOpType) || changed
+		}
+	}
+	if changed {
+		if err = r.client.Status().Patch(ctx, etcd, client.MergeFrom(originalEtcd)); err != nil {
+			ctx.Logger.Error(err, "failed to update the deferred operations in the status")
+			return ctrlutils.ReconcileWithError(err)
+		}
+	}
+	if opType == "" {
+		return ctrlutils.ContinueReconcile()
+	}
+
+	description := fmt.Sprintf("%s are deferred until the maintenance window opens at %s", deferredSpecOperationDescriptions[opType], next.Format(time.RFC3339))
+	if changed {
+		r.recorder.Event(etcd, corev1.EventTypeNormal, eventReasonOperationDeferred, description)
+	}
+	ctx.Logger.Info("Deferring spec reconciliation until the maintenance window opens", "operation", opType, "maintenanceWindowStart", next)
+	return ctrlutils.ReconcileAfter(min(next.Sub(now), r.config.EtcdStatusSyncPeriod.Duration), description)
+}
+
+// getDeferredSpecOperation returns the disruptive operation which the reconciliation of the spec would trigger while
+// the maintenance window is closed, together with the time at which the window opens next. An empty operation type is
+// returned if the spec changes can be reconciled immediately, which is also the case if they raise the quota of the
+// backend database. An invalid maintenance window, which can only be set if
+// the validations of the CRD are bypassed, does not block the reconciliation: it is reported as a warning event and the
+// spec changes are not deferred.
+func (r *Reconciler) getDeferredSpecOperation(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, now time.Time) (druidv1alpha1.DeferredOperationType, time.Time, error) {
+	// A new etcd cluster is created immediately, and the pods of externally managed members are not rolled by druid.
+	if etcd.Status.ObservedGeneration == nil || !druidv1alpha1.ArePodsManagedByEtcdDruid(etcd) {
+		return "", time.Time{}, nil
+	}
+	deferred, next, err := utils.ShouldDeferToMaintenanceWindow(etcd, now)
+	if err != nil {
+		ctx.Logger.Error(err, "Invalid maintenance window, spec changes are not deferred")
+		r.recorder.Eventf(etcd, corev1.EventTypeWarning, eventReasonInvalidMaintenanceWindow, "Spec changes are not deferred since the maintenance window is invalid: %v", err)
+		return "", time.Time{}, nil
+	}
+	if !deferred {
+		return "", time.Time{}, nil
+	}
+	// A raised quota, e.g. by the automatic quota expansion, is rolled out immediately since the backend database may
+	// otherwise run out of space. Other pending spec changes are rolled out together with it.
+	quotaRaised, err := configmap.IsQuotaRaised(ctx, r.client, etcd)
+	if err != nil || quotaRaised {
+		return "", time.Time{}, err
+	}
+	configMapCheckSum, err := configmap.ComputeCheckSum(etcd)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	ctx.Data[common.CheckSumKeyConfigMap] = configMapCheckSum
+	opType, err := statefulset.GetPendingDisruptiveOperation(ctx, r.client, r.imageVector, etcd)
+	if err != nil || opType == "" {
+		return "", time.Time{}, err
+	}
+	return opType, next, nil
+}
+
+// isSpecReconcileDeferred returns true if the reconciliation of the spec of the given Etcd is deferred until the
+// maintenance window opens.
+func isSpecReconcileDeferred(etcd *druidv1alpha1.Etcd) bool {
+	return slices.ContainsFunc(etcd.Status.DeferredOperations, func(op druidv1alpha1.DeferredOperation) bool {
+		_, ok := deferredSpecOperationDescriptions[op.Type]
+		return ok
+	})
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/component/configmap"
+	"github.com/gardener/etcd-druid/internal/component/statefulset"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDeferDisruptiveChanges(t *testing.T) {
+	closedWindow := &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}
+	testCases := []struct {
+		name                  string
+		maintenanceWindow     *druidv1alpha1.MaintenanceWindow
+		ignoreWindow          bool
+		changeSpec            bool
+		raiseQuota            bool
+		deferredOperations    []druidv1alpha1.DeferredOperation
+		expectDeferred        bool
+		expectedDeferredTypes []druidv1alpha1.DeferredOperationType
+		expectedEventReason   string
+	}{
+		{
+			name:       "spec changes should not be deferred without maintenance window",
+			changeSpec: true,
+		},
+		{
+			name:                  "spec changes which roll the pods should be deferred while the maintenance window is closed",
+			maintenanceWindow:     closedWindow,
+			changeSpec:            true,
+			expectDeferred:        true,
+			expectedDeferredTypes: []druidv1alpha1.DeferredOperationType{druidv1alpha1.DeferredOperationTypeRollingUpdate},
+		},
+		{
+			name:              "spec changes should not be deferred if the maintenance window is ignored",
+			maintenanceWindow: closedWindow,
+			ignoreWindow:      true,
+			changeSpec:        true,
+			deferredOperations: []druidv1alpha1.DeferredOperation{
+				{Type: druidv1alpha1.DeferredOperationTypeRollingUpdate, DeferredSince: metav1.Now()},
+			},
+		},
+		{
+			name:              "spec changes which do not roll the pods should not be deferred and previously deferred operations should be removed",
+			maintenanceWindow: closedWindow,
+			deferredOperations: []druidv1alpha1.DeferredOperation{
+				{Type: druidv1alpha1.DeferredOperationTypeRollingUpdate, DeferredSince: metav1.Now()},
+				{Type: druidv1alpha1.DeferredOperationTypeCompaction, DeferredSince: metav1.Now()},
+			},
+			expectedDeferredTypes: []druidv1alpha1.DeferredOperationType{druidv1alpha1.DeferredOperationTypeCompaction},
+		},
+		{
+			name:              "spec changes which raise the quota should not be deferred while the maintenance window is closed",
+			maintenanceWindow: closedWindow,
+			changeSpec:        true,
+			raiseQuota:        true,
+			deferredOperations: []druidv1alpha1.DeferredOperation{
+				{Type: druidv1alpha1.DeferredOperationTypeRollingUpdate, DeferredSince: metav1.Now()},
+			},
+		},
+		{
+			name:                "spec changes should not be deferred if the maintenance window is invalid",
+			maintenanceWindow:   &druidv1alpha1.MaintenanceWindow{Schedule: "invalid", Duration: metav1.Duration{Duration: time.Minute}},
+			changeSpec:          true,
+			expectedEventReason: eventReasonInvalidMaintenanceWindow,
+		},
+	}
+
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+			etcd.Status.ObservedGeneration = ptr.To[int64](1)
+			etcd.Status.DeferredOperations = tc.deferredOperations
+			backupSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: etcd.Spec.Backup.Store.SecretRef.Name, Namespace: etcd.Namespace},
+				Data:       map[string][]byte{"hostPath": []byte("/var/data/etcd-backup")},
+			}
+			cl := testutils.NewTestClientBuilder().
+				WithScheme(kubernetes.Scheme).
+				WithObjects(etcd.DeepCopy(), backupSecret).
+				WithStatusSubresource(etcd).
+				Build()
+
+			// create the ConfigMap and the StatefulSet as they are synced for the current spec
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), "test-run")
+			g.Expect(configmap.New(cl).Sync(opCtx, etcd)).To(Succeed())
+			g.Expect(statefulset.New(cl, iv).Sync(opCtx, etcd)).To(Succeed())
+
+			etcd.Spec.MaintenanceWindow = tc.maintenanceWindow
+			if tc.ignoreWindow {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.IgnoreMaintenanceWindowAnnotation, "true")
+			}
+			if tc.changeSpec {
+				etcd.Spec.Annotations = map[string]string{"foo": "bar"}
+			}
+			if tc.raiseQuota {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.ExpandedQuotaAnnotation, "16Gi")
+			}
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				client:      cl,
+				recorder:    recorder,
+				logger:      logr.Discard(),
+				imageVector: iv,
+				config: druidconfigv1alpha1.EtcdControllerConfiguration{
+					EtcdStatusSyncPeriod: metav1.Duration{Duration: 15 * time.Second},
+				},
+			}
+
+			result := r.deferDisruptiveChanges(component.NewOperatorContext(context.Background(), logr.Discard(), "test-run"), etcd)
+			g.Expect(result.HasErrors()).To(BeFalse())
+			g.Expect(result.NeedsRequeue()).To(Equal(tc.expectDeferred))
+
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			g.Expect(latestEtcd.Status.DeferredOperations).To(HaveLen(len(tc.expectedDeferredTypes)))
+			for _, opType := range tc.expectedDeferredTypes {
+				g.Expect(latestEtcd.Status.DeferredOperations).To(ContainElement(HaveField("Type", opType)))
+			}
+			if tc.expectDeferred {
+				g.Expect(result.ReconcileResult()).To(HaveField("RequeueAfter", 15*time.Second))
+				g.Expect(latestEtcd.Status.DeferredOperations[0].ScheduledTime).ToNot(BeNil())
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(eventReasonOperationDeferred)))
+			} else if tc.expectedEventReason != "" {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(tc.expectedEventReason)))
+			} else {
+				g.Expect(recorder.Events).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+
+	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonQuotaExpanded              = "QuotaExpanded"
+	eventReasonQuotaExpansionLimitReached = "QuotaExpansionLimitReached"
+	eventReasonNoSpaceAlarmDisarmed       = "NoSpaceAlarmDisarmed"
+	eventReasonNoSpaceAlarmDisarmFailed   = "NoSpaceAlarmDisarmFailed"
+)
+
+// quotaPVCCapacityPercent is the share of the capacity of the PVCs up to which the quota is expanded. The remaining
+// capacity is left for the write-ahead log, the snapshots of etcd and the copy of the backend database which is written
+// during a defragmentation.
+const quotaPVCCapacityPercent = 50
+
+// expandQuotaAndDisarmNoSpaceAlarm applies the quota auto-expansion policy of the etcd cluster. If the backend database
+// of a member exceeds the configured share of the quota, then the quota is raised by one step, bounded by the maximum
+// quota and a share of the capacity of the PVCs, and a spec reconciliation is triggered to roll out the new quota. Once the
+// backend databases of all members fit into the quota again, the NOSPACE alarm is disarmed.
+// Failures are recorded as events and logged but do not fail the reconciliation of the status.
+func (r *Reconciler) expandQuotaAndDisarmNoSpaceAlarm(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+	// The expanded quota is also kept once the automatic quota expansion has been disabled, so that the quota does not
+	// shrink below the size of the backend database.
+	etcd.Status.ExpandedQuota = druidv1alpha1.GetExpandedQuota(etcd)
+	expansion := etcd.Spec.Etcd.QuotaAutoExpansion
+	if expansion == nil {
+		return ctrlutils.ContinueReconcile()
+	}
+	// Wait until the last change of the quota, or any other change of the spec, has been rolled out to all members. A
+	// spec reconciliation which is deferred until the maintenance window opens does not hold back the quota expansion
+	// and the disarming of the NOSPACE alarm, since the raised quota is rolled out immediately.
+	if etcd.Spec.Replicas == 0 ||
+		((druidv1alpha1.HasReconcileOperationAnnotation(etcd.ObjectMeta) || etcd.IsReconciliationInProgress()) && !isSpecReconcileDeferred(etcd)) ||
+		(etcd.Status.LastOperation != nil && etcd.Status.LastOperation.Type == druidv1alpha1.LastOperationTypeQuotaExpansion) ||
+		!isConditionTrue(etcd, druidv1alpha1.ConditionTypeAllMembersUpdated) {
+		return ctrlutils.ContinueReconcile()
+	}
+
+	quotaBytes := common.DefaultEtcdQuotaBytes
+	if quota := druidv1alpha1.GetQuota(etcd); quota != nil {
+		quotaBytes = quota.Value()
+	}
+	maxDBSize, allDBSizesKnown := getMaxDBSize(etcd)
+	threshold := int64(ptr.Deref(expansion.ThresholdPercent, common.DefaultQuotaUsageThresholdPercent))
+	if maxDBSize*100 >= quotaBytes*threshold {
+		if err := r.expandQuota(ctx, etcd, quotaBytes, logger); err != nil {
+			logger.Error(err, "failed to expand the etcd quota")
+		}
+		return ctrlutils.ContinueReconcile()
+	}
+
+	if ptr.Deref(expansion.DisarmNoSpaceAlarm, true) && allDBSizesKnown && maxDBSize < quotaBytes &&
+		getConditionReason(etcd, druidv1alpha1.ConditionTypeQuotaHealthy) == condition.NoSpaceAlarmPresent {
+		if err := r.disarmNoSpaceAlarm(ctx, etcd); err != nil {
+			logger.Error(err, "failed to disarm the NOSPACE alarm")
+			r.recorder.Eventf(etcd, corev1.EventTypeWarning, eventReasonNoSpaceAlarmDisarmFailed, "Failed to disarm the NOSPACE alarm: %v", err)
+		}
+	}
+	return ctrlutils.ContinueReconcile()
+}
+
+// expandQuota raises the quota by one step, records it in the ExpandedQuotaAnnotation and triggers a spec reconciliation
+// to roll out the new quota.
+func (r *Reconciler) expandQuota(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, quotaBytes int64, logger logr.Logger) error {
+	expansion := etcd.Spec.Etcd.QuotaAutoExpansion
+	limitBytes := expansion.MaxQuota.Value()
+	pvcCapacity, err := r.getMinPVCCapacity(ctx, etcd)
+	if err != nil {
+		return err
+	}
+	if pvcCapacity == nil {
+		logger.Info("Skipping expansion of the etcd quota since the capacity of the PVCs is not known")
+		return nil
+	}
+	limitBytes = min(limitBytes, pvcCapacity.Value()*quotaPVCCapacityPercent/100)
+
+	newQuotaBytes := min(quotaBytes+expansion.Step.Value(), limitBytes)
+	if newQuotaBytes <= quotaBytes {
+		r.recorder.Eventf(etcd, corev1.EventTypeWarning, eventReasonQuotaExpansionLimitReached,
+			"Quota of %d bytes cannot be expanded further: it is limited to %d bytes by the maximum quota and %d%% of the capacity of the PVCs", quotaBytes, limitBytes, quotaPVCCapacityPercent)
+		return nil
+	}
+
+	// The expanded quota is recorded in an annotation rather than only in the status, so that the quota is never
+	// rendered below the size of the backend database if the status is lost.
+	newQuota := resource.NewQuantity(newQuotaBytes, resource.BinarySI)
+	if err := r.triggerSpecReconcile(ctx, etcd, map[string]string{druidv1alpha1.ExpandedQuotaAnnotation: newQuota.String()}); err != nil {
+		return fmt.Errorf("failed to record the expanded quota: %w", err)
+	}
+
+	description := fmt.Sprintf("Expanded quota from %d to %d bytes", quotaBytes, newQuotaBytes)
+	etcd.Status.ExpandedQuota = newQuota
+	etcd.Status.LastOperation = newLastOperation(ctx.RunID, druidv1alpha1.LastOperationTypeQuotaExpansion, description)
+	r.recorder.Event(etcd, corev1.EventTypeNormal, eventReasonQuotaExpanded, description)
+	logger.Info(description)
+	return nil
+}
+
+// disarmNoSpaceAlarm disarms the NOSPACE alarms which have been raised by the members of the etcd cluster.
+func (r *Reconciler) disarmNoSpaceAlarm(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
+	tlsConfig, _, err := etcdclient.GetClientTLSConfig(ctx, r.client, etcd)
+	if err != nil {
+		return err
+	}
+	etcdCl, err := r.newMaintenanceClient(etcdclient.GetClientEndpoint(etcd), tlsConfig)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = etcdCl.Close()
+	}()
+
+	reqCtx, cancel := context.WithTimeout(ctx, etcdclient.RequestTimeout)
+	defer cancel()
+	alarmList, err := etcdCl.AlarmList(reqCtx)
+	if err != nil {
+		return err
+	}
+	disarmed := 0
+	for _, alarm := range alarmList.Alarms {
+		if alarm.Alarm != etcdserverpb.AlarmType_NOSPACE {
+			continue
+		}
+		if _, err = etcdCl.AlarmDisarm(reqCtx, (*clientv3.AlarmMember)(alarm)); err != nil {
+			return err
+		}
+		disarmed++
+	}
+	if disarmed == 0 {
+		return nil
+	}
+
+	description := fmt.Sprintf("Disarmed %d NOSPACE alarm(s) since the backend databases of all members fit into the quota", disarmed)
+	etcd.Status.LastOperation = newLastOperation(ctx.RunID, druidv1alpha1.LastOperationTypeAlarmDisarm, description)
+	r.recorder.Event(etcd, corev1.EventTypeNormal, eventReasonNoSpaceAlarmDisarmed, description)
+	return nil
+}
+
+// getMinPVCCapacity returns the smallest capacity of the PVCs of the etcd members, or nil if the PVC of a member
+// does not exist (yet).
+func (r *Reconciler) getMinPVCCapacity(ctx context.Context, etcd *druidv1alpha1.Etcd) (*resource.Quantity, error) {
+	var minCapacity *resource.Quantity
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		pvc := &corev1.PersistentVolumeClaim{}
+		pvcName := fmt.Sprintf("%s-%s", ptr.Deref(etcd.Spec.VolumeClaimTemplate, etcd.Name), podName)
+		if err := r.client.Get(ctx, client.ObjectKey{Name: pvcName, Namespace: etcd.Namespace}, pvc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+		if !ok {
+			if capacity, ok = pvc.Spec.Resources.Requests[corev1.ResourceStorage]; !ok {
+				return nil, nil
+			}
+		}
+		if minCapacity == nil || capacity.Cmp(*minCapacity) < 0 {
+			minCapacity = &capacity
+		}
+	}
+	return minCapacity, nil
+}
+
+// getMaxDBSize returns the size of the largest backend database of the members and whether the size is known for all
+// members.
+func getMaxDBSize(etcd *druidv1alpha1.Etcd) (int64, bool) {
+	var maxDBSize int64
+	allKnown := len(etcd.Status.Members) > 0
+	for _, member := range etcd.Status.Members {
+		if member.DBSize == nil {
+			allKnown = false
+			continue
+		}
+		maxDBSize = max(maxDBSize, member.DBSize.Value())
+	}
+	return maxDBSize, allKnown
+}
+
+func isConditionTrue(etcd *druidv1alpha1.Etcd, conditionType druidv1alpha1.ConditionType) bool {
+	for _, cond := range etcd.Status.Conditions {
+		if cond.Type == conditionType {
+			return cond.Status == druidv1alpha1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func getConditionReason(etcd *druidv1alpha1.Etcd, conditionType druidv1alpha1.ConditionType) string {
+	for _, cond := range etcd.Status.Conditions {
+		if cond.Type == conditionType {
+			return cond.Reason
+		}
+	}
+	return ""
+}
+
+func newLastOperation(runID string, opType druidapicommon.LastOperationType, description string) *druidapicommon.LastOperation {
+	return &druidapicommon.LastOperation{
+		RunID:          runID,
+		Type:           opType,
+		State:          druidv1alpha1.LastOperationStateSucceeded,
+		LastUpdateTime: metav1.NewTime(time.Now().UTC()),
+		Description:    description,
+	}
+}
@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestExpandQuotaAndDisarmNoSpaceAlarm(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		dbSize                string
+		pvcCapacity           string
+		lastOperationType     druidapicommon.LastOperationType
+		specReconcilePending  bool
+		specReconcileDeferred bool
+		quotaHealthyReason    string
+		expectedExpandedQuota *resource.Quantity
+		expectedLastOpType    druidapicommon.LastOperationType
+		expectReconcileAnnot  bool
+		expectDisarmed        bool
+		expectedEventReason   string
+	}{
+		{
+			name:               "usage below the threshold should neither expand the quota nor disarm the alarm",
+			dbSize:             "500Mi",
+			pvcCapacity:        "10Gi",
+			quotaHealthyReason: condition.QuotaUsageBelowThreshold,
+			expectedLastOpType: druidv1alpha1.LastOperationTypeReconcile,
+		},
+		{
+			name:                  "usage above the threshold should expand the quota by one step",
+			dbSize:                "900Mi",
+			pvcCapacity:           "10Gi",
+			quotaHealthyReason:    condition.QuotaUsageAboveThreshold,
+			expectedExpandedQuota: ptr.To(resource.MustParse("2Gi")),
+			expectedLastOpType:    druidv1alpha1.LastOperationTypeQuotaExpansion,
+			expectReconcileAnnot:  true,
+			expectedEventReason:   eventReasonQuotaExpanded,
+		},
+		{
+			name:                  "expansion should be limited by half of the capacity of the PVCs",
+			dbSize:                "900Mi",
+			pvcCapacity:           "3Gi",
+			quotaHealthyReason:    condition.QuotaUsageAboveThreshold,
+			expectedExpandedQuota: ptr.To(resource.MustParse("1536Mi")),
+			expectedLastOpType:    druidv1alpha1.LastOperationTypeQuotaExpansion,
+			expectReconcileAnnot:  true,
+			expectedEventReason:   eventReasonQuotaExpanded,
+		},
+		{
+			name:                "expansion beyond half of the capacity of the PVCs should be reported",
+			dbSize:              "900Mi",
+			pvcCapacity:         "2Gi",
+			quotaHealthyReason:  condition.QuotaUsageAboveThreshold,
+			expectedLastOpType:  druidv1alpha1.LastOperationTypeReconcile,
+			expectedEventReason: eventReasonQuotaExpansionLimitReached,
+		},
+		{
+			name:               "expansion should wait until the previous expansion has been rolled out",
+			dbSize:             "900Mi",
+			pvcCapacity:        "10Gi",
+			lastOperationType:  druidv1alpha1.LastOperationTypeQuotaExpansion,
+			quotaHealthyReason: condition.QuotaUsageAboveThreshold,
+			expectedLastOpType: druidv1alpha1.LastOperationTypeQuotaExpansion,
+		},
+		{
+			name:                 "expansion should wait until a pending spec reconciliation has been rolled out",
+			dbSize:               "900Mi",
+			pvcCapacity:          "10Gi",
+			specReconcilePending: true,
+			quotaHealthyReason:   condition.QuotaUsageAboveThreshold,
+			expectedLastOpType:   druidv1alpha1.LastOperationTypeReconcile,
+			expectReconcileAnnot: true,
+		},
+		{
+			name:                  "expansion should not wait for a spec reconciliation which is deferred until the maintenance window opens",
+			dbSize:                "900Mi",
+			pvcCapacity:           "10Gi",
+			specReconcilePending:  true,
+			specReconcileDeferred: true,
+			quotaHealthyReason:    condition.QuotaUsageAboveThreshold,
+			expectedExpandedQuota: ptr.To(resource.MustParse("2Gi")),
+			expectedLastOpType:    druidv1alpha1.LastOperationTypeQuotaExpansion,
+			expectReconcileAnnot:  true,
+			expectedEventReason:   eventReasonQuotaExpanded,
+		},
+		{
+			name:                  "NOSPACE alarm should be disarmed while a spec reconciliation is deferred until the maintenance window opens",
+			dbSize:                "500Mi",
+			pvcCapacity:           "10Gi",
+			specReconcilePending:  true,
+			specReconcileDeferred: true,
+			quotaHealthyReason:    condition.NoSpaceAlarmPresent,
+			expectedLastOpType:    druidv1alpha1.LastOperationTypeAlarmDisarm,
+			expectReconcileAnnot:  true,
+			expectDisarmed:        true,
+			expectedEventReason:   eventReasonNoSpaceAlarmDisarmed,
+		},
+		{
+			name:                "NOSPACE alarm should be disarmed once the backend databases fit into the quota",
+			dbSize:              "500Mi",
+			pvcCapacity:         "10Gi",
+			quotaHealthyReason:  condition.NoSpaceAlarmPresent,
+			expectedLastOpType:  druidv1alpha1.LastOperationTypeAlarmDisarm,
+			expectDisarmed:      true,
+			expectedEventReason: eventReasonNoSpaceAlarmDisarmed,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := createEtcdWithQuotaAutoExpansion(tc.dbSize, tc.quotaHealthyReason)
+			if tc.lastOperationType != "" {
+				etcd.Status.LastOperation.Type = tc.lastOperationType
+			}
+			if tc.specReconcilePending {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile)
+				etcd.Status.LastOperation.State = druidv1alpha1.LastOperationStateRequeue
+			}
+			if tc.specReconcileDeferred {
+				etcd.Status.DeferredOperations = []druidv1alpha1.DeferredOperation{
+					{Type: druidv1alpha1.DeferredOperationTypeRollingUpdate, DeferredSince: metav1.Now()},
+				}
+			}
+			var existingObjects []client.Object
+			for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+				existingObjects = append(existingObjects, &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: etcd.Name + "-" + podName, Namespace: etcd.Namespace},
+					Status: corev1.PersistentVolumeClaimStatus{
+						Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(tc.pvcCapacity)},
+					},
+				})
+			}
+			existingObjects = append(existingObjects, etcd.DeepCopy())
+			cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, nil, nil, nil, existingObjects)
+			recorder := record.NewFakeRecorder(10)
+			etcdCl := testutils.NewFakeMaintenanceClient()
+			etcdCl.Alarms = []*etcdserverpb.AlarmMember{{MemberID: 1, Alarm: etcdserverpb.AlarmType_NOSPACE}}
+			r := &Reconciler{
+				client:               cl,
+				recorder:             recorder,
+				logger:               logr.Discard(),
+				newMaintenanceClient: etcdCl.Factory(),
+			}
+
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), "test-run")
+			result := r.expandQuotaAndDisarmNoSpaceAlarm(opCtx, etcd, logr.Discard())
+			g.Expect(result.HasErrors()).To(BeFalse())
+
+			if tc.expectedExpandedQuota != nil {
+				g.Expect(etcd.Status.ExpandedQuota).ToNot(BeNil())
+				g.Expect(etcd.Status.ExpandedQuota.Cmp(*tc.expectedExpandedQuota)).To(Equal(0))
+			} else {
+				g.Expect(etcd.Status.ExpandedQuota).To(BeNil())
+			}
+			g.Expect(etcd.Status.LastOperation.Type).To(Equal(tc.expectedLastOpType))
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(opCtx, client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			g.Expect(druidv1alpha1.HasReconcileOperationAnnotation(latestEtcd.ObjectMeta)).To(Equal(tc.expectReconcileAnnot))
+			if tc.expectedExpandedQuota != nil {
+				g.Expect(latestEtcd.Annotations).To(HaveKeyWithValue(druidv1alpha1.ExpandedQuotaAnnotation, tc.expectedExpandedQuota.String()))
+			} else {
+				g.Expect(latestEtcd.Annotations).ToNot(HaveKey(druidv1alpha1.ExpandedQuotaAnnotation))
+			}
+			g.Expect(etcdCl.Disarmed()).To(Equal(tc.expectDisarmed))
+			if tc.expectedEventReason != "" {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(tc.expectedEventReason)))
+			} else {
+				g.Expect(recorder.Events).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestExpandQuotaAndDisarmNoSpaceAlarmWithoutPolicy(t *testing.T) {
+	g := NewWithT(t)
+	etcd := createEtcdWithQuotaAutoExpansion("900Mi", condition.QuotaUsageAboveThreshold)
+	etcd.Spec.Etcd.QuotaAutoExpansion = nil
+	etcd.Status.ExpandedQuota = ptr.To(resource.MustParse("2Gi"))
+	r := &Reconciler{client: testutils.CreateDefaultFakeClient(), recorder: record.NewFakeRecorder(10), logger: logr.Discard()}
+
+	result := r.expandQuotaAndDisarmNoSpaceAlarm(component.NewOperatorContext(context.Background(), logr.Discard(), "test-run"), etcd, logr.Discard())
+
+	g.Expect(result.HasErrors()).To(BeFalse())
+	g.Expect(etcd.Status.ExpandedQuota).To(BeNil())
+}
+
+func TestExpandQuotaAndDisarmNoSpaceAlarmAfterPolicyRemoval(t *testing.T) {
+	g := NewWithT(t)
+	etcd := createEtcdWithQuotaAutoExpansion("1500Mi", condition.QuotaUsageBelowThreshold)
+	metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.ExpandedQuotaAnnotation, "2Gi")
+	etcd.Spec.Etcd.QuotaAutoExpansion = nil
+	r := &Reconciler{client: testutils.CreateDefaultFakeClient(), recorder: record.NewFakeRecorder(10), logger: logr.Discard()}
+
+	result := r.expandQuotaAndDisarmNoSpaceAlarm(component.NewOperatorContext(context.Background(), logr.Discard(), "test-run"), etcd, logr.Discard())
+
+	g.Expect(result.HasErrors()).To(BeFalse())
+	g.Expect(etcd.Status.ExpandedQuota).ToNot(BeNil())
+	g.Expect(etcd.Status.ExpandedQuota.Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+	// The quota is not reverted to spec.etcd.quota, which is smaller than the backend database.
+	g.Expect(druidv1alpha1.GetQuota(etcd).Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+}
+
+func TestExpandQuotaAndDisarmNoSpaceAlarmAfterStatusLoss(t *testing.T) {
+	g := NewWithT(t)
+	etcd := createEtcdWithQuotaAutoExpansion("1500Mi", condition.QuotaUsageBelowThreshold)
+	metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.ExpandedQuotaAnnotation, "2Gi")
+	etcd.Status.ExpandedQuota = nil
+	r := &Reconciler{client: testutils.CreateDefaultFakeClient(), recorder: record.NewFakeRecorder(10), logger: logr.Discard()}
+
+	result := r.expandQuotaAndDisarmNoSpaceAlarm(component.NewOperatorContext(context.Background(), logr.Discard(), "test-run"), etcd, logr.Discard())
+
+	g.Expect(result.HasErrors()).To(BeFalse())
+	g.Expect(druidv1alpha1.GetQuota(etcd).Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+	g.Expect(etcd.Status.ExpandedQuota).ToNot(BeNil())
+	g.Expect(etcd.Status.ExpandedQuota.Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+	g.Expect(etcd.Status.LastOperation.Type).To(Equal(druidv1alpha1.LastOperationTypeReconcile))
+}
+
+func createEtcdWithQuotaAutoExpansion(dbSize, quotaHealthyReason string) *druidv1alpha1.Etcd {
+	etcd := testutils.EtcdBuilderWithoutDefaults(testutils.TestEtcdName, testutils.TestNamespace).
+		WithReplicas(3).
+		WithConditionAllMembersUpdated(true).
+		WithLastOperation(&druidapicommon.LastOperation{
+			Type:  druidv1alpha1.LastOperationTypeReconcile,
+			State: druidv1alpha1.LastOperationStateSucceeded,
+		}).
+		Build()
+	etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse("1Gi"))
+	etcd.Spec.Etcd.QuotaAutoExpansion = &druidv1alpha1.QuotaAutoExpansion{
+		Step:     resource.MustParse("1Gi"),
+		MaxQuota: resource.MustParse("4Gi"),
+	}
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		etcd.Status.Members = append(etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+			Name:   podName,
+			Status: druidv1alpha1.EtcdMemberStatusReady,
+			DBSize: ptr.To(resource.MustParse(dbSize)),
+		})
+	}
+	etcd.Status.Conditions = append(etcd.Status.Conditions, druidv1alpha1.Condition{
+		Type:   druidv1alpha1.ConditionTypeQuotaHealthy,
+		Status: druidv1alpha1.ConditionFalse,
+		Reason: quotaHealthyReason,
+	})
+	return etcd
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/recommender"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const eventReasonResourceRecommendationApplied = "ResourceRecommendationApplied"
+
+// updateResourceRecommendation samples the resource usage of the etcd members once per configured interval and
+// updates the recommended resources in the status. If the Etcd opts into applying the recommendations, then they are
+// applied within the configured bounds once they differ significantly from the applied resources, the maintenance
+// window is open or bypassed and the previous change of the spec has been rolled out to all members.
+// Failures to sample the resource usage, e.g. because the metrics API is not served, are logged but do not fail the
+// reconciliation of the status.
+func (r *Reconciler) updateResourceRecommendation(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+	if !r.config.ResourceRecommendation.Enabled || r.recommender == nil || !druidv1alpha1.ArePodsManagedByEtcdDruid(etcd) {
+		etcd.Status.ResourceRecommendation = nil
+		return ctrlutils.ContinueReconcile()
+	}
+	now := time.Now().UTC()
+	if lastUpdate := etcd.Status.ResourceRecommendation; lastUpdate == nil || lastUpdate.LastUpdateTime == nil ||
+		now.Sub(lastUpdate.LastUpdateTime.Time) >= r.config.ResourceRecommendation.Interval.Duration {
+		recommendation, err := r.recommender.Recommend(ctx, etcd, now)
+		if err != nil {
+			logger.Error(err, "failed to sample the resource usage of the etcd members, keeping the previous resource recommendation")
+		} else {
+			etcd.Status.ResourceRecommendation = recommendation
+		}
+	}
+	if etcd.Status.ResourceRecommendation != nil {
+		etcd.Status.ResourceRecommendation.Applied = druidv1alpha1.GetAppliedResourceRecommendation(etcd)
+	}
+	if err := r.applyResourceRecommendation(ctx, etcd, now, logger); err != nil {
+		logger.Error(err, "failed to apply the resource recommendation")
+	}
+	return ctrlutils.ContinueReconcile()
+}
+
+// applyResourceRecommendation applies the recommended resources, clamped to the configured bounds, and triggers a spec
+// reconciliation to roll them out. The members are rolled one at a time by the StatefulSet, so the recommendation is
+// only applied while all members are ready and updated, which keeps the quorum during the roll-out.
+func (r *Reconciler) applyResourceRecommendation(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, now time.Time, logger logr.Logger) error {
+	spec, recommendation := etcd.Spec.ResourceRecommendation, etcd.Status.ResourceRecommendation
+	if spec == nil || !ptr.Deref(spec.Apply, false) || recommendation == nil || recommendation.LastUpdateTime == nil {
+		return nil
+	}
+	// Wait until the previously applied recommendation, or any other change of the spec, has been rolled out to all members.
+	if etcd.Spec.Replicas == 0 ||
+		druidv1alpha1.HasReconcileOperationAnnotation(etcd.ObjectMeta) ||
+		etcd.IsReconciliationInProgress() ||
+		!ptr.Deref(etcd.Status.Ready, false) ||
+		!isConditionTrue(etcd, druidv1alpha1.ConditionTypeAllMembersUpdated) {
+		return nil
+	}
+	deferred, _, err := utils.ShouldDeferToMaintenanceWindow(etcd, now)
+	if err != nil || deferred {
+		return err
+	}
+
+	applied := &druidv1alpha1.AppliedResourceRecommendation{
+		Etcd:          recommender.ClampToBounds(recommendation.Etcd, spec.Etcd),
+		BackupRestore: recommender.ClampToBounds(recommendation.BackupRestore, spec.BackupRestore),
+		LastApplyTime: metav1.Time{Time: now},
+	}
+	if previous := druidv1alpha1.GetAppliedResourceRecommendation(etcd); previous != nil &&
+		!recommender.HasSignificantChange(previous.Etcd, applied.Etcd) &&
+		!recommender.HasSignificantChange(previous.BackupRestore, applied.BackupRestore) {
+		return nil
+	}
+
+	// The applied resources are recorded in an annotation rather than only in the status, so that the rendered resources
+	// do not fall back to the configured ones if the status is lost.
+	appliedJSON, err := json.Marshal(applied)
+	if err != nil {
+		return err
+	}
+	if err = r.triggerSpecReconcile(ctx, etcd, map[string]string{druidv1alpha1.AppliedResourceRecommendationAnnotation: string(appliedJSON)}); err != nil {
+		return fmt.Errorf("failed to record the applied resource recommendation: %w", err)
+	}
+
+	recommendation.Applied = druidv1alpha1.GetAppliedResourceRecommendation(etcd)
+	description := fmt.Sprintf("Applied recommended resources: etcd %s, backup-restore %s",
+		describeContainerResources(applied.Etcd), describeContainerResources(applied.BackupRestore))
+	r.recorder.Event(etcd, corev1.EventTypeNormal, eventReasonResourceRecommendationApplied, description)
+	logger.Info(description)
+	return nil
+}
+
+func describeContainerResources(resources *druidv1alpha1.ContainerResources) string {
+	if resources == nil {
+		return "unchanged"
+	}
+	description := fmt.Sprintf("requests cpu=%s memory=%s", resources.Requests.Cpu(), resources.Requests.Memory())
+	if len(resources.Limits) > 0 {
+		description += fmt.Sprintf(", limits cpu=%s memory=%s", resources.Limits.Cpu(), resources.Limits.Memory())
+	}
+	return description
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/recommender"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestUpdateResourceRecommendation(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		disabled             bool
+		apply                bool
+		maintenanceWindow    *druidv1alpha1.MaintenanceWindow
+		ignoreWindow         bool
+		allMembersUpdated    bool
+		applied              *druidv1alpha1.AppliedResourceRecommendation
+		expectRecommendation bool
+		expectApplied        bool
+		expectReconcileAnnot bool
+	}{
+		{
+			name:     "disabled resource recommendations should clear the status",
+			disabled: true,
+		},
+		{
+			name:                 "recommendation should only be recorded if it is not applied",
+			allMembersUpdated:    true,
+			expectRecommendation: true,
+		},
+		{
+			name:                 "recommendation should be applied and rolled out",
+			apply:                true,
+			allMembersUpdated:    true,
+			expectRecommendation: true,
+			expectApplied:        true,
+			expectReconcileAnnot: true,
+		},
+		{
+			name:                 "recommendation should not be applied outside of the maintenance window",
+			apply:                true,
+			maintenanceWindow:    &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}},
+			allMembersUpdated:    true,
+			expectRecommendation: true,
+		},
+		{
+			name:                 "recommendation should be applied outside of the maintenance window if it is ignored",
+			apply:                true,
+			maintenanceWindow:    &druidv1alpha1.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}},
+			ignoreWindow:         true,
+			allMembersUpdated:    true,
+			expectRecommendation: true,
+			expectApplied:        true,
+			expectReconcileAnnot: true,
+		},
+		{
+			name:                 "recommendation should not be applied while a previous change is rolled out",
+			apply:                true,
+			expectRecommendation: true,
+		},
+		{
+			name:              "recommendation recorded in the annotation should not be applied again if it does not change significantly",
+			apply:             true,
+			allMembersUpdated: true,
+			applied: &druidv1alpha1.AppliedResourceRecommendation{
+				Etcd:          &druidv1alpha1.ContainerResources{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("120m"), corev1.ResourceMemory: resource.MustParse("240Mi")}},
+				BackupRestore: &druidv1alpha1.ContainerResources{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("23m"), corev1.ResourceMemory: resource.MustParse("58Mi")}},
+			},
+			expectRecommendation: true,
+			expectApplied:        true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).
+				WithReplicas(3).
+				WithReadyStatus().
+				WithConditionAllMembersUpdated(tc.allMembersUpdated).
+				Build()
+			etcd.Spec.Etcd.Resources = nil
+			etcd.Spec.Backup.Resources = nil
+			etcd.Spec.ResourceRecommendation = &druidv1alpha1.ResourceRecommendationSpec{Apply: ptr.To(tc.apply)}
+			etcd.Spec.MaintenanceWindow = tc.maintenanceWindow
+			if tc.ignoreWindow {
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.IgnoreMaintenanceWindowAnnotation, "true")
+			}
+			if tc.applied != nil {
+				appliedJSON, err := json.Marshal(tc.applied)
+				g.Expect(err).ToNot(HaveOccurred())
+				metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.AppliedResourceRecommendationAnnotation, string(appliedJSON))
+			}
+			metricsClient := testutils.NewFakeMetricsClient()
+			for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+				metricsClient.SetPodUsage(client.ObjectKey{Name: podName, Namespace: etcd.Namespace}, map[string]corev1.ResourceList{
+					common.ContainerNameEtcd:              {corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("200Mi")},
+					common.ContainerNameEtcdBackupRestore: {corev1.ResourceCPU: resource.MustParse("20m"), corev1.ResourceMemory: resource.MustParse("50Mi")},
+				})
+			}
+			cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, nil, nil, nil, []client.Object{etcd.DeepCopy()})
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				client:   cl,
+				recorder: recorder,
+				logger:   logr.Discard(),
+				config: druidconfigv1alpha1.EtcdControllerConfiguration{
+					ResourceRecommendation: druidconfigv1alpha1.ResourceRecommendationConfiguration{
+						Enabled:  !tc.disabled,
+						Interval: metav1.Duration{Duration: 5 * time.Minute},
+						HalfLife: metav1.Duration{Duration: 24 * time.Hour},
+					},
+				},
+				recommender: recommender.New(metricsClient, 24*time.Hour),
+			}
+
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), "test-run")
+			result := r.updateResourceRecommendation(opCtx, etcd, logr.Discard())
+			g.Expect(result.HasErrors()).To(BeFalse())
+
+			if !tc.expectRecommendation {
+				g.Expect(etcd.Status.ResourceRecommendation).To(BeNil())
+				return
+			}
+			g.Expect(etcd.Status.ResourceRecommendation).ToNot(BeNil())
+			g.Expect(etcd.Status.ResourceRecommendation.Etcd).ToNot(BeNil())
+			g.Expect(etcd.Status.ResourceRecommendation.Members).To(HaveLen(3))
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(opCtx, client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			g.Expect(druidv1alpha1.GetAppliedResourceRecommendation(latestEtcd) != nil).To(Equal(tc.expectApplied))
+			g.Expect(etcd.Status.ResourceRecommendation.Applied).To(Equal(druidv1alpha1.GetAppliedResourceRecommendation(latestEtcd)))
+			g.Expect(druidv1alpha1.HasReconcileOperationAnnotation(latestEtcd.ObjectMeta)).To(Equal(tc.expectReconcileAnnot))
+			if tc.expectReconcileAnnot {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(eventReasonResourceRecommendationApplied)))
+			} else {
+				g.Expect(recorder.Events).To(BeEmpty())
+			}
+		})
+	}
+}
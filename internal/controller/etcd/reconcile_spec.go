@@ -30,6 +30,7 @@ func (r *Reconciler) reconcileSpec(ctx component.OperatorContext, etcd *druidv1a
 	reconcileStepFns := []reconcileFn{
 		r.recordReconcileStartOperation,
 		r.ensureFinalizer,
+		r.deferDisruptiveChanges,
 		r.preSyncEtcdResources,
 		r.syncEtcdResources,
 		r.recordReconcileSuccessOperation,
@@ -180,6 +181,7 @@ func (r *Reconciler) getOrderedOperatorsForSync(etcd *druidv1alpha1.Etcd) []comp
 	operators = append(operators,
 		component.ConfigMapKind,
 		component.StatefulSetKind,
+		component.EtcdAuthKind,
 	)
 
 	return operators
@@ -5,18 +5,31 @@
 package etcd
 
 import (
+	"context"
+	"slices"
+
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/health/condition"
 	"github.com/gardener/etcd-druid/internal/health/status"
+	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 
 	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// maxDeltaSnapshotsInCatalog is the maximum number of delta snapshots which are listed in the snapshot catalog.
+const maxDeltaSnapshotsInCatalog = 10
+
 // mutateEtcdStatusFn is a function which mutates the status of the passed etcd object
 type mutateEtcdStatusFn func(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult
 
@@ -26,8 +39,16 @@ func (r *Reconciler) reconcileStatus(ctx component.OperatorContext, etcd *druidv
 
 	var mutateETCDStatusStepFns = []mutateEtcdStatusFn{
 		r.mutateETCDStatusWithMemberStatusAndConditions,
+		r.trackLeaderChanges,
+		r.expandQuotaAndDisarmNoSpaceAlarm,
 		r.inspectStatefulSetAndMutateETCDStatus,
+		r.syncEtcdAuth,
+		r.updateResourceRecommendation,
 		r.setSelector,
+		r.recordBackupEncryptionKeyID,
+		r.inspectSecondaryStoresAndMutateETCDStatus,
+		r.inspectFullSnapshotLeaseAndMutateGarbageCollectionStatus,
+		r.inspectSnapshotLeasesAndMutateSnapshotCatalog,
 	}
 
 	for _, fn := range mutateETCDStatusStepFns {
@@ -39,18 +60,38 @@ func (r *Reconciler) reconcileStatus(ctx component.OperatorContext, etcd *druidv
 		sLog.Error(err, "failed to update etcd status")
 		return ctrlutils.ReconcileWithError(err)
 	}
+	r.recordStatusTransitionEvents(originalEtcd, etcd, sLog)
 	return ctrlutils.ContinueReconcile()
 }
 
 func (r *Reconciler) mutateETCDStatusWithMemberStatusAndConditions(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
-	statusCheck := status.NewChecker(r.client, r.config.EtcdMember.NotReadyThreshold.Duration, r.config.EtcdMember.UnknownThreshold.Duration)
+	wasDataDivergent := isDataDivergent(etcd)
+	statusCheck := status.NewChecker(r.client, r.config.EtcdMember.NotReadyThreshold.Duration, r.config.EtcdMember.UnknownThreshold.Duration).
+		WithEtcdClient(r.newMaintenanceClient).
+		WithLeaderStability(r.config.LeaderStability.Window.Duration, r.config.LeaderStability.MaxLeaderChanges).
+		WithExtraChecks(r.extraChecks)
 	if err := statusCheck.Check(ctx, logger, etcd); err != nil {
 		logger.Error(err, "Error executing status checks to update member status and conditions")
 		return ctrlutils.ReconcileWithError(err)
 	}
+	if r.config.EtcdMember.RecommendDivergentMemberReplacement && !wasDataDivergent && isDataDivergent(etcd) {
+		for _, cond := range etcd.Status.Conditions {
+			if cond.Type == druidv1alpha1.ConditionTypeDataConsistent {
+				r.recorder.Eventf(etcd, corev1.EventTypeWarning, condition.MemberDataDivergent,
+					"%s. It is recommended to replace the divergent member(s).", cond.Message)
+			}
+		}
+	}
 	return ctrlutils.ContinueReconcile()
 }
 
+// isDataDivergent returns true if the DataConsistent condition reports that the data of a member diverges.
+func isDataDivergent(etcd *druidv1alpha1.Etcd) bool {
+	return slices.ContainsFunc(etcd.Status.Conditions, func(cond druidv1alpha1.Condition) bool {
+		return cond.Type == druidv1alpha1.ConditionTypeDataConsistent && cond.Reason == condition.MemberDataDivergent
+	})
+}
+
 func (r *Reconciler) inspectStatefulSetAndMutateETCDStatus(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, _ logr.Logger) ctrlutils.ReconcileStepResult {
 	sts, err := kubernetes.GetStatefulSet(ctx, r.client, etcd)
 	if err != nil {
@@ -100,3 +141,141 @@ func (r *Reconciler) setSelector(_ component.OperatorContext, etcd *druidv1alpha
 	etcd.Status.Selector = ptr.To(selector.String())
 	return ctrlutils.ContinueReconcile()
 }
+
+// recordBackupEncryptionKeyID records the ID of the key with which new snapshots are encrypted, so that the keys which
+// are required to restore from all snapshots can be checked. A key ID is only recorded once the spec which configures it
+// has been reconciled. The recorded key IDs are kept if encryption is disabled, as the backup store still contains the
+// snapshots which are encrypted with them, and are only cleared if the backup store is disabled.
+func (r *Reconciler) recordBackupEncryptionKeyID(_ component.OperatorContext, etcd *druidv1alpha1.Etcd, _ logr.Logger) ctrlutils.ReconcileStepResult {
+	if !etcd.IsBackupStoreEnabled() {
+		etcd.Status.BackupEncryptionKeyIDs = nil
+		return ctrlutils.ContinueReconcile()
+	}
+	encryption := etcd.Spec.Backup.Encryption
+	if encryption == nil || ptr.Deref(etcd.Status.ObservedGeneration, 0) != etcd.Generation {
+		return ctrlutils.ContinueReconcile()
+	}
+	if !slices.Contains(etcd.Status.BackupEncryptionKeyIDs, encryption.KeyID) {
+		etcd.Status.BackupEncryptionKeyIDs = append(etcd.Status.BackupEncryptionKeyIDs, encryption.KeyID)
+	}
+	return ctrlutils.ContinueReconcile()
+}
+
+// inspectSecondaryStoresAndMutateETCDStatus updates the status of the replication of snapshots to the secondary stores.
+func (r *Reconciler) inspectSecondaryStoresAndMutateETCDStatus(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, _ logr.Logger) ctrlutils.ReconcileStepResult {
+	etcd.Status.SecondaryStores = condition.CheckSecondaryStores(ctx, r.client, *etcd, metav1.Now())
+	return ctrlutils.ContinueReconcile()
+}
+
+// inspectFullSnapshotLeaseAndMutateGarbageCollectionStatus updates the number of full snapshots retained per tier by
+// the Tiered garbage collection policy from the annotation which etcd-backup-restore sets on the full snapshot lease.
+// The previous status is kept until etcd-backup-restore reports the retained snapshots.
+func (r *Reconciler) inspectFullSnapshotLeaseAndMutateGarbageCollectionStatus(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+	if !etcd.IsBackupStoreEnabled() || ptr.Deref(etcd.Spec.Backup.GarbageCollectionPolicy, "") != druidv1alpha1.GarbageCollectionPolicyTiered {
+		etcd.Status.GarbageCollection = nil
+		return ctrlutils.ContinueReconcile()
+	}
+	lease := &coordinationv1.Lease{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta), Namespace: etcd.Namespace}, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrlutils.ContinueReconcile()
+		}
+		return ctrlutils.ReconcileWithError(err)
+	}
+	value, ok := lease.Annotations[common.LeaseAnnotationKeyRetainedSnapshots]
+	if !ok {
+		return ctrlutils.ContinueReconcile()
+	}
+	retainedSnapshots, err := druidstore.ParseRetainedSnapshots(value)
+	if err != nil {
+		logger.Error(err, "retained snapshots annotation of full snapshot lease is invalid", "leaseName", lease.Name, "value", value)
+		return ctrlutils.ContinueReconcile()
+	}
+	tiers := make([]druidv1alpha1.GarbageCollectionTierStatus, 0, len(etcd.Spec.Backup.GarbageCollectionTiers))
+	for _, tier := range etcd.Spec.Backup.GarbageCollectionTiers {
+		tiers = append(tiers, druidv1alpha1.GarbageCollectionTierStatus{
+			Interval:          tier.Interval,
+			RetainedSnapshots: retainedSnapshots[tier.Interval.Duration],
+		})
+	}
+	if etcd.Status.GarbageCollection != nil && slices.Equal(etcd.Status.GarbageCollection.Tiers, tiers) {
+		return ctrlutils.ContinueReconcile()
+	}
+	etcd.Status.GarbageCollection = &druidv1alpha1.GarbageCollectionStatus{
+		Tiers:          tiers,
+		LastUpdateTime: ptr.To(metav1.Now()),
+	}
+	return ctrlutils.ContinueReconcile()
+}
+
+// inspectSnapshotLeasesAndMutateSnapshotCatalog updates the snapshot catalog with the latest full snapshot and the
+// most recent delta snapshots taken after it, as recorded by etcd-backup-restore on the snapshot leases. The previous
+// snapshot catalog is kept if the snapshots recorded on a lease are invalid.
+func (r *Reconciler) inspectSnapshotLeasesAndMutateSnapshotCatalog(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+	if !etcd.IsBackupStoreEnabled() {
+		etcd.Status.SnapshotCatalog = nil
+		return ctrlutils.ContinueReconcile()
+	}
+	var snapshotsPerLease [2][]druidv1alpha1.SnapshotInfo
+	for i, leaseName := range []string{druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta), druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta)} {
+		lease := &coordinationv1.Lease{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: leaseName, Namespace: etcd.Namespace}, lease); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return ctrlutils.ReconcileWithError(err)
+		}
+		snapshots, err := kubernetes.GetSnapshotsFromLease(lease)
+		if err != nil {
+			logger.Error(err, "failed to read snapshots from snapshot lease", "leaseName", leaseName)
+			return ctrlutils.ContinueReconcile()
+		}
+		snapshotsPerLease[i] = snapshots
+	}
+	fullSnapshots, deltaSnapshots := snapshotsPerLease[0], snapshotsPerLease[1]
+
+	catalog := &druidv1alpha1.SnapshotCatalog{}
+	if len(fullSnapshots) > 0 {
+		catalog.FullSnapshot = &fullSnapshots[len(fullSnapshots)-1]
+	}
+	for _, deltaSnapshot := range deltaSnapshots {
+		if catalog.FullSnapshot == nil || deltaSnapshot.LastRevision > catalog.FullSnapshot.LastRevision {
+			catalog.DeltaSnapshots = append(catalog.DeltaSnapshots, deltaSnapshot)
+		}
+	}
+	if len(catalog.DeltaSnapshots) > maxDeltaSnapshotsInCatalog {
+		catalog.DeltaSnapshots = catalog.DeltaSnapshots[len(catalog.DeltaSnapshots)-maxDeltaSnapshotsInCatalog:]
+	}
+	if catalog.FullSnapshot == nil && len(catalog.DeltaSnapshots) == 0 {
+		return ctrlutils.ContinueReconcile()
+	}
+	if previous := etcd.Status.SnapshotCatalog; previous != nil &&
+		apiequality.Semantic.DeepEqual(previous.FullSnapshot, catalog.FullSnapshot) &&
+		apiequality.Semantic.DeepEqual(previous.DeltaSnapshots, catalog.DeltaSnapshots) {
+		return ctrlutils.ContinueReconcile()
+	}
+	catalog.LastUpdateTime = ptr.To(metav1.Now())
+	etcd.Status.SnapshotCatalog = catalog
+	return ctrlutils.ContinueReconcile()
+}
+
+// triggerSpecReconcile records the given annotations on the Etcd and adds the reconcile annotation, so that changes which
+// have been decided while reconciling the status are rolled out by a spec reconciliation. The annotations are patched on
+// a copy, so that the status which has been computed so far is not overwritten with the status returned by the API
+// server. Only the given annotations are set on the passed Etcd, the reconcile annotation is not, since it would
+// otherwise be removed again when the current reconciliation completes.
+func (r *Reconciler) triggerSpecReconcile(ctx context.Context, etcd *druidv1alpha1.Etcd, annotations map[string]string) error {
+	withAnnotations := etcd.DeepCopy()
+	patch := client.MergeFrom(withAnnotations.DeepCopy())
+	for key, value := range annotations {
+		metav1.SetMetaDataAnnotation(&withAnnotations.ObjectMeta, key, value)
+	}
+	metav1.SetMetaDataAnnotation(&withAnnotations.ObjectMeta, druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile)
+	if err := r.client.Patch(ctx, withAnnotations, patch); err != nil {
+		return err
+	}
+	for key, value := range annotations {
+		metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, key, value)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// transitionEventBurst is the number of transition events which are emitted for the same subject, i.e. a
+	// condition, a member or the leader of an etcd cluster, before transition events of the subject are rate limited.
+	transitionEventBurst = 3
+	// transitionEventInterval is the interval in which the rate limit of transition events of a subject is refilled by one event.
+	transitionEventInterval = 10 * time.Minute
+
+	eventReasonLeaderChanged = "LeaderChanged"
+)
+
+// problemConditionTypes are the condition types which indicate a problem if their status is True.
+var problemConditionTypes = map[druidv1alpha1.ConditionType]struct{}{
+	druidv1alpha1.ConditionTypeClusterIDMismatch:         {},
+	druidv1alpha1.ConditionTypeSnapshotCompactionBackoff: {},
+}
+
+// recordStatusTransitionEvents emits an event on the Etcd for every condition whose status has changed, for every
+// member which has moved between the Ready, NotReady and Unknown statuses, and for a change of the leader, comparing
+// the original with the updated status. Events of the same subject are rate limited, so that a flapping member does
+// not flood the API server.
+func (r *Reconciler) recordStatusTransitionEvents(originalEtcd, etcd *druidv1alpha1.Etcd, logger logr.Logger) {
+	subjectPrefix := transitionEventSubjectPrefix(etcd)
+
+	originalConditions := make(map[druidv1alpha1.ConditionType]druidv1alpha1.Condition, len(originalEtcd.Status.Conditions))
+	for _, cond := range originalEtcd.Status.Conditions {
+		originalConditions[cond.Type] = cond
+	}
+	for _, cond := range etcd.Status.Conditions {
+		originalCond, ok := originalConditions[cond.Type]
+		if !ok || originalCond.Status == cond.Status {
+			continue
+		}
+		r.emitTransitionEvent(etcd, subjectPrefix+"condition/"+string(cond.Type), getConditionEventType(cond), getEventReason(cond.Reason, "ConditionChanged"),
+			fmt.Sprintf("Condition %s changed from %s to %s: %s", cond.Type, originalCond.Status, cond.Status, cond.Message), logger)
+	}
+
+	originalMembers := make(map[string]druidv1alpha1.EtcdMemberStatus, len(originalEtcd.Status.Members))
+	for _, member := range originalEtcd.Status.Members {
+		originalMembers[member.Name] = member
+	}
+	for _, member := range etcd.Status.Members {
+		originalMember, ok := originalMembers[member.Name]
+		if !ok || originalMember.Status == member.Status {
+			continue
+		}
+		eventType := corev1.EventTypeWarning
+		if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			eventType = corev1.EventTypeNormal
+		}
+		r.emitTransitionEvent(etcd, subjectPrefix+"member/"+member.Name, eventType, getEventReason(member.Reason, "MemberStatusChanged"),
+			fmt.Sprintf("Member %s changed from %s to %s", member.Name, originalMember.Status, member.Status), logger)
+	}
+
+	if originalLeaderElection, leaderElection := originalEtcd.Status.LeaderElection, etcd.Status.LeaderElection; originalLeaderElection != nil && originalLeaderElection.Leader != "" &&
+		leaderElection != nil && leaderElection.LastLeaderChangeTime != nil && !leaderElection.LastLeaderChangeTime.Equal(originalLeaderElection.LastLeaderChangeTime) {
+		message := fmt.Sprintf("Leader changed from %s to %s", originalLeaderElection.Leader, leaderElection.Leader)
+		if leaderElection.Leader == originalLeaderElection.Leader {
+			message = fmt.Sprintf("Leader %s has been re-elected", leaderElection.Leader)
+		}
+		r.emitTransitionEvent(etcd, subjectPrefix+"leader", corev1.EventTypeNormal, eventReasonLeaderChanged, message, logger)
+	}
+}
+
+func (r *Reconciler) emitTransitionEvent(etcd *druidv1alpha1.Etcd, subject, eventType, reason, message string, logger logr.Logger) {
+	if !r.transitionEventLimiter.allow(subject) {
+		logger.V(1).Info("Suppressing rate limited event", "subject", subject, "reason", reason, "message", message)
+		return
+	}
+	r.recorder.Event(etcd, eventType, reason, message)
+}
+
+// getConditionEventType returns Normal if the condition reports a healthy state and Warning otherwise.
+func getConditionEventType(cond druidv1alpha1.Condition) string {
+	_, isProblemCondition := problemConditionTypes[cond.Type]
+	if (cond.Status == druidv1alpha1.ConditionTrue) != isProblemCondition {
+		return corev1.EventTypeNormal
+	}
+	return corev1.EventTypeWarning
+}
+
+// getEventReason returns the given reason if it is set, otherwise the fallback reason.
+func getEventReason(reason, fallbackReason string) string {
+	if reason == "" {
+		return fallbackReason
+	}
+	return reason
+}
+
+func transitionEventSubjectPrefix(etcd *druidv1alpha1.Etcd) string {
+	return fmt.Sprintf("%s/%s/", etcd.Namespace, etcd.Name)
+}
+
+// transitionEventLimiter rate limits the transition events per subject with a token bucket.
+type transitionEventLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+	newFn    func() flowcontrol.RateLimiter
+}
+
+func newTransitionEventLimiter() *transitionEventLimiter {
+	return &transitionEventLimiter{
+		limiters: make(map[string]flowcontrol.RateLimiter),
+		newFn: func() flowcontrol.RateLimiter {
+			return flowcontrol.NewTokenBucketRateLimiter(float32(1/transitionEventInterval.Seconds()), transitionEventBurst)
+		},
+	}
+}
+
+// allow returns true if an event for the given subject may be emitted. All events are allowed by a nil limiter.
+func (l *transitionEventLimiter) allow(subject string) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.limiters[subject]
+	if !ok {
+		limiter = l.newFn()
+		l.limiters[subject] = limiter
+	}
+	return limiter.TryAccept()
+}
+
+// forget removes the rate limits of all subjects of the given Etcd.
+func (l *transitionEventLimiter) forget(etcd *druidv1alpha1.Etcd) {
+	if l == nil {
+		return
+	}
+	subjectPrefix := transitionEventSubjectPrefix(etcd)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for subject := range l.limiters {
+		if strings.HasPrefix(subject, subjectPrefix) {
+			delete(l.limiters, subject)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRecordStatusTransitionEvents(t *testing.T) {
+	testCases := []struct {
+		name           string
+		mutateOriginal func(etcd *druidv1alpha1.Etcd)
+		mutateUpdated  func(etcd *druidv1alpha1.Etcd)
+		expectedEvents []string
+	}{
+		{
+			name:           "unchanged status should not emit events",
+			mutateOriginal: func(_ *druidv1alpha1.Etcd) {},
+			mutateUpdated:  func(_ *druidv1alpha1.Etcd) {},
+		},
+		{
+			name:           "condition which became unhealthy should emit a warning event",
+			mutateOriginal: func(_ *druidv1alpha1.Etcd) {},
+			mutateUpdated: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Status.Conditions[0].Status = druidv1alpha1.ConditionFalse
+				etcd.Status.Conditions[0].Reason = "QuorumLost"
+				etcd.Status.Conditions[0].Message = "The majority of ETCD members is not ready"
+			},
+			expectedEvents: []string{"Warning QuorumLost Condition Ready changed from True to False: The majority of ETCD members is not ready"},
+		},
+		{
+			name: "problem condition which became False should emit a normal event",
+			mutateOriginal: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Status.Conditions[1].Status = druidv1alpha1.ConditionTrue
+			},
+			mutateUpdated: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Status.Conditions[1].Status = druidv1alpha1.ConditionFalse
+				etcd.Status.Conditions[1].Reason = "NoClusterIDMismatch"
+				etcd.Status.Conditions[1].Message = "All members have the same cluster ID"
+			},
+			expectedEvents: []string{"Normal NoClusterIDMismatch Condition ClusterIDMismatch changed from True to False: All members have the same cluster ID"},
+		},
+		{
+			name:           "member which became not ready should emit a warning event",
+			mutateOriginal: func(_ *druidv1alpha1.Etcd) {},
+			mutateUpdated: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Status.Members[0].Status = druidv1alpha1.EtcdMemberStatusNotReady
+				etcd.Status.Members[0].Reason = "LeaseExpired"
+			},
+			expectedEvents: []string{"Warning LeaseExpired Member etcd-test-0 changed from Ready to NotReady"},
+		},
+		{
+			name:           "changed leader should emit a normal event",
+			mutateOriginal: func(_ *druidv1alpha1.Etcd) {},
+			mutateUpdated: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Status.LeaderElection.Leader = "etcd-test-1"
+				etcd.Status.LeaderElection.LastLeaderChangeTime = ptr.To(metav1.Now())
+			},
+			expectedEvents: []string{"Normal LeaderChanged Leader changed from etcd-test-0 to etcd-test-1"},
+		},
+		{
+			name:           "re-elected leader should emit a normal event",
+			mutateOriginal: func(_ *druidv1alpha1.Etcd) {},
+			mutateUpdated: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Status.LeaderElection.LastLeaderChangeTime = ptr.To(metav1.Now())
+			},
+			expectedEvents: []string{"Normal LeaderChanged Leader etcd-test-0 has been re-elected"},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			originalEtcd := createEtcdWithStatus()
+			tc.mutateOriginal(originalEtcd)
+			etcd := originalEtcd.DeepCopy()
+			tc.mutateUpdated(etcd)
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{recorder: recorder, transitionEventLimiter: newTransitionEventLimiter()}
+
+			r.recordStatusTransitionEvents(originalEtcd, etcd, logr.Discard())
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			g.Expect(events).To(ConsistOf(tc.expectedEvents))
+		})
+	}
+}
+
+func TestRecordStatusTransitionEventsIsRateLimited(t *testing.T) {
+	g := NewWithT(t)
+	recorder := record.NewFakeRecorder(2 * transitionEventBurst)
+	r := &Reconciler{recorder: recorder, transitionEventLimiter: newTransitionEventLimiter()}
+	ready := createEtcdWithStatus()
+	notReady := ready.DeepCopy()
+	notReady.Status.Members[0].Status = druidv1alpha1.EtcdMemberStatusNotReady
+
+	for range transitionEventBurst {
+		r.recordStatusTransitionEvents(ready, notReady, logr.Discard())
+		r.recordStatusTransitionEvents(notReady, ready, logr.Discard())
+	}
+	g.Expect(recorder.Events).To(HaveLen(transitionEventBurst))
+
+	r.transitionEventLimiter.forget(ready)
+	r.recordStatusTransitionEvents(ready, notReady, logr.Discard())
+	g.Expect(recorder.Events).To(HaveLen(transitionEventBurst + 1))
+}
+
+func createEtcdWithStatus() *druidv1alpha1.Etcd {
+	etcd := testutils.EtcdBuilderWithoutDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+	etcd.Status.Conditions = []druidv1alpha1.Condition{
+		{Type: druidv1alpha1.ConditionTypeReady, Status: druidv1alpha1.ConditionTrue, Reason: "Quorate"},
+		{Type: druidv1alpha1.ConditionTypeClusterIDMismatch, Status: druidv1alpha1.ConditionFalse, Reason: "NoClusterIDMismatch"},
+	}
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		etcd.Status.Members = append(etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+			Name:   podName,
+			Status: druidv1alpha1.EtcdMemberStatusReady,
+			Reason: "LeaseSucceeded",
+		})
+	}
+	etcd.Status.LeaderElection = &druidv1alpha1.LeaderElectionStatus{Leader: "etcd-test-0"}
+	return etcd
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gardener/etcd-druid/internal/component"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRecordBackupEncryptionKeyID(t *testing.T) {
+	testCases := []struct {
+		name               string
+		disableEncryption  bool
+		disableBackupStore bool
+		observedGeneration *int64
+		keyIDs             []string
+		expectedKeyIDs     []string
+	}{
+		{
+			name:               "should record the key ID once the spec has been reconciled",
+			observedGeneration: ptr.To[int64](1),
+			keyIDs:             []string{"key-0"},
+			expectedKeyIDs:     []string{"key-0", "key-1"},
+		},
+		{
+			name:               "should not record the key ID as long as the spec has not been reconciled",
+			observedGeneration: ptr.To[int64](0),
+			keyIDs:             []string{"key-0"},
+			expectedKeyIDs:     []string{"key-0"},
+		},
+		{
+			name:           "should not record the key ID of a new etcd cluster before its spec has been reconciled",
+			expectedKeyIDs: nil,
+		},
+		{
+			name:               "should keep the key IDs if encryption is disabled",
+			disableEncryption:  true,
+			observedGeneration: ptr.To[int64](1),
+			keyIDs:             []string{"key-0", "key-1"},
+			expectedKeyIDs:     []string{"key-0", "key-1"},
+		},
+		{
+			name:               "should clear the key IDs if the backup store is disabled",
+			disableBackupStore: true,
+			observedGeneration: ptr.To[int64](1),
+			keyIDs:             []string{"key-0", "key-1"},
+			expectedKeyIDs:     nil,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithBackupEncryption().Build()
+			etcd.Generation = 1
+			etcd.Status.ObservedGeneration = tc.observedGeneration
+			etcd.Status.BackupEncryptionKeyIDs = tc.keyIDs
+			if tc.disableEncryption {
+				etcd.Spec.Backup.Encryption = nil
+			}
+			if tc.disableBackupStore {
+				etcd.Spec.Backup.Store = nil
+			}
+			g.Expect(etcd.IsBackupStoreEnabled()).To(Equal(!tc.disableBackupStore))
+
+			r := &Reconciler{}
+			result := r.recordBackupEncryptionKeyID(component.NewOperatorContext(context.Background(), logr.Discard(), "test-run"), etcd, logr.Discard())
+
+			g.Expect(result.HasErrors()).To(BeFalse())
+			g.Expect(etcd.Status.BackupEncryptionKeyIDs).To(Equal(tc.expectedKeyIDs))
+		})
+	}
+}
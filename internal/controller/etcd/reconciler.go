@@ -9,9 +9,11 @@ import (
 
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
 	"github.com/gardener/etcd-druid/internal/component"
 	"github.com/gardener/etcd-druid/internal/component/clientservice"
 	"github.com/gardener/etcd-druid/internal/component/configmap"
+	"github.com/gardener/etcd-druid/internal/component/etcdauth"
 	"github.com/gardener/etcd-druid/internal/component/memberlease"
 	"github.com/gardener/etcd-druid/internal/component/peerservice"
 	"github.com/gardener/etcd-druid/internal/component/poddistruptionbudget"
@@ -21,7 +23,9 @@ import (
 	"github.com/gardener/etcd-druid/internal/component/snapshotlease"
 	"github.com/gardener/etcd-druid/internal/component/statefulset"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/health/status"
 	"github.com/gardener/etcd-druid/internal/images"
+	"github.com/gardener/etcd-druid/internal/recommender"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
 
 	"github.com/go-logr/logr"
@@ -42,6 +46,14 @@ type Reconciler struct {
 	operatorRegistry  component.Registry
 	lastOpErrRecorder ctrlutils.LastOperationAndLastErrorsRecorder
 	logger            logr.Logger
+	// newMaintenanceClient creates the clients to etcd with which the status is checked and the NOSPACE alarm is disarmed.
+	newMaintenanceClient etcdclient.MaintenanceClientFactory
+	// extraChecks are the registered status checks which are enabled in addition to the built-in checks.
+	extraChecks status.ExtraChecks
+	// transitionEventLimiter rate limits the events which are emitted on transitions of the Etcd status.
+	transitionEventLimiter *transitionEventLimiter
+	// recommender recommends resources for the etcd members from their resource usage.
+	recommender *recommender.Recommender
 }
 
 // NewReconciler creates a new reconciler for Etcd.
@@ -56,16 +68,24 @@ func NewReconciler(mgr manager.Manager, config druidconfigv1alpha1.EtcdControlle
 // NewReconcilerWithImageVector creates a new reconciler for Etcd with the given image vector.
 func NewReconcilerWithImageVector(mgr manager.Manager, controllerName string, config druidconfigv1alpha1.EtcdControllerConfiguration, iv imagevector.ImageVector) (*Reconciler, error) {
 	logger := log.Log.WithName(controllerName)
+	extraChecks, err := status.NewExtraChecks(config.ExtraChecks)
+	if err != nil {
+		return nil, err
+	}
 	operatorReg := createAndInitializeOperatorRegistry(mgr.GetClient(), config, iv)
 	lastOpErrRecorder := ctrlutils.NewLastOperationAndLastErrorsRecorder(mgr.GetClient(), logger)
 	return &Reconciler{
-		client:            mgr.GetClient(),
-		config:            config,
-		recorder:          mgr.GetEventRecorderFor(controllerName),
-		imageVector:       iv,
-		logger:            logger,
-		operatorRegistry:  operatorReg,
-		lastOpErrRecorder: lastOpErrRecorder,
+		client:                 mgr.GetClient(),
+		config:                 config,
+		recorder:               mgr.GetEventRecorderFor(controllerName),
+		imageVector:            iv,
+		logger:                 logger,
+		operatorRegistry:       operatorReg,
+		lastOpErrRecorder:      lastOpErrRecorder,
+		newMaintenanceClient:   etcdclient.NewMaintenanceClient,
+		extraChecks:            extraChecks,
+		transitionEventLimiter: newTransitionEventLimiter(),
+		recommender:            recommender.New(recommender.NewMetricsAPIClient(mgr.GetClient()), config.ResourceRecommendation.HalfLife.Duration),
 	}, nil
 }
 
@@ -81,6 +101,8 @@ type reconcileFn func(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) c
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=statefulsets/status,verbs=get;watch
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;get;list
 
 // Reconcile manages the reconciliation of the Etcd component to align it with its desired specifications.
@@ -159,6 +181,7 @@ func createAndInitializeOperatorRegistry(client client.Client, config druidconfi
 	reg.Register(component.PeerServiceKind, peerservice.New(client))
 	reg.Register(component.ConfigMapKind, configmap.New(client))
 	reg.Register(component.StatefulSetKind, statefulset.New(client, imageVector))
+	reg.Register(component.EtcdAuthKind, etcdauth.New(client))
 	return reg
 }
 
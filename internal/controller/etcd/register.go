@@ -164,6 +164,8 @@ func hasLastReconcileFinished(updateEvent event.UpdateEvent) bool {
 	lastOpType := newEtcd.Status.LastOperation.Type
 	lastOpState := newEtcd.Status.LastOperation.State
 
-	return lastOpType == druidv1alpha1.LastOperationTypeReconcile &&
+	return (lastOpType == druidv1alpha1.LastOperationTypeReconcile ||
+		lastOpType == druidv1alpha1.LastOperationTypeQuotaExpansion ||
+		lastOpType == druidv1alpha1.LastOperationTypeAlarmDisarm) &&
 		lastOpState == druidv1alpha1.LastOperationStateSucceeded
 }
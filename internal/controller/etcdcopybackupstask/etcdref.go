@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// conditionReasonTargetEtcdSuspended is the reason of the TargetEtcdReconcileTriggered condition if the spec
+	// reconciliation of the target Etcd is suspended.
+	conditionReasonTargetEtcdSuspended = "TargetEtcdSuspended"
+	// conditionReasonTargetEtcdReconcileTriggered is the reason of the TargetEtcdReconcileTriggered condition once the
+	// target Etcd has been annotated for reconciliation.
+	conditionReasonTargetEtcdReconcileTriggered = "TargetEtcdReconcileTriggered"
+	// eventReasonTargetEtcdSuspended is the reason for the event emitted when the reconciliation of the target Etcd is
+	// not triggered since its spec reconciliation is suspended.
+	eventReasonTargetEtcdSuspended = "TargetEtcdSuspended"
+	// targetEtcdSuspendedRequeueInterval is the interval after which the trigger of the reconciliation of a suspended
+	// target Etcd is retried.
+	targetEtcdSuspendedRequeueInterval = time.Minute
+)
+
+// getStores returns the source and target stores of the given task. Stores of referenced Etcd resources take the place
+// of stores which are not specified explicitly. The Etcd referenced as target must be stopped, otherwise its members
+// could write to the target store while backups are being copied.
+func (r *Reconciler) getStores(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask) (sourceStore, targetStore *druidv1alpha1.StoreSpec, err error) {
+	if task.Spec.SourceEtcdRef == nil {
+		sourceStore = &task.Spec.SourceStore
+	} else {
+		sourceEtcd, err := r.getReferencedEtcd(ctx, task.Namespace, task.Spec.SourceEtcdRef)
+		if err != nil {
+			return nil, nil, err
+		}
+		sourceStore = sourceEtcd.Spec.Backup.Store
+	}
+	if sourceStore == nil {
+		return nil, nil, fmt.Errorf("no source store is configured for task %s", client.ObjectKeyFromObject(task))
+	}
+
+	if task.Spec.TargetEtcdRef == nil {
+		targetStore = &task.Spec.TargetStore
+	} else {
+		targetEtcd, err := r.getReferencedEtcd(ctx, task.Namespace, task.Spec.TargetEtcdRef)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isEtcdStopped(targetEtcd) {
+			return nil, nil, fmt.Errorf("target etcd %s is neither hibernated nor suspended before its start", client.ObjectKeyFromObject(targetEtcd))
+		}
+		targetStore = targetEtcd.Spec.Backup.Store
+	}
+	if targetStore == nil {
+		return nil, nil, fmt.Errorf("no target store is configured for task %s", client.ObjectKeyFromObject(task))
+	}
+
+	return sourceStore, targetStore, nil
+}
+
+// getReferencedBackups returns the backup specifications of the Etcd resources referenced as source and target of the
+// given task. An empty specification is returned for stores which are specified explicitly, as they carry no settings
+// such as the encryption or compression of the backups.
+func (r *Reconciler) getReferencedBackups(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask) (sourceBackup, targetBackup druidv1alpha1.BackupSpec, err error) {
+	if task.Spec.SourceEtcdRef != nil {
+		sourceEtcd, err := r.getReferencedEtcd(ctx, task.Namespace, task.Spec.SourceEtcdRef)
+		if err != nil {
+			return sourceBackup, targetBackup, err
+		}
+		sourceBackup = sourceEtcd.Spec.Backup
+	}
+	if task.Spec.TargetEtcdRef != nil {
+		targetEtcd, err := r.getReferencedEtcd(ctx, task.Namespace, task.Spec.TargetEtcdRef)
+		if err != nil {
+			return sourceBackup, targetBackup, err
+		}
+		targetBackup = targetEtcd.Spec.Backup
+	}
+	return sourceBackup, targetBackup, nil
+}
+
+// getReferencedEtcd fetches the Etcd resource referenced by the given reference and ensures that it has a backup store.
+func (r *Reconciler) getReferencedEtcd(ctx context.Context, namespace string, etcdRef *corev1.LocalObjectReference) (*druidv1alpha1.Etcd, error) {
+	etcd := &druidv1alpha1.Etcd{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: etcdRef.Name}, etcd); err != nil {
+		return nil, fmt.Errorf("could not get referenced etcd %s/%s: %w", namespace, etcdRef.Name, err)
+	}
+	if !etcd.IsBackupStoreEnabled() {
+		return nil, fmt.Errorf("referenced etcd %s has no backup store configured", client.ObjectKeyFromObject(etcd))
+	}
+	return etcd, nil
+}
+
+// isEtcdStopped checks if none of the members of the given Etcd are running and none will be started by etcd-druid.
+// This is the case if the Etcd is hibernated, or if its spec reconciliation is suspended before it has been started.
+func isEtcdStopped(etcd *druidv1alpha1.Etcd) bool {
+	if etcd.Status.CurrentReplicas != 0 || etcd.Status.ReadyReplicas != 0 {
+		return false
+	}
+	return etcd.Spec.Replicas == 0 || druidv1alpha1.GetSuspendEtcdSpecReconcileAnnotationKey(etcd.ObjectMeta) != nil
+}
+
+// triggerTargetEtcdReconcile annotates the Etcd referenced as target for reconciliation and returns true. If the spec
+// reconciliation of the target Etcd is suspended, it is not annotated and false is returned. The suspension is set by
+// the user and is therefore never lifted by the controller.
+func (r *Reconciler) triggerTargetEtcdReconcile(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask) (bool, error) {
+	etcd := &druidv1alpha1.Etcd{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: task.Namespace, Name: task.Spec.TargetEtcdRef.Name}, etcd); err != nil {
+		return false, fmt.Errorf("could not get target etcd %s/%s: %w", task.Namespace, task.Spec.TargetEtcdRef.Name, err)
+	}
+	if druidv1alpha1.GetSuspendEtcdSpecReconcileAnnotationKey(etcd.ObjectMeta) != nil {
+		return false, nil
+	}
+	patch := client.MergeFrom(etcd.DeepCopy())
+	metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile)
+	if err := r.Patch(ctx, etcd, patch); err != nil {
+		return false, fmt.Errorf("could not annotate target etcd %s for reconciliation: %w", client.ObjectKeyFromObject(etcd), err)
+	}
+	return true, nil
+}
+
+// setTargetEtcdReconcileCondition sets the condition which indicates whether the reconciliation of the target Etcd has
+// been triggered, and returns true if its status or reason has changed.
+func setTargetEtcdReconcileCondition(status *druidv1alpha1.EtcdCopyBackupsTaskStatus, conditionStatus druidv1alpha1.ConditionStatus, reason, message string) bool {
+	now := metav1.Now()
+	condition := druidv1alpha1.Condition{
+		Type:               druidv1alpha1.EtcdCopyBackupsTaskTargetEtcdReconcileTriggered,
+		Status:             conditionStatus,
+		LastTransitionTime: now,
+		LastUpdateTime:     now,
+		Reason:             reason,
+		Message:            message,
+	}
+	for i := range status.Conditions {
+		if status.Conditions[i].Type != condition.Type {
+			continue
+		}
+		changed := status.Conditions[i].Status != conditionStatus || status.Conditions[i].Reason != reason
+		if !changed {
+			condition.LastTransitionTime = status.Conditions[i].LastTransitionTime
+		}
+		status.Conditions[i] = condition
+		return changed
+	}
+	status.Conditions = append(status.Conditions, condition)
+	return true
+}
+
+// getTargetEtcdReconcileCondition returns the condition which indicates whether the reconciliation of the target Etcd
+// has been triggered, or nil if there is none.
+func getTargetEtcdReconcileCondition(conditions []druidv1alpha1.Condition) *druidv1alpha1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == druidv1alpha1.EtcdCopyBackupsTaskTargetEtcdReconcileTriggered {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// isTargetEtcdReconcileSuspended checks if the trigger of the reconciliation of the target Etcd has been skipped
+// because its spec reconciliation is suspended.
+func isTargetEtcdReconcileSuspended(status *druidv1alpha1.EtcdCopyBackupsTaskStatus) bool {
+	condition := getTargetEtcdReconcileCondition(status.Conditions)
+	return status.TargetEtcdReconcileTime == nil && condition != nil && condition.Reason == conditionReasonTargetEtcdSuspended
+}
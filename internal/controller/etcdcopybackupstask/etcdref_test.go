@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"context"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("EtcdReferences", func() {
+	const (
+		testTaskName       = "test-task"
+		testNamespace      = "test-ns"
+		testSourceEtcdName = "source-etcd"
+		testTargetEtcdName = "target-etcd"
+	)
+	var (
+		ctx        = context.Background()
+		task       *druidv1alpha1.EtcdCopyBackupsTask
+		sourceEtcd *druidv1alpha1.Etcd
+		targetEtcd *druidv1alpha1.Etcd
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		r          *Reconciler
+	)
+
+	BeforeEach(func() {
+		task = testutils.CreateEtcdCopyBackupsTask(testTaskName, testNamespace, "aws", false)
+		task.Spec.SourceStore = druidv1alpha1.StoreSpec{}
+		task.Spec.TargetStore = druidv1alpha1.StoreSpec{}
+		task.Spec.SourceEtcdRef = &corev1.LocalObjectReference{Name: testSourceEtcdName}
+		task.Spec.TargetEtcdRef = &corev1.LocalObjectReference{Name: testTargetEtcdName}
+		sourceEtcd = testutils.EtcdBuilderWithDefaults(testSourceEtcdName, testNamespace).WithProviderS3("source-prefix").Build()
+		targetEtcd = testutils.EtcdBuilderWithDefaults(testTargetEtcdName, testNamespace).WithProviderS3("target-prefix").WithReplicas(0).Build()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(sourceEtcd, targetEtcd).Build()
+		recorder = record.NewFakeRecorder(10)
+		r = &Reconciler{
+			Client:   fakeClient,
+			recorder: recorder,
+			logger:   logr.Discard(),
+		}
+	})
+
+	Describe("#getStores", func() {
+		It("should return the stores of the referenced etcds", func() {
+			sourceStore, targetStore, err := r.getStores(ctx, task)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourceStore).To(Equal(sourceEtcd.Spec.Backup.Store))
+			Expect(targetStore).To(Equal(targetEtcd.Spec.Backup.Store))
+		})
+
+		It("should use explicitly specified stores if no Etcd is referenced", func() {
+			task.Spec.SourceEtcdRef = nil
+			task.Spec.SourceStore = druidv1alpha1.StoreSpec{Prefix: "explicit-prefix"}
+			sourceStore, _, err := r.getStores(ctx, task)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourceStore).To(Equal(&task.Spec.SourceStore))
+		})
+
+		It("should return an error if a referenced etcd does not exist", func() {
+			task.Spec.SourceEtcdRef.Name = "non-existent"
+			_, _, err := r.getStores(ctx, task)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if a referenced etcd has no backup store", func() {
+			sourceEtcd.Spec.Backup.Store = nil
+			Expect(fakeClient.Update(ctx, sourceEtcd)).To(Succeed())
+			_, _, err := r.getStores(ctx, task)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the target etcd is running", func() {
+			targetEtcd.Spec.Replicas = 3
+			Expect(fakeClient.Update(ctx, targetEtcd)).To(Succeed())
+			_, _, err := r.getStores(ctx, task)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#getReferencedBackups", func() {
+		It("should return the backup specifications of the referenced etcds", func() {
+			sourceEtcd.Spec.Backup.Encryption = &druidv1alpha1.EncryptionSpec{
+				KeyID:     "source-key",
+				SecretRef: &corev1.LocalObjectReference{Name: "source-encryption"},
+			}
+			Expect(fakeClient.Update(ctx, sourceEtcd)).To(Succeed())
+			sourceBackup, targetBackup, err := r.getReferencedBackups(ctx, task)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourceBackup).To(Equal(sourceEtcd.Spec.Backup))
+			Expect(targetBackup).To(Equal(targetEtcd.Spec.Backup))
+		})
+
+		It("should return empty backup specifications for explicitly specified stores", func() {
+			task.Spec.SourceEtcdRef = nil
+			task.Spec.TargetEtcdRef = nil
+			sourceBackup, targetBackup, err := r.getReferencedBackups(ctx, task)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourceBackup).To(BeZero())
+			Expect(targetBackup).To(BeZero())
+		})
+	})
+
+	Describe("#isEtcdStopped", func() {
+		It("should return true for a hibernated etcd", func() {
+			Expect(isEtcdStopped(targetEtcd)).To(BeTrue())
+		})
+
+		It("should return true for an etcd whose reconciliation is suspended before its start", func() {
+			targetEtcd.Spec.Replicas = 3
+			targetEtcd.Annotations = map[string]string{druidv1alpha1.SuspendEtcdSpecReconcileAnnotation: ""}
+			Expect(isEtcdStopped(targetEtcd)).To(BeTrue())
+		})
+
+		It("should return false for an etcd which still has running members", func() {
+			targetEtcd.Status.CurrentReplicas = 1
+			Expect(isEtcdStopped(targetEtcd)).To(BeFalse())
+		})
+
+		It("should return false for an etcd which is going to be started", func() {
+			targetEtcd.Spec.Replicas = 3
+			Expect(isEtcdStopped(targetEtcd)).To(BeFalse())
+		})
+	})
+
+	Describe("#triggerTargetEtcdReconcile", func() {
+		It("should annotate the target etcd for reconciliation", func() {
+			triggered, err := r.triggerTargetEtcdReconcile(ctx, task)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(triggered).To(BeTrue())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(targetEtcd), targetEtcd)).To(Succeed())
+			Expect(targetEtcd.Annotations).To(HaveKeyWithValue(druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile))
+		})
+
+		It("should neither annotate the target etcd nor remove its suspension if its spec reconciliation is suspended", func() {
+			targetEtcd.Annotations = map[string]string{druidv1alpha1.SuspendEtcdSpecReconcileAnnotation: ""}
+			Expect(fakeClient.Update(ctx, targetEtcd)).To(Succeed())
+
+			triggered, err := r.triggerTargetEtcdReconcile(ctx, task)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(triggered).To(BeFalse())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(targetEtcd), targetEtcd)).To(Succeed())
+			Expect(targetEtcd.Annotations).To(HaveKey(druidv1alpha1.SuspendEtcdSpecReconcileAnnotation))
+			Expect(targetEtcd.Annotations).ToNot(HaveKey(druidv1alpha1.DruidOperationAnnotation))
+		})
+	})
+
+	Describe("#doReconcile", func() {
+		BeforeEach(func() {
+			task.Spec.TriggerTargetEtcdReconcile = true
+			job := testutils.CreateEtcdCopyBackupsJob(testTaskName, testNamespace)
+			Expect(fakeClient.Create(ctx, job)).To(Succeed())
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+			Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+		})
+
+		It("should trigger the reconciliation of the target etcd once the copy job has completed", func() {
+			status, err := r.doReconcile(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.TargetEtcdReconcileTime).ToNot(BeNil())
+			Expect(getTargetEtcdReconcileCondition(status.Conditions)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(druidv1alpha1.ConditionTrue),
+			})))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(targetEtcd), targetEtcd)).To(Succeed())
+			Expect(targetEtcd.Annotations).To(HaveKeyWithValue(druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile))
+		})
+
+		It("should skip the trigger and report it while the target etcd is suspended", func() {
+			targetEtcd.Annotations = map[string]string{druidv1alpha1.SuspendEtcdSpecReconcileAnnotation: ""}
+			Expect(fakeClient.Update(ctx, targetEtcd)).To(Succeed())
+
+			status, err := r.doReconcile(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.TargetEtcdReconcileTime).To(BeNil())
+			Expect(isTargetEtcdReconcileSuspended(status)).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring(eventReasonTargetEtcdSuspended)))
+
+			task.Status = *status
+			status, err = r.doReconcile(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isTargetEtcdReconcileSuspended(status)).To(BeTrue())
+			Expect(recorder.Events).ToNot(Receive())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(targetEtcd), targetEtcd)).To(Succeed())
+			Expect(targetEtcd.Annotations).To(HaveKey(druidv1alpha1.SuspendEtcdSpecReconcileAnnotation))
+			delete(targetEtcd.Annotations, druidv1alpha1.SuspendEtcdSpecReconcileAnnotation)
+			Expect(fakeClient.Update(ctx, targetEtcd)).To(Succeed())
+
+			task.Status = *status
+			status, err = r.doReconcile(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.TargetEtcdReconcileTime).ToNot(BeNil())
+			Expect(isTargetEtcdReconcileSuspended(status)).To(BeFalse())
+		})
+
+		It("should not trigger the reconciliation of the target etcd again", func() {
+			status, err := r.doReconcile(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			task.Status = *status
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(targetEtcd), targetEtcd)).To(Succeed())
+			targetEtcd.Annotations = nil
+			Expect(fakeClient.Update(ctx, targetEtcd)).To(Succeed())
+			_, err = r.doReconcile(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(targetEtcd), targetEtcd)).To(Succeed())
+			Expect(targetEtcd.Annotations).ToNot(HaveKey(druidv1alpha1.DruidOperationAnnotation))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespaceEtcdDruid           = "etcddruid"
+	subsystemEtcdCopyBackupsTask = "etcdcopybackupstask"
+
+	labelTaskNamespace = "task_namespace"
+	labelTaskName      = "task_name"
+)
+
+// metricTimeSinceLastSuccess is the metric used to expose the time elapsed since the start of the last successful copy
+// job of recurring EtcdCopyBackupsTasks. It is computed at the time of collection, so that it keeps increasing while no
+// copy job succeeds.
+var metricTimeSinceLastSuccess = newTimeSinceLastSuccessCollector()
+
+// timeSinceLastSuccessCollector is a prometheus.Collector which exposes the time elapsed since the start of the last
+// successful copy job of every recurring EtcdCopyBackupsTask.
+type timeSinceLastSuccessCollector struct {
+	desc                *prometheus.Desc
+	mu                  sync.RWMutex
+	lastSuccessfulTimes map[types.NamespacedName]time.Time
+}
+
+func newTimeSinceLastSuccessCollector() *timeSinceLastSuccessCollector {
+	return &timeSinceLastSuccessCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespaceEtcdDruid, subsystemEtcdCopyBackupsTask, "seconds_since_last_success"),
+			"Time in seconds since the start of the last successful copy job of a recurring EtcdCopyBackupsTask.",
+			[]string{labelTaskNamespace, labelTaskName},
+			nil,
+		),
+		lastSuccessfulTimes: make(map[types.NamespacedName]time.Time),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *timeSinceLastSuccessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *timeSinceLastSuccessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for key, lastSuccessfulTime := range c.lastSuccessfulTimes {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, time.Since(lastSuccessfulTime).Seconds(), key.Namespace, key.Name)
+	}
+}
+
+func (c *timeSinceLastSuccessCollector) set(key types.NamespacedName, lastSuccessfulTime time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastSuccessfulTimes[key] = lastSuccessfulTime
+}
+
+func (c *timeSinceLastSuccessCollector) delete(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.lastSuccessfulTimes, key)
+}
+
+func init() {
+	metrics.Registry.MustRegister(metricTimeSinceLastSuccess)
+}
@@ -7,6 +7,7 @@ package etcdcopybackupstask
 import (
 	"context"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/common"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	"github.com/gardener/etcd-druid/internal/images"
 	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
@@ -25,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,7 +37,25 @@ import (
 )
 
 const (
-	sourcePrefix = "source-"
+	sourcePrefix      = "source-"
+	copyContainerName = "copy-backups"
+)
+
+const (
+	// ErrGetJob indicates an error in getting the copy job.
+	ErrGetJob druidapicommon.ErrorCode = "ERR_GET_COPY_JOB"
+	// ErrCreateJob indicates an error in creating the copy job.
+	ErrCreateJob druidapicommon.ErrorCode = "ERR_CREATE_COPY_JOB"
+	// ErrDeleteJob indicates an error in deleting the copy job.
+	ErrDeleteJob druidapicommon.ErrorCode = "ERR_DELETE_COPY_JOB"
+	// ErrGetCopyResult indicates an error in getting the result reported by the copy job.
+	ErrGetCopyResult druidapicommon.ErrorCode = "ERR_GET_COPY_RESULT"
+	// ErrParseSchedule indicates an error in parsing the schedule of a recurring task.
+	ErrParseSchedule druidapicommon.ErrorCode = "ERR_PARSE_SCHEDULE"
+	// ErrTriggerTargetEtcdReconcile indicates an error in triggering the reconciliation of the target Etcd.
+	ErrTriggerTargetEtcdReconcile druidapicommon.ErrorCode = "ERR_TRIGGER_TARGET_ETCD_RECONCILE"
+	// ErrCopyBackups indicates that the copy job has failed to copy the backups.
+	ErrCopyBackups druidapicommon.ErrorCode = "ERR_COPY_BACKUPS"
 )
 
 // Reconciler reconciles EtcdCopyBackupsTask object.
@@ -42,11 +63,14 @@ type Reconciler struct {
 	client.Client
 	Config      druidconfigv1alpha1.EtcdCopyBackupsTaskControllerConfiguration
 	imageVector imagevector.ImageVector
+	recorder    record.EventRecorder
 	logger      logr.Logger
 }
 
 // +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcdcopybackupstasks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcdcopybackupstasks/status;etcdcopybackupstasks/finalizers,verbs=get;update;patch;create
+// +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcds,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // NewReconciler creates a new reconciler for EtcdCopyBackupsTask.
 func NewReconciler(mgr manager.Manager, config druidconfigv1alpha1.EtcdCopyBackupsTaskControllerConfiguration) (*Reconciler, error) {
@@ -64,6 +88,7 @@ func NewReconcilerWithImageVector(mgr manager.Manager, config druidconfigv1alpha
 		Client:      mgr.GetClient(),
 		Config:      config,
 		imageVector: imageVector,
+		recorder:    mgr.GetEventRecorderFor(controllerName),
 		logger:      log.Log.WithName("etcd-copy-backups-task-controller"),
 	}
 }
@@ -105,12 +130,20 @@ func (r *Reconciler) reconcile(ctx context.Context, task *druidv1alpha1.EtcdCopy
 
 	// Reconcile creation or update
 	logger.V(1).Info("Reconciling creation or update for etcd-copy-backups-task", "name", task.Name, "namespace", task.Namespace)
-	if status, err = r.doReconcile(ctx, task, logger); err != nil {
+	if task.IsRecurring() {
+		status, result, err = r.doReconcileRecurring(ctx, task, logger)
+	} else {
+		status, err = r.doReconcile(ctx, task, logger)
+		if err == nil && isTargetEtcdReconcileSuspended(status) {
+			result = ctrl.Result{RequeueAfter: targetEtcdSuspendedRequeueInterval}
+		}
+	}
+	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("could not reconcile creation or update: %w", err)
 	}
 	logger.V(1).Info("Creation or update reconciled for etcd-copy-backups-task", "name", task.Name, "namespace", task.Namespace)
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 func (r *Reconciler) doReconcile(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask, logger logr.Logger) (status *druidv1alpha1.EtcdCopyBackupsTaskStatus, err error) {
@@ -124,22 +157,34 @@ func (r *Reconciler) doReconcile(ctx context.Context, task *druidv1alpha1.EtcdCo
 	// Get job from cluster
 	job, err = r.getJob(ctx, task)
 	if err != nil {
-		return status, err
+		return status, druiderr.WrapError(err, ErrGetJob, string(druidv1alpha1.LastOperationTypeReconcile), "could not get copy job")
 	}
 	if job != nil {
+		if isJobFinished(job) {
+			result, copyErr, err := r.getCopyResult(ctx, job)
+			if err != nil {
+				return status, druiderr.WrapError(err, ErrGetCopyResult, string(druidv1alpha1.LastOperationTypeReconcile), "could not get result of copy job")
+			}
+			setCopyResult(status, result, copyErr)
+		}
+		if task.Spec.TriggerTargetEtcdReconcile && status.TargetEtcdReconcileTime == nil && getJobCondition(job, batchv1.JobComplete) != nil {
+			if err = r.handleTargetEtcdReconcile(ctx, task, status, logger); err != nil {
+				return status, druiderr.WrapError(err, ErrTriggerTargetEtcdReconcile, string(druidv1alpha1.LastOperationTypeReconcile), "could not trigger reconciliation of target etcd")
+			}
+		}
 		return status, nil
 	}
 
 	// create a job object from task
 	job, err = r.createJobObject(ctx, task)
 	if err != nil {
-		return status, err
+		return status, druiderr.WrapError(err, ErrCreateJob, string(druidv1alpha1.LastOperationTypeReconcile), "could not create copy job object")
 	}
 
 	// Create job
 	logger.Info("Creating job", "namespace", job.Namespace, "name", job.Name)
 	if err := r.Create(ctx, job); err != nil {
-		return status, fmt.Errorf("could not create job %s: %w", client.ObjectKeyFromObject(job), err)
+		return status, druiderr.WrapError(err, ErrCreateJob, string(druidv1alpha1.LastOperationTypeReconcile), fmt.Sprintf("could not create job %s", client.ObjectKeyFromObject(job)))
 	}
 
 	return status, nil
@@ -179,6 +224,7 @@ func (r *Reconciler) delete(ctx context.Context, task *druidv1alpha1.EtcdCopyBac
 		if err := kubernetes.RemoveFinalizers(ctx, r.Client, task, druidapicommon.EtcdFinalizerName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("could not remove finalizer: %w", err)
 		}
+		metricTimeSinceLastSuccess.delete(client.ObjectKeyFromObject(task))
 	}
 
 	return ctrl.Result{}, nil
@@ -195,7 +241,7 @@ func (r *Reconciler) doDelete(ctx context.Context, task *druidv1alpha1.EtcdCopyB
 	// Get job from cluster
 	job, err = r.getJob(ctx, task)
 	if err != nil {
-		return status, false, err
+		return status, false, druiderr.WrapError(err, ErrGetJob, string(druidv1alpha1.LastOperationTypeDelete), "could not get copy job")
 	}
 	if job == nil {
 		return status, true, nil
@@ -205,13 +251,34 @@ func (r *Reconciler) doDelete(ctx context.Context, task *druidv1alpha1.EtcdCopyB
 	if job.DeletionTimestamp == nil {
 		logger.Info("Deleting job", "namespace", job.Namespace, "name", job.Name)
 		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
-			return status, false, fmt.Errorf("could not delete job %s: %w", client.ObjectKeyFromObject(job), err)
+			return status, false, druiderr.WrapError(err, ErrDeleteJob, string(druidv1alpha1.LastOperationTypeDelete), fmt.Sprintf("could not delete job %s", client.ObjectKeyFromObject(job)))
 		}
 	}
 
 	return status, false, nil
 }
 
+// handleTargetEtcdReconcile triggers the reconciliation of the target Etcd and records the outcome in the given status.
+// If the spec reconciliation of the target Etcd is suspended, the trigger is skipped and a warning event is emitted.
+func (r *Reconciler) handleTargetEtcdReconcile(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask, status *druidv1alpha1.EtcdCopyBackupsTaskStatus, logger logr.Logger) error {
+	triggered, err := r.triggerTargetEtcdReconcile(ctx, task)
+	if err != nil {
+		return err
+	}
+	if !triggered {
+		message := fmt.Sprintf("Reconciliation of target etcd %s is not triggered since its spec reconciliation is suspended by the %s annotation", task.Spec.TargetEtcdRef.Name, druidv1alpha1.SuspendEtcdSpecReconcileAnnotation)
+		logger.Info("Skipping reconciliation of suspended target etcd", "namespace", task.Namespace, "name", task.Spec.TargetEtcdRef.Name)
+		if setTargetEtcdReconcileCondition(status, druidv1alpha1.ConditionFalse, conditionReasonTargetEtcdSuspended, message) {
+			r.recorder.Event(task, corev1.EventTypeWarning, eventReasonTargetEtcdSuspended, message)
+		}
+		return nil
+	}
+	logger.Info("Triggered reconciliation of target etcd", "namespace", task.Namespace, "name", task.Spec.TargetEtcdRef.Name)
+	status.TargetEtcdReconcileTime = ptr.To(metav1.Now())
+	setTargetEtcdReconcileCondition(status, druidv1alpha1.ConditionTrue, conditionReasonTargetEtcdReconcileTriggered, fmt.Sprintf("Target etcd %s has been annotated for reconciliation", task.Spec.TargetEtcdRef.Name))
+	return nil
+}
+
 func (r *Reconciler) getJob(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask) (*batchv1.Job, error) {
 	job := &batchv1.Job{}
 	if err := r.Get(ctx, client.ObjectKey{Namespace: task.Namespace, Name: task.GetJobName()}, job); err != nil {
@@ -235,15 +302,15 @@ func (r *Reconciler) updateStatus(ctx context.Context, task *druidv1alpha1.EtcdC
 func setStatusDetails(status *druidv1alpha1.EtcdCopyBackupsTaskStatus, generation int64, job *batchv1.Job, err error) {
 	status.ObservedGeneration = &generation
 	if job != nil {
-		status.Conditions = getConditions(job.Status.Conditions)
+		conditions := getConditions(job.Status.Conditions)
+		if condition := getTargetEtcdReconcileCondition(status.Conditions); condition != nil {
+			conditions = append(conditions, *condition)
+		}
+		status.Conditions = conditions
 	} else {
 		status.Conditions = nil
 	}
-	if err != nil {
-		status.LastError = ptr.To(err.Error())
-	} else {
-		status.LastError = nil
-	}
+	setLastErrors(status, err)
 }
 
 func getConditions(jobConditions []batchv1.JobCondition) []druidv1alpha1.Condition {
@@ -284,37 +351,65 @@ func (r *Reconciler) createJobObject(ctx context.Context, task *druidv1alpha1.Et
 		return nil, err
 	}
 
-	targetStore := task.Spec.TargetStore
+	sourceStore, targetStore, err := r.getStores(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceBackup, targetBackup, err := r.getReferencedBackups(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if task.Spec.TargetEtcdRef != nil {
+		if err = druidstore.CheckCompressionSupported(targetBackup.SnapshotCompression, *etcdBackupImage); err != nil {
+			return nil, err
+		}
+	}
+	for _, encryption := range []*druidv1alpha1.EncryptionSpec{sourceBackup.Encryption, targetBackup.Encryption} {
+		if err = druidstore.CheckEncryptionSupported(encryption, *etcdBackupImage); err != nil {
+			return nil, err
+		}
+	}
+
 	targetProvider, err := druidstore.StorageProviderFromInfraProvider(targetStore.Provider)
 	if err != nil {
 		return nil, err
 	}
 
-	sourceStore := task.Spec.SourceStore
 	sourceProvider, err := druidstore.StorageProviderFromInfraProvider(sourceStore.Provider)
 	if err != nil {
 		return nil, err
 	}
 
 	// Formulate the job's arguments.
-	args := createJobArgs(task, sourceProvider, targetProvider)
+	args := createJobArgs(task, sourceStore, targetStore, sourceProvider, targetProvider)
+	if task.Spec.TargetEtcdRef != nil {
+		// Snapshots written to the target store are compressed in the same way as by the target etcd cluster itself.
+		args = append(args, druidstore.GetCompressionArgs(targetBackup.SnapshotCompression)...)
+	}
+	// Recurring tasks only copy the snapshots taken after the most recent snapshot which has been copied before.
+	if revision := getLastCopiedRevision(task); revision != nil && supportsCopyResult(*etcdBackupImage) {
+		args = append(args, "--start-after-revision="+strconv.FormatInt(*revision, 10))
+	}
+	args = append(args, druidstore.GetEncryptionArgs(targetBackup.Encryption, "", getEncryptionVolumeMountPathWithPrefix(""))...)
+	args = append(args, druidstore.GetEncryptionArgs(sourceBackup.Encryption, sourcePrefix, getEncryptionVolumeMountPathWithPrefix(sourcePrefix))...)
 
 	// Formulate the job environment variables.
-	env := append(createEnvVarsFromStore(&sourceStore, sourceProvider, "SOURCE_", sourcePrefix), createEnvVarsFromStore(&targetStore, targetProvider, "", "")...)
+	env := append(createEnvVarsFromStore(sourceStore, sourceProvider, "SOURCE_", sourcePrefix), createEnvVarsFromStore(targetStore, targetProvider, "", "")...)
 
 	// Formulate the job's volume mounts.
 	volumeMounts := append(
-		createVolumeMountsFromStore(&sourceStore, sourceProvider, sourcePrefix),
-		createVolumeMountsFromStore(&targetStore, targetProvider, "")...)
+		createVolumeMountsFromStore(sourceStore, sourceProvider, sourcePrefix),
+		createVolumeMountsFromStore(targetStore, targetProvider, "")...)
 
 	// Formulate the job's volumes from the source store.
-	sourceVolumes, err := r.createVolumesFromStore(ctx, &sourceStore, task.Namespace, sourceProvider, sourcePrefix)
+	sourceVolumes, err := r.createVolumesFromStore(ctx, sourceStore, task.Namespace, sourceProvider, sourcePrefix)
 	if err != nil {
 		return nil, err
 	}
 
 	// Formulate the job's volumes from the target store.
-	targetVolumes, err := r.createVolumesFromStore(ctx, &targetStore, task.Namespace, targetProvider, "")
+	targetVolumes, err := r.createVolumesFromStore(ctx, targetStore, task.Namespace, targetProvider, "")
 	if err != nil {
 		return nil, err
 	}
@@ -322,6 +417,16 @@ func (r *Reconciler) createJobObject(ctx context.Context, task *druidv1alpha1.Et
 	// Combine the source and target volumes.
 	volumes := append(sourceVolumes, targetVolumes...)
 
+	// Add the volumes and volume mounts for the encryption of the source and target backups.
+	for prefix, encryption := range map[string]*druidv1alpha1.EncryptionSpec{sourcePrefix: sourceBackup.Encryption, "": targetBackup.Encryption} {
+		if volume := druidstore.GetEncryptionVolume(encryption, prefix+common.VolumeNameBackupEncryption); volume != nil {
+			volumes = append(volumes, *volume)
+		}
+		if volumeMount := druidstore.GetEncryptionVolumeMount(encryption, prefix+common.VolumeNameBackupEncryption, getEncryptionVolumeMountPathWithPrefix(prefix)); volumeMount != nil {
+			volumeMounts = append(volumeMounts, *volumeMount)
+		}
+	}
+
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      task.GetJobName(),
@@ -337,17 +442,21 @@ func (r *Reconciler) createJobObject(ctx context.Context, task *druidv1alpha1.Et
 					RestartPolicy: corev1.RestartPolicyOnFailure,
 					Containers: []corev1.Container{
 						{
-							Name:            "copy-backups",
+							Name:            copyContainerName,
 							Image:           *etcdBackupImage,
 							ImagePullPolicy: corev1.PullIfNotPresent,
-							Args:            args,
-							Env:             env,
-							VolumeMounts:    volumeMounts,
+							// Versions of etcd-backup-restore which do not report the result of copying backups in the
+							// termination message leave it empty, in that case the tail of the logs is used for failures.
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							Args:                     args,
+							Env:                      env,
+							VolumeMounts:             volumeMounts,
 							SecurityContext: &corev1.SecurityContext{
 								AllowPrivilegeEscalation: ptr.To(false),
 							},
 						},
 					},
+					ServiceAccountName:    task.Spec.ServiceAccountName,
 					ShareProcessNamespace: ptr.To(true),
 					Volumes:               volumes,
 				},
@@ -400,7 +509,7 @@ func getPodLabels(task *druidv1alpha1.EtcdCopyBackupsTask) map[string]string {
 	return utils.MergeMaps(getCommonLabels(task), task.Spec.PodLabels)
 }
 
-func createJobArgs(task *druidv1alpha1.EtcdCopyBackupsTask, sourceObjStoreProvider string, targetObjStoreProvider string) []string {
+func createJobArgs(task *druidv1alpha1.EtcdCopyBackupsTask, sourceStore, targetStore *druidv1alpha1.StoreSpec, sourceObjStoreProvider string, targetObjStoreProvider string) []string {
 	// Create the initial arguments for the copy-backups job.
 	args := []string{
 		"copy",
@@ -408,8 +517,8 @@ func createJobArgs(task *druidv1alpha1.EtcdCopyBackupsTask, sourceObjStoreProvid
 	}
 
 	// Formulate the job's arguments.
-	args = append(args, createJobArgumentFromStore(&task.Spec.TargetStore, targetObjStoreProvider, "")...)
-	args = append(args, createJobArgumentFromStore(&task.Spec.SourceStore, sourceObjStoreProvider, sourcePrefix)...)
+	args = append(args, createJobArgumentFromStore(targetStore, targetObjStoreProvider, "")...)
+	args = append(args, createJobArgumentFromStore(sourceStore, sourceObjStoreProvider, sourcePrefix)...)
 	if task.Spec.MaxBackupAge != nil {
 		args = append(args, "--max-backup-age="+strconv.Itoa(int(*task.Spec.MaxBackupAge)))
 	}
@@ -457,6 +566,9 @@ func (r *Reconciler) createVolumesFromStore(ctx context.Context, store *druidv1a
 			},
 		})
 	case druidstore.GCS, druidstore.S3, druidstore.ABS, druidstore.Swift, druidstore.OCS, druidstore.OSS:
+		if !druidstore.UsesSecretCredentials(store) {
+			break
+		}
 		if store.SecretRef == nil {
 			err = fmt.Errorf("no secretRef is configured for backup %sstore", prefix)
 			return
@@ -472,6 +584,9 @@ func (r *Reconciler) createVolumesFromStore(ctx context.Context, store *druidv1a
 		})
 
 	}
+	if tokenVolume := druidstore.GetWorkloadIdentityTokenVolume(store, provider, getVolumeNamePrefix(prefix)+common.VolumeNameWorkloadIdentityToken); tokenVolume != nil {
+		volumes = append(volumes, *tokenVolume)
+	}
 	return
 }
 
@@ -486,19 +601,40 @@ func createVolumeMountsFromStore(store *druidv1alpha1.StoreSpec, provider, volum
 			MountPath: "/home/nonroot/" + *store.Container,
 		})
 	case druidstore.GCS:
-		volumeMounts = append(volumeMounts, corev1.VolumeMount{
-			Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameProviderBackupSecret,
-			MountPath: getGCSSecretVolumeMountPathWithPrefixAndSuffix(getVolumeNamePrefix(volumeMountPrefix), "/"),
-		})
+		if druidstore.UsesSecretCredentials(store) {
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameProviderBackupSecret,
+				MountPath: getGCSSecretVolumeMountPathWithPrefixAndSuffix(getVolumeNamePrefix(volumeMountPrefix), "/"),
+			})
+		}
 	case druidstore.S3, druidstore.ABS, druidstore.Swift, druidstore.OCS, druidstore.OSS:
+		if druidstore.UsesSecretCredentials(store) {
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameProviderBackupSecret,
+				MountPath: getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumeMountPrefix, "/"),
+			})
+		}
+	}
+	if druidstore.GetWorkloadIdentityTokenAudience(store, provider) != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
-			Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameProviderBackupSecret,
-			MountPath: getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumeMountPrefix, "/"),
+			Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameWorkloadIdentityToken,
+			MountPath: getWorkloadIdentityTokenVolumeMountPathWithPrefix(getVolumeNamePrefix(volumeMountPrefix)),
+			ReadOnly:  true,
 		})
 	}
 	return
 }
 
+func getWorkloadIdentityTokenVolumeMountPathWithPrefix(volumePrefix string) string {
+	// "/var/run/secrets/druid.gardener.cloud/<volumePrefix>workload-identity"
+	return path.Join(path.Dir(common.VolumeMountPathWorkloadIdentityToken), volumePrefix+path.Base(common.VolumeMountPathWorkloadIdentityToken))
+}
+
+func getEncryptionVolumeMountPathWithPrefix(volumePrefix string) string {
+	// "/var/etcdbr/<volumePrefix>encryption"
+	return path.Join(path.Dir(common.VolumeMountPathBackupEncryption), volumePrefix+path.Base(common.VolumeMountPathBackupEncryption))
+}
+
 func getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, volumeSuffix string) string {
 	// "/var/<volumePrefix>etcd-backup<volumeSuffix>"
 	tokens := strings.Split(strings.Trim(common.VolumeMountPathNonGCSProviderBackupSecret, "/"), "/")
@@ -517,6 +653,10 @@ func getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, volumeSuffix s
 // environment variables include storage container information and provider-specific credentials.
 func createEnvVarsFromStore(store *druidv1alpha1.StoreSpec, storeProvider, envKeyPrefix, volumePrefix string) (envVars []corev1.EnvVar) {
 	envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvStorageContainer, *store.Container))
+	envVars = append(envVars, druidstore.GetWorkloadIdentityEnvVars(store, storeProvider, envKeyPrefix, getWorkloadIdentityTokenVolumeMountPathWithPrefix(getVolumeNamePrefix(volumePrefix)))...)
+	if !druidstore.UsesSecretCredentials(store) {
+		return envVars
+	}
 	switch storeProvider {
 	case druidstore.S3:
 		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAWSApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
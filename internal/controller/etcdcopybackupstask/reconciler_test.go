@@ -236,6 +236,30 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 			})
 		})
 
+		Context("when a recurring task has copied snapshots before", func() {
+			var task *druidv1alpha1.EtcdCopyBackupsTask
+
+			BeforeEach(func() {
+				task = testutils.CreateEtcdCopyBackupsTask("test-recurring", namespace, "Local", false)
+				task.Spec.Schedule = ptr.To("0 * * * *")
+				task.Status.LastCopiedSnapshot = &druidv1alpha1.EtcdCopyBackupsTaskSnapshot{Revision: 42, Timestamp: metav1.Now()}
+				Expect(testutils.CreateSecrets(ctx, fakeClient, task.Namespace, task.Spec.SourceStore.SecretRef.Name, task.Spec.TargetStore.SecretRef.Name)).To(BeNil())
+			})
+
+			It("should only copy the snapshots taken after the last copied snapshot", func() {
+				reconciler.imageVector[0].Tag = ptr.To("v0.43.0")
+				job, err := reconciler.createJobObject(ctx, task)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--start-after-revision=42"))
+			})
+
+			It("should copy all snapshots if the image does not support it", func() {
+				job, err := reconciler.createJobObject(ctx, task)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job.Spec.Template.Spec.Containers[0].Args).ToNot(ContainElement(HavePrefix("--start-after-revision=")))
+			})
+		})
+
 		Context("when source store provider is unknown", func() {
 			It("should return error", func() {
 				task := testutils.CreateEtcdCopyBackupsTask("test", namespace, "Local", true)
@@ -360,19 +384,19 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 		})
 
 		It("should create the correct arguments", func() {
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, &task.Spec.SourceStore, &task.Spec.TargetStore, druidstore.Local, druidstore.S3)
 			Expect(arguments).To(Equal(expected))
 		})
 
 		It("should include the max backup age in the arguments", func() {
 			task.Spec.MaxBackupAge = ptr.To[uint32](10)
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, &task.Spec.SourceStore, &task.Spec.TargetStore, druidstore.Local, druidstore.S3)
 			Expect(arguments).To(Equal(append(expected, "--max-backup-age=10")))
 		})
 
 		It("should include the max number of backups in the arguments", func() {
 			task.Spec.MaxBackups = ptr.To[uint32](5)
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, &task.Spec.SourceStore, &task.Spec.TargetStore, druidstore.Local, druidstore.S3)
 			Expect(arguments).To(Equal(append(expected, "--max-backups-to-copy=5")))
 		})
 
@@ -380,7 +404,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 			task.Spec.WaitForFinalSnapshot = &druidv1alpha1.WaitForFinalSnapshotSpec{
 				Enabled: true,
 			}
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, &task.Spec.SourceStore, &task.Spec.TargetStore, druidstore.Local, druidstore.S3)
 			Expect(arguments).To(Equal(append(expected, "--wait-for-final-snapshot=true")))
 		})
 
@@ -389,7 +413,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 				Enabled: true,
 				Timeout: &metav1.Duration{Duration: time.Minute},
 			}
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, &task.Spec.SourceStore, &task.Spec.TargetStore, druidstore.Local, druidstore.S3)
 			Expect(arguments).To(Equal(append(expected, "--wait-for-final-snapshot=true", "--wait-for-final-snapshot-timeout=1m0s")))
 		})
 	})
@@ -656,6 +680,53 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 		})
 	})
 
+	Describe("with workload identity", func() {
+		const roleARN = "arn:aws:iam::123456789012:role/etcd-backup"
+		var (
+			ctx        = context.Background()
+			reconciler *Reconciler
+			storeSpec  *druidv1alpha1.StoreSpec
+		)
+
+		BeforeEach(func() {
+			reconciler = &Reconciler{
+				Client: fakeclient.NewClientBuilder().WithScheme(kubernetes.Scheme).Build(),
+				logger: logr.Discard(),
+			}
+			storeSpec = &druidv1alpha1.StoreSpec{
+				Container: ptr.To("source-container"),
+				Provider:  ptr.To(druidv1alpha1.StorageProvider("aws")),
+				WorkloadIdentity: &druidv1alpha1.WorkloadIdentity{
+					ServiceAccountAnnotations: map[string]string{druidstore.AnnotationAWSRoleARN: roleARN},
+				},
+			}
+		})
+
+		It("should project a service account token instead of mounting a secret", func() {
+			volumes, err := reconciler.createVolumesFromStore(ctx, storeSpec, "test-ns", druidstore.S3, sourcePrefix)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volumes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":         Equal(sourcePrefix + common.VolumeNameWorkloadIdentityToken),
+				"VolumeSource": MatchFields(IgnoreExtras, Fields{"Projected": Not(BeNil())}),
+			})))
+
+			volumeMounts := createVolumeMountsFromStore(storeSpec, druidstore.S3, sourcePrefix)
+			Expect(volumeMounts).To(ConsistOf(corev1.VolumeMount{
+				Name:      sourcePrefix + common.VolumeNameWorkloadIdentityToken,
+				MountPath: "/var/run/secrets/druid.gardener.cloud/source-workload-identity",
+				ReadOnly:  true,
+			}))
+		})
+
+		It("should set the workload identity env vars instead of the credentials", func() {
+			envVars := createEnvVarsFromStore(storeSpec, druidstore.S3, "SOURCE_", sourcePrefix)
+			Expect(envVars).To(Equal([]corev1.EnvVar{
+				{Name: "SOURCE_" + common.EnvStorageContainer, Value: "source-container"},
+				{Name: "SOURCE_" + common.EnvAWSWebIdentityTokenFile, Value: "/var/run/secrets/druid.gardener.cloud/source-workload-identity/token"},
+				{Name: "SOURCE_" + common.EnvAWSRoleARN, Value: roleARN},
+			}))
+		})
+	})
 })
 
 func ensureEtcdCopyBackupsTaskRemoval(ctx context.Context, name, namespace string, fakeClient client.WithWatch) {
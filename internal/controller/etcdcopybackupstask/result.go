@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/utils/version"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// copyResultVersionConstraint is the constraint on the version of etcd-backup-restore whose copy command writes the
+// result of copying backups as a JSON document to the termination message of the copy container, and accepts the
+// revision after which snapshots are copied. Older versions do not report a result. For them the termination message
+// of a failed copy container holds the tail of its logs instead.
+const copyResultVersionConstraint = ">= 0.43.0"
+
+// copyResultMessage is the result of copying backups which the copy container writes to its termination message.
+type copyResultMessage struct {
+	druidv1alpha1.EtcdCopyBackupsTaskCopyResult
+	// Error describes why copying backups has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// getCopyResult returns the result reported by the given finished copy job, if any, and the error reported by the
+// copy job if it has failed. A result is only reported by copy containers whose version of etcd-backup-restore
+// satisfies copyResultVersionConstraint.
+func (r *Reconciler) getCopyResult(ctx context.Context, job *batchv1.Job) (*druidv1alpha1.EtcdCopyBackupsTaskCopyResult, *druidapicommon.LastError, error) {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return nil, nil, fmt.Errorf("could not list pods of job %s: %w", client.ObjectKeyFromObject(job), err)
+	}
+
+	message := getTerminationMessage(podList.Items)
+	if message == "" {
+		return nil, getCopyError(job, ""), nil
+	}
+	if !reportsCopyResult(job) {
+		return nil, getCopyError(job, getLastLine(message)), nil
+	}
+	msg := &copyResultMessage{}
+	if err := json.Unmarshal([]byte(message), msg); err != nil {
+		r.logger.Info("Ignoring invalid result of copy job", "namespace", job.Namespace, "name", job.Name, "error", err.Error())
+		return nil, getCopyError(job, ""), nil
+	}
+	result := &msg.EtcdCopyBackupsTaskCopyResult
+	result.Duration = getJobDuration(job)
+	return result, getCopyError(job, msg.Error), nil
+}
+
+// reportsCopyResult checks whether the copy container of the given job runs a version of etcd-backup-restore which
+// reports the result of copying backups in its termination message.
+func reportsCopyResult(job *batchv1.Job) bool {
+	for _, container := range job.Spec.Template.Spec.Containers {
+		if container.Name != copyContainerName {
+			continue
+		}
+		return supportsCopyResult(container.Image)
+	}
+	return false
+}
+
+// supportsCopyResult checks whether the given etcd-backup-restore image satisfies copyResultVersionConstraint.
+func supportsCopyResult(image string) bool {
+	ok, err := version.CheckImageVersionMeetsConstraint(image, copyResultVersionConstraint)
+	return err == nil && ok
+}
+
+// getLastLine returns the last non-empty line of the given message.
+func getLastLine(message string) string {
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
+// getTerminationMessage returns the most recent termination message of the copy container among the given pods.
+func getTerminationMessage(pods []corev1.Pod) string {
+	var (
+		message    string
+		finishedAt time.Time
+	)
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name != copyContainerName {
+				continue
+			}
+			for _, terminated := range []*corev1.ContainerStateTerminated{containerStatus.State.Terminated, containerStatus.LastTerminationState.Terminated} {
+				if terminated != nil && terminated.Message != "" && terminated.FinishedAt.After(finishedAt) {
+					message, finishedAt = terminated.Message, terminated.FinishedAt.Time
+				}
+			}
+		}
+	}
+	return message
+}
+
+// getJobDuration returns the time it took the given finished job to finish.
+func getJobDuration(job *batchv1.Job) *metav1.Duration {
+	if job.Status.StartTime == nil {
+		return nil
+	}
+	finishedAt := job.Status.CompletionTime
+	if failedCondition := getJobCondition(job, batchv1.JobFailed); failedCondition != nil {
+		finishedAt = &failedCondition.LastTransitionTime
+	}
+	if finishedAt == nil {
+		return nil
+	}
+	return &metav1.Duration{Duration: finishedAt.Sub(job.Status.StartTime.Time)}
+}
+
+// getCopyError returns the error reported by the given copy job if it has failed. The error reported in the result
+// of the copy job takes precedence over the message of the failed job condition.
+func getCopyError(job *batchv1.Job, resultMessage string) *druidapicommon.LastError {
+	failedCondition := getJobCondition(job, batchv1.JobFailed)
+	if failedCondition == nil {
+		return nil
+	}
+	description := resultMessage
+	if description == "" {
+		description = failedCondition.Message
+	}
+	return &druidapicommon.LastError{
+		Code:        ErrCopyBackups,
+		Description: description,
+		ObservedAt:  failedCondition.LastTransitionTime,
+	}
+}
+
+// setCopyResult sets the result and the error reported by the last finished copy job in the status. The errors of the
+// last reconciliation are set afterwards by setLastErrors.
+func setCopyResult(status *druidv1alpha1.EtcdCopyBackupsTaskStatus, result *druidv1alpha1.EtcdCopyBackupsTaskCopyResult, copyErr *druidapicommon.LastError) {
+	status.CopyResult = result
+	status.LastErrors = nil
+	if copyErr != nil {
+		status.LastErrors = []druidapicommon.LastError{*copyErr}
+	}
+}
+
+// setLastErrors sets the given error of the last reconciliation in the status. The error reported by the last finished
+// copy job is retained.
+func setLastErrors(status *druidv1alpha1.EtcdCopyBackupsTaskStatus, err error) {
+	var lastErrors []druidapicommon.LastError
+	for _, lastErr := range status.LastErrors {
+		if lastErr.Code == ErrCopyBackups {
+			lastErrors = append(lastErrors, lastErr)
+		}
+	}
+	if err != nil {
+		status.LastError = ptr.To(err.Error())
+		lastErrors = append(lastErrors, druiderr.MapToLastErrors([]error{err})...)
+	} else {
+		status.LastError = nil
+	}
+	status.LastErrors = lastErrors
+}
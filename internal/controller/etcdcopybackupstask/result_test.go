@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("CopyResult", func() {
+	const (
+		testTaskName  = "test-task"
+		testNamespace = "test-ns"
+	)
+	var (
+		startTime = metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+		endTime   = metav1.NewTime(time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC))
+	)
+
+	Describe("#getCopyResult", func() {
+		var (
+			ctx        = context.Background()
+			job        *batchv1.Job
+			fakeClient client.Client
+			r          *Reconciler
+		)
+
+		BeforeEach(func() {
+			job = testutils.CreateEtcdCopyBackupsJob(testTaskName, testNamespace)
+			job.Spec.Template.Spec.Containers[0].Image += ":v0.43.0"
+			job.Status = batchv1.JobStatus{
+				StartTime:      &startTime,
+				CompletionTime: &endTime,
+				Conditions:     []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: endTime}},
+			}
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.Scheme).Build()
+			r = &Reconciler{
+				Client: fakeClient,
+				logger: logr.Discard(),
+			}
+		})
+
+		It("should return the result reported in the termination message of the copy container", func() {
+			Expect(fakeClient.Create(ctx, newCopyPod(job, `{"snapshotsCopied":3,"snapshotsSkipped":2,"bytesCopied":1024,"firstSnapshot":{"revision":1,"timestamp":"2025-01-01T08:00:00Z"},"lastSnapshot":{"revision":42,"timestamp":"2025-01-01T09:00:00Z"}}`))).To(Succeed())
+
+			result, copyErr, err := r.getCopyResult(ctx, job)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(copyErr).To(BeNil())
+			Expect(result).To(PointTo(MatchAllFields(Fields{
+				"SnapshotsCopied":  Equal(int32(3)),
+				"SnapshotsSkipped": Equal(int32(2)),
+				"BytesCopied":      Equal(int64(1024)),
+				"FirstSnapshot":    PointTo(MatchAllFields(Fields{"Revision": Equal(int64(1)), "Timestamp": HaveField("Time", BeTemporally("==", time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)))})),
+				"LastSnapshot":     PointTo(MatchAllFields(Fields{"Revision": Equal(int64(42)), "Timestamp": HaveField("Time", BeTemporally("==", time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)))})),
+				"Duration":         Equal(&metav1.Duration{Duration: 5 * time.Minute}),
+			})))
+		})
+
+		It("should return the error reported in the termination message of a failed copy job", func() {
+			job.Status.CompletionTime = nil
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: endTime, Message: "BackoffLimitExceeded"}}
+			Expect(fakeClient.Create(ctx, newCopyPod(job, `{"snapshotsCopied":1,"error":"access denied"}`))).To(Succeed())
+
+			result, copyErr, err := r.getCopyResult(ctx, job)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(PointTo(HaveField("SnapshotsCopied", Equal(int32(1)))))
+			Expect(copyErr).To(Equal(&druidapicommon.LastError{Code: ErrCopyBackups, Description: "access denied", ObservedAt: endTime}))
+		})
+
+		It("should not return a result if the copy container has not reported one", func() {
+			Expect(fakeClient.Create(ctx, newCopyPod(job, ""))).To(Succeed())
+
+			result, copyErr, err := r.getCopyResult(ctx, job)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(copyErr).To(BeNil())
+		})
+
+		It("should ignore an invalid result", func() {
+			Expect(fakeClient.Create(ctx, newCopyPod(job, "copied all snapshots"))).To(Succeed())
+
+			result, _, err := r.getCopyResult(ctx, job)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+		It("should not return a result and take the error from the logs of a failed copy container of an older version", func() {
+			job.Spec.Template.Spec.Containers[0].Image = "europe-docker.pkg.dev/gardener-project/public/gardener/etcdbrctl:v0.41.2"
+			job.Status.CompletionTime = nil
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: endTime, Message: "BackoffLimitExceeded"}}
+			Expect(fakeClient.Create(ctx, newCopyPod(job, "starting to copy backups\naccess denied\n"))).To(Succeed())
+
+			result, copyErr, err := r.getCopyResult(ctx, job)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(copyErr).To(Equal(&druidapicommon.LastError{Code: ErrCopyBackups, Description: "access denied", ObservedAt: endTime}))
+		})
+	})
+
+	DescribeTable("#reportsCopyResult",
+		func(image string, expected bool) {
+			job := testutils.CreateEtcdCopyBackupsJob(testTaskName, testNamespace)
+			job.Spec.Template.Spec.Containers[0].Image = image
+			Expect(reportsCopyResult(job)).To(Equal(expected))
+		},
+		Entry("version which reports the result", "registry.example.com:5000/etcdbrctl:v0.43.0", true),
+		Entry("newer version", "registry.example.com/etcdbrctl:v0.44.1-dev", true),
+		Entry("older version", "registry.example.com/etcdbrctl:v0.41.2", false),
+		Entry("image without tag", "registry.example.com:5000/etcdbrctl", false),
+		Entry("image referenced by digest", "registry.example.com/etcdbrctl:v0.43.0@sha256:0123456789abcdef", false),
+	)
+
+	Describe("#getCopyError", func() {
+		It("should not return an error for a successful job", func() {
+			Expect(getCopyError(newFinishedJob(startTime, batchv1.JobComplete, endTime), "")).To(BeNil())
+		})
+
+		It("should fall back to the message of the failed job condition", func() {
+			Expect(getCopyError(newFinishedJob(startTime, batchv1.JobFailed, endTime), "")).To(Equal(&druidapicommon.LastError{
+				Code:        ErrCopyBackups,
+				Description: "copy failed",
+				ObservedAt:  endTime,
+			}))
+		})
+	})
+
+	Describe("#setLastErrors", func() {
+		var (
+			status  *druidv1alpha1.EtcdCopyBackupsTaskStatus
+			copyErr = druidapicommon.LastError{Code: ErrCopyBackups, Description: "copy failed", ObservedAt: endTime}
+		)
+
+		BeforeEach(func() {
+			status = &druidv1alpha1.EtcdCopyBackupsTaskStatus{}
+			setCopyResult(status, nil, &copyErr)
+		})
+
+		It("should retain the error reported by the copy job", func() {
+			setLastErrors(status, nil)
+			Expect(status.LastError).To(BeNil())
+			Expect(status.LastErrors).To(ConsistOf(copyErr))
+		})
+
+		It("should add the error of the reconciliation", func() {
+			setLastErrors(status, druiderr.WrapError(fmt.Errorf("test error"), ErrGetJob, "Reconcile", "could not get copy job"))
+			Expect(status.LastError).ToNot(BeNil())
+			Expect(status.LastErrors).To(ConsistOf(copyErr, HaveField("Code", ErrGetJob)))
+		})
+
+		It("should replace the error of the previous reconciliation", func() {
+			setLastErrors(status, druiderr.WrapError(fmt.Errorf("test error"), ErrGetJob, "Reconcile", "could not get copy job"))
+			setLastErrors(status, nil)
+			Expect(status.LastErrors).To(ConsistOf(copyErr))
+		})
+	})
+})
+
+func newCopyPod(job *batchv1.Job, terminationMessage string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-abcde",
+			Namespace: job.Namespace,
+			Labels:    map[string]string{batchv1.JobNameLabel: job.Name},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: copyContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							Message:    terminationMessage,
+							FinishedAt: metav1.NewTime(time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
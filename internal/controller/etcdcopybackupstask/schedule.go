@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxRecentRuns is the maximum number of runs of a recurring task that are retained in the status.
+	maxRecentRuns = 5
+	// maxMissedSchedules is the maximum number of missed schedules that are walked to determine the most recent
+	// scheduled time, similar to the limit applied to CronJobs.
+	maxMissedSchedules = 100
+)
+
+// doReconcileRecurring reconciles a recurring EtcdCopyBackupsTask. At most one copy job exists at any time.
+// A finished copy job is recorded in the status and deleted, and a new copy job is created once the next
+// scheduled time has been reached. Missed schedules are not caught up on individually, a single copy job is
+// created for all of them instead.
+func (r *Reconciler) doReconcileRecurring(ctx context.Context, task *druidv1alpha1.EtcdCopyBackupsTask, logger logr.Logger) (status *druidv1alpha1.EtcdCopyBackupsTaskStatus, result ctrl.Result, err error) {
+	status = task.Status.DeepCopy()
+	now := time.Now()
+
+	var job *batchv1.Job
+	defer func() {
+		status.ObservedGeneration = &task.Generation
+		// The conditions of the last copy job are retained until the next copy job is created.
+		if job != nil {
+			status.Conditions = getConditions(job.Status.Conditions)
+		}
+		setLastErrors(status, err)
+		updateTimeSinceLastSuccess(task, status, now)
+	}()
+
+	schedule, err := cron.ParseStandard(*task.Spec.Schedule)
+	if err != nil {
+		return status, ctrl.Result{}, druiderr.WrapError(err, ErrParseSchedule, string(druidv1alpha1.LastOperationTypeReconcile), fmt.Sprintf("could not parse schedule %q", *task.Spec.Schedule))
+	}
+
+	// Get job from cluster
+	job, err = r.getJob(ctx, task)
+	if err != nil {
+		return status, ctrl.Result{}, druiderr.WrapError(err, ErrGetJob, string(druidv1alpha1.LastOperationTypeReconcile), "could not get copy job")
+	}
+	if job != nil {
+		if job.DeletionTimestamp != nil || !isJobFinished(job) {
+			return status, ctrl.Result{}, nil
+		}
+		result, copyErr, err := r.getCopyResult(ctx, job)
+		if err != nil {
+			return status, ctrl.Result{}, druiderr.WrapError(err, ErrGetCopyResult, string(druidv1alpha1.LastOperationTypeReconcile), "could not get result of copy job")
+		}
+		setCopyResult(status, result, copyErr)
+		recordRun(status, job, result, copyErr)
+		// The deletion of the job triggers another reconciliation which takes care of scheduling the next copy job.
+		logger.Info("Deleting finished job", "namespace", job.Namespace, "name", job.Name)
+		if err = r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return status, ctrl.Result{}, druiderr.WrapError(err, ErrDeleteJob, string(druidv1alpha1.LastOperationTypeReconcile), fmt.Sprintf("could not delete job %s", client.ObjectKeyFromObject(job)))
+		}
+		return status, ctrl.Result{}, nil
+	}
+
+	earliestTime := task.CreationTimestamp.Time
+	if status.LastScheduleTime != nil {
+		earliestTime = status.LastScheduleTime.Time
+	}
+	scheduledTime, nextScheduleTime := getScheduleTimes(schedule, earliestTime, now)
+	status.NextScheduleTime = ptr.To(metav1.NewTime(nextScheduleTime))
+	if scheduledTime == nil {
+		return status, ctrl.Result{RequeueAfter: nextScheduleTime.Sub(now)}, nil
+	}
+
+	// create a job object from task
+	job, err = r.createJobObject(ctx, task)
+	if err != nil {
+		return status, ctrl.Result{}, druiderr.WrapError(err, ErrCreateJob, string(druidv1alpha1.LastOperationTypeReconcile), "could not create copy job object")
+	}
+
+	// Create job
+	logger.Info("Creating job", "namespace", job.Namespace, "name", job.Name, "scheduledTime", scheduledTime)
+	if err = r.Create(ctx, job); err != nil {
+		return status, ctrl.Result{}, druiderr.WrapError(err, ErrCreateJob, string(druidv1alpha1.LastOperationTypeReconcile), fmt.Sprintf("could not create job %s", client.ObjectKeyFromObject(job)))
+	}
+	status.LastScheduleTime = ptr.To(metav1.NewTime(*scheduledTime))
+
+	return status, ctrl.Result{RequeueAfter: nextScheduleTime.Sub(now)}, nil
+}
+
+// getScheduleTimes returns the most recent scheduled time after earliestTime that is not after now, if any,
+// and the first scheduled time after now. Only the schedules close to now are walked, so that the effort does not
+// grow with the time elapsed since earliestTime, and at most maxMissedSchedules of them.
+func getScheduleTimes(schedule cron.Schedule, earliestTime, now time.Time) (*time.Time, time.Time) {
+	// Look back from now in exponentially growing steps until a scheduled time lies within the step.
+	from := earliestTime
+	for lookback := time.Minute; now.Add(-lookback).After(earliestTime); lookback *= 2 {
+		if t := schedule.Next(now.Add(-lookback)); !t.IsZero() && !t.After(now) {
+			from = now.Add(-lookback)
+			break
+		}
+	}
+	var scheduledTime *time.Time
+	for i, t := 0, schedule.Next(from); i < maxMissedSchedules && !t.IsZero() && !t.After(now); i, t = i+1, schedule.Next(t) {
+		scheduledTime = ptr.To(t)
+	}
+	return scheduledTime, schedule.Next(now)
+}
+
+// getLastCopiedRevision returns the revision of the most recent snapshot which has been copied by a previous copy job
+// of a recurring task, if any. Only the snapshots taken after it need to be copied by the next copy job.
+func getLastCopiedRevision(task *druidv1alpha1.EtcdCopyBackupsTask) *int64 {
+	if !task.IsRecurring() || task.Status.LastCopiedSnapshot == nil {
+		return nil
+	}
+	return &task.Status.LastCopiedSnapshot.Revision
+}
+
+// isJobFinished checks if the given job has either completed or failed.
+func isJobFinished(job *batchv1.Job) bool {
+	return getJobCondition(job, batchv1.JobComplete) != nil || getJobCondition(job, batchv1.JobFailed) != nil
+}
+
+// getJobCondition returns the condition of the given type if its status is true, nil otherwise.
+func getJobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return &condition
+		}
+	}
+	return nil
+}
+
+// recordRun records the given finished copy job along with the result and the error reported by it as a run in the
+// status of a recurring task.
+func recordRun(status *druidv1alpha1.EtcdCopyBackupsTaskStatus, job *batchv1.Job, result *druidv1alpha1.EtcdCopyBackupsTaskCopyResult, copyErr *druidapicommon.LastError) {
+	startTime := job.CreationTimestamp
+	// The job may already have been recorded if its deletion failed in a previous reconciliation.
+	if n := len(status.RecentRuns); n > 0 && status.RecentRuns[n-1].StartTime.Equal(&startTime) {
+		return
+	}
+	run := druidv1alpha1.EtcdCopyBackupsTaskRun{
+		StartTime:      startTime,
+		CompletionTime: job.Status.CompletionTime,
+		Result:         druidv1alpha1.EtcdCopyBackupsTaskRunSucceeded,
+		CopyResult:     result,
+	}
+	if copyErr != nil {
+		run.Result = druidv1alpha1.EtcdCopyBackupsTaskRunFailed
+		run.CompletionTime = ptr.To(copyErr.ObservedAt)
+		run.Message = copyErr.Description
+	} else {
+		status.LastSuccessfulTime = ptr.To(startTime)
+		if result != nil && result.LastSnapshot != nil {
+			status.LastCopiedSnapshot = result.LastSnapshot.DeepCopy()
+		}
+	}
+	status.RecentRuns = append(status.RecentRuns, run)
+	if len(status.RecentRuns) > maxRecentRuns {
+		status.RecentRuns = status.RecentRuns[len(status.RecentRuns)-maxRecentRuns:]
+	}
+}
+
+// updateTimeSinceLastSuccess updates the time elapsed since the start of the last successful copy job in the status and
+// in the metrics.
+func updateTimeSinceLastSuccess(task *druidv1alpha1.EtcdCopyBackupsTask, status *druidv1alpha1.EtcdCopyBackupsTaskStatus, now time.Time) {
+	if status.LastSuccessfulTime == nil {
+		status.TimeSinceLastSuccess = nil
+		return
+	}
+	status.TimeSinceLastSuccess = &metav1.Duration{Duration: now.Sub(status.LastSuccessfulTime.Time).Truncate(time.Second)}
+	metricTimeSinceLastSuccess.set(client.ObjectKeyFromObject(task), status.LastSuccessfulTime.Time)
+}
@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcopybackupstask
+
+import (
+	"context"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/utils/imagevector"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("RecurringEtcdCopyBackupsTask", func() {
+
+	Describe("#getScheduleTimes", func() {
+		var (
+			schedule cron.Schedule
+			now      = time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			var err error
+			schedule, err = cron.ParseStandard("0 * * * *")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not return a scheduled time if the next schedule has not been reached", func() {
+			scheduledTime, next := getScheduleTimes(schedule, now.Add(-10*time.Minute), now)
+			Expect(scheduledTime).To(BeNil())
+			Expect(next).To(Equal(time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the most recent scheduled time if schedules have been missed", func() {
+			scheduledTime, next := getScheduleTimes(schedule, now.Add(-5*time.Hour), now)
+			Expect(scheduledTime).To(PointTo(Equal(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))))
+			Expect(next).To(Equal(time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the most recent scheduled time if many schedules have been missed", func() {
+			schedule, err := cron.ParseStandard("* * * * *")
+			Expect(err).ToNot(HaveOccurred())
+			scheduledTime, next := getScheduleTimes(schedule, now.AddDate(-1, 0, 0), now)
+			Expect(scheduledTime).To(PointTo(Equal(now)))
+			Expect(next).To(Equal(now.Add(time.Minute)))
+		})
+
+		It("should not return a scheduled time for a schedule which is never due", func() {
+			schedule, err := cron.ParseStandard("0 0 30 2 *")
+			Expect(err).ToNot(HaveOccurred())
+			scheduledTime, _ := getScheduleTimes(schedule, now.AddDate(-1, 0, 0), now)
+			Expect(scheduledTime).To(BeNil())
+		})
+	})
+
+	Describe("#getLastCopiedRevision", func() {
+		var task *druidv1alpha1.EtcdCopyBackupsTask
+
+		BeforeEach(func() {
+			task = &druidv1alpha1.EtcdCopyBackupsTask{Spec: druidv1alpha1.EtcdCopyBackupsTaskSpec{Schedule: ptr.To("0 * * * *")}}
+		})
+
+		It("should not return a revision if no snapshot has been copied yet", func() {
+			Expect(getLastCopiedRevision(task)).To(BeNil())
+		})
+
+		It("should return the revision of the last copied snapshot", func() {
+			task.Status.LastCopiedSnapshot = &druidv1alpha1.EtcdCopyBackupsTaskSnapshot{Revision: 42}
+			Expect(getLastCopiedRevision(task)).To(PointTo(Equal(int64(42))))
+		})
+
+		It("should not return a revision if the task is not recurring", func() {
+			task.Spec.Schedule = nil
+			task.Status.LastCopiedSnapshot = &druidv1alpha1.EtcdCopyBackupsTaskSnapshot{Revision: 42}
+			Expect(getLastCopiedRevision(task)).To(BeNil())
+		})
+	})
+
+	Describe("#recordRun", func() {
+		var (
+			startTime = metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+			endTime   = metav1.NewTime(time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC))
+			status    *druidv1alpha1.EtcdCopyBackupsTaskStatus
+		)
+
+		BeforeEach(func() {
+			status = &druidv1alpha1.EtcdCopyBackupsTaskStatus{}
+		})
+
+		It("should record a successful run and update the last successful time", func() {
+			job := newFinishedJob(startTime, batchv1.JobComplete, endTime)
+			recordRun(status, job, nil, getCopyError(job, ""))
+			Expect(status.RecentRuns).To(Equal([]druidv1alpha1.EtcdCopyBackupsTaskRun{
+				{StartTime: startTime, CompletionTime: &endTime, Result: druidv1alpha1.EtcdCopyBackupsTaskRunSucceeded},
+			}))
+			Expect(status.LastSuccessfulTime).To(PointTo(Equal(startTime)))
+		})
+
+		It("should record a failed run without updating the last successful time", func() {
+			job := newFinishedJob(startTime, batchv1.JobFailed, endTime)
+			recordRun(status, job, nil, getCopyError(job, ""))
+			Expect(status.RecentRuns).To(Equal([]druidv1alpha1.EtcdCopyBackupsTaskRun{
+				{StartTime: startTime, CompletionTime: &endTime, Result: druidv1alpha1.EtcdCopyBackupsTaskRunFailed, Message: "copy failed"},
+			}))
+			Expect(status.LastSuccessfulTime).To(BeNil())
+		})
+
+		It("should record the result reported by the copy job", func() {
+			job := newFinishedJob(startTime, batchv1.JobComplete, endTime)
+			result := &druidv1alpha1.EtcdCopyBackupsTaskCopyResult{SnapshotsCopied: 3, SnapshotsSkipped: 2, BytesCopied: 1024}
+			recordRun(status, job, result, nil)
+			Expect(status.RecentRuns).To(ConsistOf(HaveField("CopyResult", Equal(result))))
+		})
+
+		It("should record the last copied snapshot of a successful run", func() {
+			lastSnapshot := &druidv1alpha1.EtcdCopyBackupsTaskSnapshot{Revision: 42, Timestamp: startTime}
+			job := newFinishedJob(startTime, batchv1.JobComplete, endTime)
+			recordRun(status, job, &druidv1alpha1.EtcdCopyBackupsTaskCopyResult{SnapshotsCopied: 1, LastSnapshot: lastSnapshot}, nil)
+			Expect(status.LastCopiedSnapshot).To(Equal(lastSnapshot))
+		})
+
+		It("should keep the last copied snapshot if a run fails or copies nothing", func() {
+			lastSnapshot := &druidv1alpha1.EtcdCopyBackupsTaskSnapshot{Revision: 42, Timestamp: startTime}
+			status.LastCopiedSnapshot = lastSnapshot.DeepCopy()
+			job := newFinishedJob(startTime, batchv1.JobFailed, endTime)
+			recordRun(status, job, &druidv1alpha1.EtcdCopyBackupsTaskCopyResult{LastSnapshot: &druidv1alpha1.EtcdCopyBackupsTaskSnapshot{Revision: 50}}, getCopyError(job, ""))
+			job = newFinishedJob(metav1.NewTime(startTime.Add(time.Hour)), batchv1.JobComplete, endTime)
+			recordRun(status, job, &druidv1alpha1.EtcdCopyBackupsTaskCopyResult{}, nil)
+			Expect(status.LastCopiedSnapshot).To(Equal(lastSnapshot))
+		})
+
+		It("should not record the same run twice", func() {
+			job := newFinishedJob(startTime, batchv1.JobComplete, endTime)
+			recordRun(status, job, nil, getCopyError(job, ""))
+			recordRun(status, job, nil, getCopyError(job, ""))
+			Expect(status.RecentRuns).To(HaveLen(1))
+		})
+
+		It("should retain only the most recent runs", func() {
+			for i := range maxRecentRuns + 2 {
+				job := newFinishedJob(metav1.NewTime(startTime.Add(time.Duration(i)*time.Hour)), batchv1.JobComplete, endTime)
+				recordRun(status, job, nil, getCopyError(job, ""))
+			}
+			Expect(status.RecentRuns).To(HaveLen(maxRecentRuns))
+			Expect(status.RecentRuns[0].StartTime.Time).To(Equal(startTime.Add(2 * time.Hour)))
+		})
+	})
+
+	Describe("#doReconcileRecurring", func() {
+		const (
+			testTaskName  = "test-recurring-task"
+			testNamespace = "test-ns"
+		)
+		var (
+			ctx        = context.Background()
+			task       *druidv1alpha1.EtcdCopyBackupsTask
+			fakeClient client.Client
+			r          *Reconciler
+		)
+
+		BeforeEach(func() {
+			task = testutils.CreateEtcdCopyBackupsTask(testTaskName, testNamespace, "aws", false)
+			task.Spec.Schedule = ptr.To("0 * * * *")
+			task.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.Scheme).Build()
+			Expect(testutils.CreateSecrets(ctx, fakeClient, testNamespace, task.Spec.SourceStore.SecretRef.Name, task.Spec.TargetStore.SecretRef.Name)).To(Succeed())
+			r = &Reconciler{
+				Client: fakeClient,
+				logger: logr.Discard(),
+				imageVector: imagevector.ImageVector{
+					&imagevector.ImageSource{
+						Name:       common.ImageKeyEtcdBackupRestore,
+						Repository: ptr.To("test-repo"),
+						Tag:        ptr.To("etcd-test-tag"),
+					},
+					&imagevector.ImageSource{
+						Name:       common.ImageKeyAlpine,
+						Repository: ptr.To("test-repo"),
+						Tag:        ptr.To("init-container-test-tag"),
+					},
+				},
+				Config: druidconfigv1alpha1.EtcdCopyBackupsTaskControllerConfiguration{},
+			}
+		})
+
+		It("should create a job if the scheduled time has been reached", func() {
+			status, result, err := r.doReconcileRecurring(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(status.LastScheduleTime).ToNot(BeNil())
+			Expect(status.NextScheduleTime).ToNot(BeNil())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: task.GetJobName()}, &batchv1.Job{})).To(Succeed())
+		})
+
+		It("should not create a job if the scheduled time has not been reached", func() {
+			task.Status.LastScheduleTime = ptr.To(metav1.Now())
+			status, result, err := r.doReconcileRecurring(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(status.NextScheduleTime.Time).To(BeTemporally(">", time.Now()))
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: task.GetJobName()}, &batchv1.Job{})).To(testutils.BeNotFoundError())
+		})
+
+		It("should record and delete a finished job", func() {
+			job := testutils.CreateEtcdCopyBackupsJob(testTaskName, testNamespace)
+			Expect(fakeClient.Create(ctx, job)).To(Succeed())
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+			Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+
+			status, _, err := r.doReconcileRecurring(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.RecentRuns).To(HaveLen(1))
+			Expect(status.LastSuccessfulTime).ToNot(BeNil())
+			Expect(status.TimeSinceLastSuccess).ToNot(BeNil())
+			Expect(status.Conditions).To(ConsistOf(HaveField("Type", druidv1alpha1.EtcdCopyBackupsTaskSucceeded)))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(job), job)).To(testutils.BeNotFoundError())
+		})
+
+		It("should not delete a running job", func() {
+			job := testutils.CreateEtcdCopyBackupsJob(testTaskName, testNamespace)
+			Expect(fakeClient.Create(ctx, job)).To(Succeed())
+
+			status, _, err := r.doReconcileRecurring(ctx, task, logr.Discard())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.RecentRuns).To(BeEmpty())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(job), job)).To(Succeed())
+		})
+	})
+})
+
+func newFinishedJob(startTime metav1.Time, conditionType batchv1.JobConditionType, endTime metav1.Time) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: startTime},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue, LastTransitionTime: endTime}},
+		},
+	}
+	if conditionType == batchv1.JobComplete {
+		job.Status.CompletionTime = &endTime
+	} else {
+		job.Status.Conditions[0].Message = "copy failed"
+	}
+	return job
+}
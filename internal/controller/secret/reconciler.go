@@ -6,16 +6,21 @@ package secret
 
 import (
 	"context"
+	"slices"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -39,6 +44,8 @@ func NewReconciler(mgr manager.Manager, config druidconfigv1alpha1.SecretControl
 }
 
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcds,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch
 
 // Reconcile reconciles the secret.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -59,7 +66,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	if needed, etcd := isFinalizerNeeded(secret.Name, etcdList); needed {
 		if hasFinalizer(secret) {
-			return ctrl.Result{}, nil
+			return ctrl.Result{}, r.triggerReconcileOnSecretChange(ctx, logger, secret.Name, etcdList)
 		}
 		logger.Info("Adding finalizer for secret since it is referenced by etcd resource",
 			"secretNamespace", secret.Namespace, "secretName", secret.Name, "etcdNamespace", etcd.Namespace, "etcdName", etcd.Name)
@@ -68,6 +75,40 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, removeFinalizer(ctx, logger, r.Client, secret)
 }
 
+// triggerReconcileOnSecretChange annotates every Etcd which references the given secret and opted in to rolling its
+// pods on secret changes for reconciliation, if the checksum of its secrets differs from the one on its StatefulSet.
+func (r *Reconciler) triggerReconcileOnSecretChange(ctx context.Context, logger logr.Logger, secretName string, etcdList *druidv1alpha1.EtcdList) error {
+	for _, etcd := range etcdList.Items {
+		if !ptr.Deref(etcd.Spec.RollPodsOnSecretChange, false) ||
+			!slices.Contains(kubernetes.GetReferencedSecretNames(&etcd), secretName) ||
+			etcd.Annotations[druidv1alpha1.DruidOperationAnnotation] == druidv1alpha1.DruidOperationReconcile {
+			continue
+		}
+		sts := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: etcd.Namespace, Name: druidv1alpha1.GetStatefulSetName(etcd.ObjectMeta)}, sts); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		checkSum, err := kubernetes.ComputeSecretsCheckSum(ctx, r.Client, &etcd)
+		if err != nil {
+			return err
+		}
+		if sts.Spec.Template.Annotations[common.CheckSumKeySecrets] == checkSum {
+			continue
+		}
+		logger.Info("Triggering reconciliation of etcd resource since the contents of its secrets have changed",
+			"etcdNamespace", etcd.Namespace, "etcdName", etcd.Name)
+		patch := client.MergeFrom(etcd.DeepCopy())
+		metav1.SetMetaDataAnnotation(&etcd.ObjectMeta, druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile)
+		if err = client.IgnoreNotFound(r.Patch(ctx, &etcd, patch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isFinalizerNeeded(secretName string, etcdList *druidv1alpha1.EtcdList) (bool, *druidv1alpha1.Etcd) {
 	for _, etcd := range etcdList.Items {
 		if etcd.Spec.Etcd.ClientUrlTLS != nil &&
@@ -95,6 +136,25 @@ func isFinalizerNeeded(secretName string, etcdList *druidv1alpha1.EtcdList) (boo
 			etcd.Spec.Backup.Store.SecretRef.Name == secretName {
 			return true, &etcd
 		}
+
+		if slices.ContainsFunc(etcd.Spec.Backup.SecondaryStores, func(secondaryStore druidv1alpha1.SecondaryStoreSpec) bool {
+			return secondaryStore.Store.SecretRef != nil && secondaryStore.Store.SecretRef.Name == secretName
+		}) {
+			return true, &etcd
+		}
+
+		if encryption := etcd.Spec.Backup.Encryption; encryption != nil &&
+			((encryption.SecretRef != nil && encryption.SecretRef.Name == secretName) ||
+				(encryption.KMS != nil && encryption.KMS.CredentialsSecretRef != nil && encryption.KMS.CredentialsSecretRef.Name == secretName)) {
+			return true, &etcd
+		}
+
+		if etcd.Spec.Etcd.Auth != nil &&
+			slices.ContainsFunc(etcd.Spec.Etcd.Auth.Users, func(user druidv1alpha1.EtcdAuthUser) bool {
+				return user.PasswordSecretRef != nil && user.PasswordSecretRef.Name == secretName
+			}) {
+			return true, &etcd
+		}
 	}
 
 	return false, nil
@@ -10,22 +10,29 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/common"
+	k8sutils "github.com/gardener/etcd-druid/internal/utils/kubernetes"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/onsi/gomega"
 )
 
 type etcdBuildInfo struct {
-	name          string
-	withClientTLS bool
-	withPeerTLS   bool
-	withBackup    bool
+	name           string
+	withClientTLS  bool
+	withPeerTLS    bool
+	withBackup     bool
+	withAuth       bool
+	withEncryption bool
+	withSecondary  bool
 }
 
 func TestIsFinalizerNeeded(t *testing.T) {
@@ -118,6 +125,33 @@ func TestIsFinalizerNeeded(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:       "there is at least one etcd with auth, secret name matches user password secret",
+			secretName: testutils.EtcdAuthUserPasswordSecretName,
+			etcdResources: []etcdBuildInfo{
+				{name: "test-etcd-with-client-tls", withClientTLS: true, withPeerTLS: false, withBackup: false},
+				{name: "test-etcd-with-auth", withClientTLS: true, withPeerTLS: false, withBackup: false, withAuth: true},
+			},
+			expected: true,
+		},
+		{
+			name:       "there is at least one etcd with backup encryption, secret name matches encryption secret",
+			secretName: testutils.BackupEncryptionSecretName,
+			etcdResources: []etcdBuildInfo{
+				{name: "test-etcd-with-backup", withClientTLS: false, withPeerTLS: false, withBackup: true},
+				{name: "test-etcd-with-encryption", withClientTLS: false, withPeerTLS: false, withBackup: true, withEncryption: true},
+			},
+			expected: true,
+		},
+		{
+			name:       "there is at least one etcd with a secondary backup store, secret name matches secondary store secret",
+			secretName: testutils.SecondaryBackupStoreSecretName,
+			etcdResources: []etcdBuildInfo{
+				{name: "test-etcd-with-backup", withClientTLS: false, withPeerTLS: false, withBackup: true},
+				{name: "test-etcd-with-secondary", withClientTLS: false, withPeerTLS: false, withBackup: true, withSecondary: true},
+			},
+			expected: true,
+		},
 	}
 
 	g := NewWithT(t)
@@ -279,6 +313,73 @@ func TestRemoveFinalizer(t *testing.T) {
 	}
 }
 
+func TestTriggerReconcileOnSecretChange(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		rollPodsOnSecretChange bool
+		stsExists              bool
+		stsCheckSumUpToDate    bool
+		expectReconcile        bool
+	}{
+		{
+			name:            "etcd did not opt in to rolling pods on secret changes",
+			stsExists:       true,
+			expectReconcile: false,
+		},
+		{
+			name:                   "statefulset does not exist yet",
+			rollPodsOnSecretChange: true,
+			expectReconcile:        false,
+		},
+		{
+			name:                   "checksum on statefulset is up to date",
+			rollPodsOnSecretChange: true,
+			stsExists:              true,
+			stsCheckSumUpToDate:    true,
+			expectReconcile:        false,
+		},
+		{
+			name:                   "checksum on statefulset is outdated",
+			rollPodsOnSecretChange: true,
+			stsExists:              true,
+			expectReconcile:        true,
+		},
+	}
+
+	g := NewWithT(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			etcd := testutils.EtcdBuilderWithoutDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+			etcd.Spec.RollPodsOnSecretChange = ptr.To(tc.rollPodsOnSecretChange)
+			cl := testutils.NewTestClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(etcd).Build()
+			g.Expect(testutils.CreateSecrets(ctx, cl, etcd.Namespace, k8sutils.GetReferencedSecretNames(etcd)...)).To(Succeed())
+			if tc.stsExists {
+				sts := testutils.CreateStatefulSet(etcd.Name, etcd.Namespace, etcd.UID, etcd.Spec.Replicas)
+				if tc.stsCheckSumUpToDate {
+					checkSum, err := k8sutils.ComputeSecretsCheckSum(ctx, cl, etcd)
+					g.Expect(err).ToNot(HaveOccurred())
+					sts.Spec.Template.Annotations = map[string]string{common.CheckSumKeySecrets: checkSum}
+				}
+				g.Expect(cl.Create(ctx, sts)).To(Succeed())
+			}
+			r := &Reconciler{Client: cl, logger: logr.Discard()}
+
+			etcdList := &druidv1alpha1.EtcdList{Items: []druidv1alpha1.Etcd{*etcd}}
+			g.Expect(r.triggerReconcileOnSecretChange(ctx, logr.Discard(), testutils.ClientTLSServerCertSecretName, etcdList)).To(Succeed())
+
+			updatedEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(etcd), updatedEtcd)).To(Succeed())
+			if tc.expectReconcile {
+				g.Expect(updatedEtcd.Annotations).To(HaveKeyWithValue(druidv1alpha1.DruidOperationAnnotation, druidv1alpha1.DruidOperationReconcile))
+			} else {
+				g.Expect(updatedEtcd.Annotations).ToNot(HaveKey(druidv1alpha1.DruidOperationAnnotation))
+			}
+		})
+	}
+}
+
 func createEtcdList(etcdBuildInfos []etcdBuildInfo) druidv1alpha1.EtcdList {
 	etcdList := druidv1alpha1.EtcdList{}
 	for _, info := range etcdBuildInfos {
@@ -292,6 +393,15 @@ func createEtcdList(etcdBuildInfos []etcdBuildInfo) druidv1alpha1.EtcdList {
 		if info.withBackup {
 			_ = etcdBuilder.WithDefaultBackup()
 		}
+		if info.withAuth {
+			_ = etcdBuilder.WithEtcdAuth()
+		}
+		if info.withEncryption {
+			_ = etcdBuilder.WithBackupEncryption()
+		}
+		if info.withSecondary {
+			_ = etcdBuilder.WithSecondaryBackupStore("secondary")
+		}
 		etcdList.Items = append(etcdList.Items, *etcdBuilder.Build())
 	}
 	return etcdList
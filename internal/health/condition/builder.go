@@ -15,12 +15,15 @@ import (
 
 // skipMergeConditions contain the list of conditions we don't want to add to the list if not recalculated
 var skipMergeConditions = map[druidv1alpha1.ConditionType]struct{}{
-	druidv1alpha1.ConditionTypeReady:             {},
-	druidv1alpha1.ConditionTypeAllMembersReady:   {},
-	druidv1alpha1.ConditionTypeAllMembersUpdated: {},
-	druidv1alpha1.ConditionTypeBackupReady:       {},
-	druidv1alpha1.ConditionTypeDataVolumesReady:  {},
-	druidv1alpha1.ConditionTypeClusterIDMismatch: {},
+	druidv1alpha1.ConditionTypeReady:                   {},
+	druidv1alpha1.ConditionTypeAllMembersReady:         {},
+	druidv1alpha1.ConditionTypeAllMembersUpdated:       {},
+	druidv1alpha1.ConditionTypeBackupReady:             {},
+	druidv1alpha1.ConditionTypeDataVolumesReady:        {},
+	druidv1alpha1.ConditionTypeClusterIDMismatch:       {},
+	druidv1alpha1.ConditionTypeQuotaHealthy:            {},
+	druidv1alpha1.ConditionTypeMembersInSync:           {},
+	druidv1alpha1.ConditionTypeTopologySpreadSatisfied: {},
 }
 
 // Builder is an interface for building conditions.
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// dataConsistencyCheckInterval is the interval in which the hashes of the key-value stores of the members are
+	// compared. Computing the hash requires etcd to read the whole key-value store, it is therefore not done on every
+	// status update.
+	dataConsistencyCheckInterval = 10 * time.Minute
+	// hashKVTimeout is the timeout for computing the hashes of the key-value stores of all members.
+	hashKVTimeout = 10 * time.Second
+
+	// MemberHashesMatch is a constant that means that the key-value stores of all members have the same hash.
+	MemberHashesMatch string = "MemberHashesMatch"
+	// MemberDataDivergent is a constant that means that the key-value store of at least one member has a different
+	// hash than the key-value stores of the other members.
+	MemberDataDivergent string = "MemberDataDivergent"
+)
+
+type dataConsistentCheck struct {
+	statusClient *etcdclient.StatusClient
+}
+
+// Check compares the hashes of the key-value stores of all members at the smallest revision which all members have
+// applied. The check is only executed once per dataConsistencyCheckInterval, otherwise no result is returned and the
+// previous condition is kept. For clusters with less than two members the condition is not checked.
+func (d *dataConsistentCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) Result {
+	res := &result{
+		conType: druidv1alpha1.ConditionTypeDataConsistent,
+		status:  druidv1alpha1.ConditionUnknown,
+		reason:  Unknown,
+	}
+	if etcd.Spec.Replicas < 2 {
+		res.reason = NotChecked
+		res.message = "Data consistency is only checked for etcd clusters with more than one member"
+		return res
+	}
+	if !d.isCheckDue(etcd) {
+		return nil
+	}
+	if len(etcd.Status.Members) < int(etcd.Spec.Replicas) || slices.ContainsFunc(etcd.Status.Members, func(member druidv1alpha1.EtcdMemberStatus) bool {
+		return member.Status != druidv1alpha1.EtcdMemberStatusReady
+	}) {
+		res.message = "Not all etcd members are ready"
+		return res
+	}
+
+	memberHashes, err := d.getMemberHashes(ctx)
+	if err != nil {
+		res.reason = "UnableToComputeHashes"
+		res.message = fmt.Sprintf("Unable to compute hashes of the etcd members: %s", err.Error())
+		return res
+	}
+
+	membersPerHash := make(map[uint32][]string)
+	for memberName, hash := range memberHashes {
+		membersPerHash[hash] = append(membersPerHash[hash], memberName)
+	}
+	if len(membersPerHash) == 1 {
+		res.status = druidv1alpha1.ConditionTrue
+		res.reason = MemberHashesMatch
+		res.message = fmt.Sprintf("Key-value stores of all %d members have the same hash", len(memberHashes))
+		return res
+	}
+
+	res.status = druidv1alpha1.ConditionFalse
+	res.reason = MemberDataDivergent
+	res.message = fmt.Sprintf("Data of member(s) %s diverges from the data of the other members", strings.Join(getDivergentMembers(membersPerHash, len(memberHashes)), ", "))
+	return res
+}
+
+// isCheckDue returns true if the hashes have not been compared within the last dataConsistencyCheckInterval, or if
+// they could not be compared on the previous attempt.
+func (d *dataConsistentCheck) isCheckDue(etcd druidv1alpha1.Etcd) bool {
+	for _, cond := range etcd.Status.Conditions {
+		if cond.Type == druidv1alpha1.ConditionTypeDataConsistent {
+			return cond.Status == druidv1alpha1.ConditionUnknown || time.Since(cond.LastUpdateTime.Time) >= dataConsistencyCheckInterval
+		}
+	}
+	return true
+}
+
+// getMemberHashes returns the hash of the key-value store of every member, computed at the smallest revision which
+// all members have applied. An error is returned if the members have compacted their key-value stores at different
+// revisions, since the hashes only cover the revisions after the compacted revision.
+func (d *dataConsistentCheck) getMemberHashes(ctx context.Context) (map[string]uint32, error) {
+	memberList, err := d.statusClient.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make(map[string]string, len(memberList.Members))
+	var revision int64
+	for _, member := range memberList.Members {
+		if len(member.ClientURLs) == 0 {
+			return nil, fmt.Errorf("member %s has no client URL", member.Name)
+		}
+		status, err := d.statusClient.Status(ctx, member.ClientURLs[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get status of member %s: %w", member.Name, err)
+		}
+		if revision == 0 || status.Header.GetRevision() < revision {
+			revision = status.Header.GetRevision()
+		}
+		endpoints[member.Name] = member.ClientURLs[0]
+	}
+
+	etcdCl, err := d.statusClient.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The hashes are computed concurrently and within a single deadline, so that an unresponsive member does not
+	// delay the reconciliation of the status.
+	hashCtx, cancel := context.WithTimeout(ctx, hashKVTimeout)
+	defer cancel()
+	type memberHash struct {
+		memberName string
+		hashKV     *clientv3.HashKVResponse
+		err        error
+	}
+	hashCh := make(chan memberHash, len(endpoints))
+	for memberName, endpoint := range endpoints {
+		go func() {
+			hashKV, err := etcdCl.HashKV(hashCtx, endpoint, revision)
+			hashCh <- memberHash{memberName: memberName, hashKV: hashKV, err: err}
+		}()
+	}
+
+	hashes := make(map[string]uint32, len(endpoints))
+	var compactRevision *int64
+	for range endpoints {
+		mh := <-hashCh
+		if mh.err != nil {
+			return nil, fmt.Errorf("failed to compute hash of member %s at revision %d: %w", mh.memberName, revision, mh.err)
+		}
+		if compactRevision != nil && *compactRevision != mh.hashKV.CompactRevision {
+			return nil, fmt.Errorf("members have compacted their key-value stores at different revisions")
+		}
+		compactRevision = &mh.hashKV.CompactRevision
+		hashes[mh.memberName] = mh.hashKV.Hash
+	}
+	return hashes, nil
+}
+
+// getDivergentMembers returns the sorted names of the members whose hash differs from the hash of the majority of the
+// members. All members are considered divergent if no hash is shared by a majority of the members.
+func getDivergentMembers(membersPerHash map[uint32][]string, memberCount int) []string {
+	var divergentMembers []string
+	for _, memberNames := range membersPerHash {
+		if len(memberNames)*2 <= memberCount {
+			divergentMembers = append(divergentMembers, memberNames...)
+		}
+	}
+	slices.Sort(divergentMembers)
+	return divergentMembers
+}
+
+// DataConsistentCheck returns a check for the "DataConsistent" condition, which computes the hashes of the members
+// with the given client.
+func DataConsistentCheck(statusClient *etcdclient.StatusClient) Checker {
+	return &dataConsistentCheck{
+		statusClient: statusClient,
+	}
+}
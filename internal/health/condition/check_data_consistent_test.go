@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DataConsistentCheck", func() {
+	Describe("#Check", func() {
+		var (
+			etcd   druidv1alpha1.Etcd
+			etcdCl *testutils.FakeMaintenanceClient
+		)
+
+		BeforeEach(func() {
+			etcd = druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: "etcd-test"},
+				Spec:       druidv1alpha1.EtcdSpec{Replicas: 3},
+				Status: druidv1alpha1.EtcdStatus{
+					Members: []druidv1alpha1.EtcdMemberStatus{
+						{Name: "etcd-0", Status: druidv1alpha1.EtcdMemberStatusReady},
+						{Name: "etcd-1", Status: druidv1alpha1.EtcdMemberStatusReady},
+						{Name: "etcd-2", Status: druidv1alpha1.EtcdMemberStatusReady},
+					},
+				},
+			}
+			etcdCl = testutils.NewFakeMaintenanceClient()
+			etcdCl.Members = []*etcdserverpb.Member{
+				{ID: 1, Name: "etcd-0", ClientURLs: []string{"http://etcd-0:2379"}},
+				{ID: 2, Name: "etcd-1", ClientURLs: []string{"http://etcd-1:2379"}},
+				{ID: 3, Name: "etcd-2", ClientURLs: []string{"http://etcd-2:2379"}},
+			}
+			etcdCl.Statuses = map[string]*clientv3.StatusResponse{
+				"http://etcd-0:2379": {Header: &etcdserverpb.ResponseHeader{Revision: 120}},
+				"http://etcd-1:2379": {Header: &etcdserverpb.ResponseHeader{Revision: 100}},
+				"http://etcd-2:2379": {Header: &etcdserverpb.ResponseHeader{Revision: 110}},
+			}
+			etcdCl.Hashes = map[string]*clientv3.HashKVResponse{
+				"http://etcd-0:2379": {Hash: 42, CompactRevision: 50},
+				"http://etcd-1:2379": {Hash: 42, CompactRevision: 50},
+				"http://etcd-2:2379": {Hash: 42, CompactRevision: 50},
+			}
+		})
+
+		check := func() Result {
+			statusClient := etcdclient.NewStatusClient(testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil), etcdCl.Factory(), &etcd)
+			defer func() {
+				_ = statusClient.Close()
+			}()
+			return DataConsistentCheck(statusClient).Check(context.Background(), etcd)
+		}
+
+		It("should return that the data is consistent if the hashes of all members match", func() {
+			result := check()
+
+			Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeDataConsistent))
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal(MemberHashesMatch))
+		})
+
+		It("should name the member whose hash differs from the majority", func() {
+			etcdCl.Hashes["http://etcd-2:2379"].Hash = 7
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(MemberDataDivergent))
+			Expect(result.Message()).To(Equal("Data of member(s) etcd-2 diverges from the data of the other members"))
+		})
+
+		It("should name all members if no hash is shared by a majority", func() {
+			etcdCl.Hashes["http://etcd-1:2379"].Hash = 7
+			etcdCl.Hashes["http://etcd-2:2379"].Hash = 8
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Message()).To(Equal("Data of member(s) etcd-0, etcd-1, etcd-2 diverges from the data of the other members"))
+		})
+
+		It("should return that the data consistency is unknown if the members have different compacted revisions", func() {
+			etcdCl.Hashes["http://etcd-1:2379"].CompactRevision = 60
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal("UnableToComputeHashes"))
+		})
+
+		It("should return that the data consistency is unknown if etcd cannot be reached", func() {
+			etcdCl.Err = fmt.Errorf("connection refused")
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal("UnableToComputeHashes"))
+		})
+
+		It("should return that the data consistency is unknown if not all members are ready", func() {
+			etcd.Status.Members[1].Status = druidv1alpha1.EtcdMemberStatusNotReady
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Message()).To(Equal("Not all etcd members are ready"))
+		})
+
+		It("should not return a result if the hashes have been compared recently", func() {
+			etcd.Status.Conditions = []druidv1alpha1.Condition{{
+				Type:           druidv1alpha1.ConditionTypeDataConsistent,
+				Status:         druidv1alpha1.ConditionTrue,
+				LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			}}
+
+			Expect(check()).To(BeNil())
+		})
+
+		It("should return that the data consistency is not checked for a single member cluster", func() {
+			etcd.Spec.Replicas = 1
+			etcd.Status.Members = etcd.Status.Members[:1]
+			etcd.Status.Conditions = []druidv1alpha1.Condition{{
+				Type:           druidv1alpha1.ConditionTypeDataConsistent,
+				Status:         druidv1alpha1.ConditionTrue,
+				LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			}}
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal(NotChecked))
+		})
+	})
+})
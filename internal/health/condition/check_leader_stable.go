@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// maxRecordedLeaderChanges is the maximum number of leader changes which are recorded in the status, it bounds the
+	// size of the status if the leader flaps while a long window is configured.
+	maxRecordedLeaderChanges = 100
+
+	// LeaderStable is a constant that means that the leader has not changed more often than allowed.
+	LeaderStable string = "LeaderStable"
+	// FrequentLeaderChanges is a constant that means that the leader has changed more often than allowed.
+	FrequentLeaderChanges string = "FrequentLeaderChanges"
+	// NoLeaderObserved is a constant that means that no leader has been observed yet.
+	NoLeaderObserved string = "NoLeaderObserved"
+)
+
+type leaderStableCheck struct {
+	window           time.Duration
+	maxLeaderChanges int32
+}
+
+// Check counts the leader changes within the window, including a change of the leader which is reported in the member
+// status but has not been recorded in the leader election status yet.
+func (l *leaderStableCheck) Check(_ context.Context, etcd druidv1alpha1.Etcd) Result {
+	leaderElection, _ := UpdateLeaderElection(etcd, l.window, time.Now().UTC())
+	res := &result{conType: druidv1alpha1.ConditionTypeLeaderStable}
+	switch {
+	case leaderElection.Leader == "":
+		res.status = druidv1alpha1.ConditionUnknown
+		res.reason = NoLeaderObserved
+		res.message = "No leader of the etcd cluster has been observed yet"
+	case leaderElection.LeaderChangeCount > l.maxLeaderChanges:
+		res.status = druidv1alpha1.ConditionFalse
+		res.reason = FrequentLeaderChanges
+		res.message = fmt.Sprintf("Leader changed %d times within the last %s, which exceeds the maximum of %d changes", leaderElection.LeaderChangeCount, l.window, l.maxLeaderChanges)
+	default:
+		res.status = druidv1alpha1.ConditionTrue
+		res.reason = LeaderStable
+		res.message = fmt.Sprintf("Leader changed %d times within the last %s", leaderElection.LeaderChangeCount, l.window)
+	}
+	return res
+}
+
+// LeaderStableCheck returns a check for the "LeaderStable" condition, which is False once the leader has changed more
+// than maxLeaderChanges times within the given window.
+func LeaderStableCheck(window time.Duration, maxLeaderChanges int32) Checker {
+	return &leaderStableCheck{
+		window:           window,
+		maxLeaderChanges: maxLeaderChanges,
+	}
+}
+
+// UpdateLeaderElection returns a copy of the leader election status of the given etcd which records the leader that is
+// currently reported in the member status, and whether a change of the leader has been observed. A change is observed
+// whenever the leader reports a later raft term than the recorded one, which also covers the re-election of the same
+// member. If the raft term is not known, a change is observed if another member has become the leader. The first
+// leader which is observed is not counted as a change. Leader changes which have been observed before the start of the
+// window are dropped.
+func UpdateLeaderElection(etcd druidv1alpha1.Etcd, window time.Duration, now time.Time) (*druidv1alpha1.LeaderElectionStatus, bool) {
+	leaderElection := etcd.Status.LeaderElection.DeepCopy()
+	if leaderElection == nil {
+		leaderElection = &druidv1alpha1.LeaderElectionStatus{}
+	}
+
+	changed := false
+	if leader := getLeader(etcd); leader != nil {
+		if leaderElection.Leader != "" {
+			if leader.RaftTerm != nil && leaderElection.RaftTerm != nil {
+				changed = *leader.RaftTerm > *leaderElection.RaftTerm
+			} else {
+				changed = leader.Name != leaderElection.Leader
+			}
+		}
+		if changed {
+			leaderElection.LastLeaderChangeTime = &metav1.Time{Time: now}
+			leaderElection.LeaderChangeTimes = append(leaderElection.LeaderChangeTimes, metav1.Time{Time: now})
+		}
+		leaderElection.Leader = leader.Name
+		if leader.RaftTerm != nil {
+			leaderElection.RaftTerm = ptr.To(*leader.RaftTerm)
+		}
+	}
+
+	windowStart := now.Add(-window)
+	leaderElection.LeaderChangeTimes = slices.DeleteFunc(leaderElection.LeaderChangeTimes, func(changeTime metav1.Time) bool {
+		return changeTime.Time.Before(windowStart)
+	})
+	if len(leaderElection.LeaderChangeTimes) > maxRecordedLeaderChanges {
+		leaderElection.LeaderChangeTimes = leaderElection.LeaderChangeTimes[len(leaderElection.LeaderChangeTimes)-maxRecordedLeaderChanges:]
+	}
+	leaderElection.LeaderChangeCount = int32(len(leaderElection.LeaderChangeTimes)) // #nosec G115 -- bounded by maxRecordedLeaderChanges, so conversion is safe.
+	return leaderElection, changed
+}
+
+// getLeader returns the ready member which reports itself as the leader, or nil if there is none.
+func getLeader(etcd druidv1alpha1.Etcd) *druidv1alpha1.EtcdMemberStatus {
+	for _, member := range etcd.Status.Members {
+		if member.Status == druidv1alpha1.EtcdMemberStatusReady && member.Role != nil && *member.Role == druidv1alpha1.EtcdRoleLeader {
+			return &member
+		}
+	}
+	return nil
+}
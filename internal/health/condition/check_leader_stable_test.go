@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LeaderStableCheck", func() {
+	var (
+		etcd druidv1alpha1.Etcd
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Now().UTC()
+		etcd = druidv1alpha1.Etcd{
+			Spec: druidv1alpha1.EtcdSpec{Replicas: 3},
+			Status: druidv1alpha1.EtcdStatus{
+				Members: []druidv1alpha1.EtcdMemberStatus{
+					{Name: "etcd-0", Role: ptr.To(druidv1alpha1.EtcdRoleLeader), Status: druidv1alpha1.EtcdMemberStatusReady, RaftTerm: ptr.To[int64](5)},
+					{Name: "etcd-1", Role: ptr.To(druidv1alpha1.EtcdRoleMember), Status: druidv1alpha1.EtcdMemberStatusReady, RaftTerm: ptr.To[int64](5)},
+					{Name: "etcd-2", Role: ptr.To(druidv1alpha1.EtcdRoleMember), Status: druidv1alpha1.EtcdMemberStatusReady, RaftTerm: ptr.To[int64](5)},
+				},
+				LeaderElection: &druidv1alpha1.LeaderElectionStatus{Leader: "etcd-0", RaftTerm: ptr.To[int64](5)},
+			},
+		}
+	})
+
+	Describe("#Check", func() {
+		check := func() Result {
+			return LeaderStableCheck(time.Hour, 2).Check(context.TODO(), etcd)
+		}
+
+		It("should return that the leader is stable", func() {
+			result := check()
+
+			Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeLeaderStable))
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal(LeaderStable))
+		})
+
+		It("should return that the leader stability is unknown if no leader has been observed", func() {
+			etcd.Status.LeaderElection = nil
+			etcd.Status.Members[0].Role = ptr.To(druidv1alpha1.EtcdRoleMember)
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal(NoLeaderObserved))
+		})
+
+		It("should count a leader change which has not been recorded yet", func() {
+			etcd.Status.LeaderElection.LeaderChangeTimes = []metav1.Time{{Time: now.Add(-30 * time.Minute)}, {Time: now.Add(-20 * time.Minute)}}
+			etcd.Status.Members[0].RaftTerm = ptr.To[int64](6)
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(FrequentLeaderChanges))
+			Expect(etcd.Status.LeaderElection.LeaderChangeTimes).To(HaveLen(2))
+		})
+	})
+
+	Describe("#UpdateLeaderElection", func() {
+		It("should not count the first observed leader as a change", func() {
+			etcd.Status.LeaderElection = nil
+
+			leaderElection, changed := UpdateLeaderElection(etcd, time.Hour, now)
+
+			Expect(changed).To(BeFalse())
+			Expect(leaderElection.Leader).To(Equal("etcd-0"))
+			Expect(leaderElection.RaftTerm).To(Equal(ptr.To[int64](5)))
+			Expect(leaderElection.LeaderChangeCount).To(BeZero())
+		})
+
+		It("should not count a leader in the same raft term as a change", func() {
+			leaderElection, changed := UpdateLeaderElection(etcd, time.Hour, now)
+
+			Expect(changed).To(BeFalse())
+			Expect(leaderElection.LastLeaderChangeTime).To(BeNil())
+		})
+
+		It("should count a re-election of the same member in a later raft term as a change", func() {
+			etcd.Status.Members[0].RaftTerm = ptr.To[int64](6)
+
+			leaderElection, changed := UpdateLeaderElection(etcd, time.Hour, now)
+
+			Expect(changed).To(BeTrue())
+			Expect(leaderElection.Leader).To(Equal("etcd-0"))
+			Expect(leaderElection.RaftTerm).To(Equal(ptr.To[int64](6)))
+			Expect(leaderElection.LeaderChangeCount).To(Equal(int32(1)))
+			Expect(leaderElection.LastLeaderChangeTime.Time).To(Equal(now))
+		})
+
+		It("should count a change of the leader if the raft term is not known", func() {
+			etcd.Status.LeaderElection.RaftTerm = nil
+			etcd.Status.Members[0].Role = ptr.To(druidv1alpha1.EtcdRoleMember)
+			etcd.Status.Members[1].Role = ptr.To(druidv1alpha1.EtcdRoleLeader)
+
+			leaderElection, changed := UpdateLeaderElection(etcd, time.Hour, now)
+
+			Expect(changed).To(BeTrue())
+			Expect(leaderElection.Leader).To(Equal("etcd-1"))
+		})
+
+		It("should drop leader changes outside of the window", func() {
+			etcd.Status.LeaderElection.LeaderChangeTimes = []metav1.Time{{Time: now.Add(-3 * time.Hour)}, {Time: now.Add(-30 * time.Minute)}}
+
+			leaderElection, _ := UpdateLeaderElection(etcd, time.Hour, now)
+
+			Expect(leaderElection.LeaderChangeTimes).To(HaveLen(1))
+			Expect(leaderElection.LeaderChangeCount).To(Equal(int32(1)))
+		})
+	})
+})
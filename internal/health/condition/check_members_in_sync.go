@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxRaftIndexLag is the number of raft entries by which the applied index of a member may lag behind the committed
+	// index of the leader. It matches the number of entries etcd keeps after a raft log compaction to let slow
+	// followers catch up without a snapshot.
+	maxRaftIndexLag int64 = 5000
+
+	// AllMembersInSync is a constant that means that the applied index of no member lags behind the leader.
+	AllMembersInSync string = "AllMembersInSync"
+	// MembersLagging is a constant that means that the applied index of at least one member lags behind the leader.
+	MembersLagging string = "MembersLagging"
+)
+
+type membersInSyncCheck struct{}
+
+// Check compares the raft applied index of every ready member with the raft committed index of the leader, as
+// recorded in the status of the members.
+func (m *membersInSyncCheck) Check(_ context.Context, etcd druidv1alpha1.Etcd) Result {
+	res := &result{
+		conType: druidv1alpha1.ConditionTypeMembersInSync,
+		status:  druidv1alpha1.ConditionUnknown,
+		reason:  Unknown,
+	}
+	var leaderRaftIndex *int64
+	for _, member := range etcd.Status.Members {
+		if member.Role != nil && *member.Role == druidv1alpha1.EtcdRoleLeader && member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			leaderRaftIndex = member.RaftIndex
+			break
+		}
+	}
+	if leaderRaftIndex == nil {
+		res.message = "Raft index of the leader is not known"
+		return res
+	}
+
+	var laggingMembers []string
+	for _, member := range etcd.Status.Members {
+		if member.Status != druidv1alpha1.EtcdMemberStatusReady || member.RaftAppliedIndex == nil {
+			continue
+		}
+		if lag := *leaderRaftIndex - *member.RaftAppliedIndex; lag > maxRaftIndexLag {
+			laggingMembers = append(laggingMembers, fmt.Sprintf("%s (%d entries)", member.Name, lag))
+		}
+	}
+	if len(laggingMembers) > 0 {
+		res.status = druidv1alpha1.ConditionFalse
+		res.reason = MembersLagging
+		res.message = fmt.Sprintf("Members lag behind the leader by more than %d raft entries: %s", maxRaftIndexLag, strings.Join(laggingMembers, ", "))
+		return res
+	}
+	res.status = druidv1alpha1.ConditionTrue
+	res.reason = AllMembersInSync
+	res.message = fmt.Sprintf("No member lags behind the leader by more than %d raft entries", maxRaftIndexLag)
+	return res
+}
+
+// MembersInSyncCheck returns a check for the "MembersInSync" condition.
+func MembersInSyncCheck(_ client.Client) Checker {
+	return &membersInSyncCheck{}
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MembersInSyncCheck", func() {
+	Describe("#Check", func() {
+		var etcd druidv1alpha1.Etcd
+
+		BeforeEach(func() {
+			etcd = druidv1alpha1.Etcd{
+				Spec: druidv1alpha1.EtcdSpec{Replicas: 3},
+				Status: druidv1alpha1.EtcdStatus{
+					Members: []druidv1alpha1.EtcdMemberStatus{
+						{Name: "etcd-0", Role: ptr.To(druidv1alpha1.EtcdRoleMember), Status: druidv1alpha1.EtcdMemberStatusReady, RaftIndex: ptr.To[int64](10000), RaftAppliedIndex: ptr.To[int64](9990)},
+						{Name: "etcd-1", Role: ptr.To(druidv1alpha1.EtcdRoleLeader), Status: druidv1alpha1.EtcdMemberStatusReady, RaftIndex: ptr.To[int64](10000), RaftAppliedIndex: ptr.To[int64](10000)},
+						{Name: "etcd-2", Role: ptr.To(druidv1alpha1.EtcdRoleLearner), Status: druidv1alpha1.EtcdMemberStatusReady, RaftIndex: ptr.To[int64](9000), RaftAppliedIndex: ptr.To[int64](8000)},
+					},
+				},
+			}
+		})
+
+		It("should return that all members are in sync", func() {
+			result := MembersInSyncCheck(nil).Check(context.TODO(), etcd)
+
+			Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeMembersInSync))
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal(AllMembersInSync))
+		})
+
+		It("should name the members which lag behind the leader", func() {
+			etcd.Status.Members[2].RaftAppliedIndex = ptr.To[int64](2000)
+
+			result := MembersInSyncCheck(nil).Check(context.TODO(), etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(MembersLagging))
+			Expect(result.Message()).To(Equal("Members lag behind the leader by more than 5000 raft entries: etcd-2 (8000 entries)"))
+		})
+
+		It("should ignore members which are not ready", func() {
+			etcd.Status.Members[2].RaftAppliedIndex = ptr.To[int64](2000)
+			etcd.Status.Members[2].Status = druidv1alpha1.EtcdMemberStatusNotReady
+
+			result := MembersInSyncCheck(nil).Check(context.TODO(), etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+		})
+
+		It("should return that the sync state is unknown if the raft index of the leader is not known", func() {
+			etcd.Status.Members[1].RaftIndex = nil
+
+			result := MembersInSyncCheck(nil).Check(context.TODO(), etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal(Unknown))
+		})
+	})
+})
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	"github.com/gardener/etcd-druid/internal/common"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+const (
+	// NoSpaceAlarmPresent is a constant that means that etcd has raised the NOSPACE alarm because the backend database
+	// of a member has exceeded its quota. etcd only accepts reads and deletes while the alarm is raised.
+	NoSpaceAlarmPresent string = "NoSpaceAlarmPresent"
+	// AlarmsPresent is a constant that means that etcd has raised an alarm other than the NOSPACE alarm.
+	AlarmsPresent string = "AlarmsPresent"
+	// QuotaUsageAboveThreshold is a constant that means that the backend database of a member is close to exceeding its quota.
+	QuotaUsageAboveThreshold string = "QuotaUsageAboveThreshold"
+	// QuotaUsageBelowThreshold is a constant that means that the backend databases of all members are well below their quota.
+	QuotaUsageBelowThreshold string = "QuotaUsageBelowThreshold"
+)
+
+type quotaHealthyCheck struct {
+	statusClient *etcdclient.StatusClient
+}
+
+func (q *quotaHealthyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) Result {
+	res := &result{
+		conType: druidv1alpha1.ConditionTypeQuotaHealthy,
+		status:  druidv1alpha1.ConditionUnknown,
+		reason:  Unknown,
+	}
+	if !slices.ContainsFunc(etcd.Status.Members, func(member druidv1alpha1.EtcdMemberStatus) bool {
+		return member.Status == druidv1alpha1.EtcdMemberStatusReady
+	}) {
+		res.message = "No etcd member is ready"
+		return res
+	}
+
+	alarms, noSpace, err := q.listAlarms(ctx)
+	if err != nil {
+		res.reason = "UnableToListAlarms"
+		res.message = fmt.Sprintf("Unable to list etcd alarms: %s", err.Error())
+		return res
+	}
+	if len(alarms) > 0 {
+		res.status = druidv1alpha1.ConditionFalse
+		res.reason = AlarmsPresent
+		if noSpace {
+			res.reason = NoSpaceAlarmPresent
+		}
+		res.message = fmt.Sprintf("etcd has raised alarms: %s", strings.Join(alarms, ", "))
+		return res
+	}
+
+	quotaBytes := common.DefaultEtcdQuotaBytes
+	if quota := druidv1alpha1.GetQuota(&etcd); quota != nil {
+		quotaBytes = quota.Value()
+	}
+	var (
+		maxDBSize    int64
+		maxDBSizeOf  string
+		dbSizeKnown  bool
+		thresholdMsg = fmt.Sprintf("%d%% of the quota of %d bytes", common.DefaultQuotaUsageThresholdPercent, quotaBytes)
+	)
+	for _, member := range etcd.Status.Members {
+		if member.DBSize == nil {
+			continue
+		}
+		dbSizeKnown = true
+		if member.DBSize.Value() > maxDBSize {
+			maxDBSize, maxDBSizeOf = member.DBSize.Value(), member.Name
+		}
+	}
+	if !dbSizeKnown {
+		res.message = "Size of the backend database is not known for any etcd member"
+		return res
+	}
+	if maxDBSize*100 >= quotaBytes*int64(common.DefaultQuotaUsageThresholdPercent) {
+		res.status = druidv1alpha1.ConditionFalse
+		res.reason = QuotaUsageAboveThreshold
+		res.message = fmt.Sprintf("Backend database of member %s has a size of %d bytes, which exceeds %s", maxDBSizeOf, maxDBSize, thresholdMsg)
+		return res
+	}
+	res.status = druidv1alpha1.ConditionTrue
+	res.reason = QuotaUsageBelowThreshold
+	res.message = fmt.Sprintf("Backend databases of all members are below %s", thresholdMsg)
+	return res
+}
+
+// listAlarms returns the alarms which are raised in the etcd cluster, each formatted as "<alarm> (<member-name>)",
+// and whether the NOSPACE alarm is among them.
+func (q *quotaHealthyCheck) listAlarms(ctx context.Context) ([]string, bool, error) {
+	alarmList, err := q.statusClient.AlarmList(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(alarmList.Alarms) == 0 {
+		return nil, false, nil
+	}
+	memberList, err := q.statusClient.MemberList(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	memberNames := make(map[uint64]string, len(memberList.Members))
+	for _, member := range memberList.Members {
+		memberNames[member.ID] = member.Name
+	}
+	var (
+		alarms  = make([]string, 0, len(alarmList.Alarms))
+		noSpace bool
+	)
+	for _, alarm := range alarmList.Alarms {
+		memberName, ok := memberNames[alarm.MemberID]
+		if !ok {
+			memberName = fmt.Sprintf("%x", alarm.MemberID)
+		}
+		alarms = append(alarms, fmt.Sprintf("%s (%s)", alarm.Alarm.String(), memberName))
+		noSpace = noSpace || alarm.Alarm == etcdserverpb.AlarmType_NOSPACE
+	}
+	slices.Sort(alarms)
+	return alarms, noSpace, nil
+}
+
+// QuotaHealthyCheck returns a check for the "QuotaHealthy" condition, which lists the alarms of etcd with the given client.
+func QuotaHealthyCheck(statusClient *etcdclient.StatusClient) Checker {
+	return &quotaHealthyCheck{
+		statusClient: statusClient,
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuotaHealthyCheck", func() {
+	Describe("#Check", func() {
+		var (
+			etcd   druidv1alpha1.Etcd
+			etcdCl *testutils.FakeMaintenanceClient
+		)
+
+		BeforeEach(func() {
+			etcd = druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: "etcd-test"},
+				Spec: druidv1alpha1.EtcdSpec{
+					Replicas: 3,
+					Etcd:     druidv1alpha1.EtcdConfig{Quota: ptr.To(resource.MustParse("1Gi"))},
+				},
+				Status: druidv1alpha1.EtcdStatus{
+					Members: []druidv1alpha1.EtcdMemberStatus{
+						{Name: "etcd-0", Status: druidv1alpha1.EtcdMemberStatusReady, DBSize: ptr.To(resource.MustParse("100Mi"))},
+						{Name: "etcd-1", Status: druidv1alpha1.EtcdMemberStatusReady, DBSize: ptr.To(resource.MustParse("200Mi"))},
+						{Name: "etcd-2", Status: druidv1alpha1.EtcdMemberStatusUnknown},
+					},
+				},
+			}
+			etcdCl = testutils.NewFakeMaintenanceClient()
+			etcdCl.Members = []*etcdserverpb.Member{{ID: 1, Name: "etcd-0"}, {ID: 2, Name: "etcd-1"}, {ID: 3, Name: "etcd-2"}}
+		})
+
+		check := func() Result {
+			statusClient := etcdclient.NewStatusClient(testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil), etcdCl.Factory(), &etcd)
+			defer func() {
+				_ = statusClient.Close()
+			}()
+			return QuotaHealthyCheck(statusClient).Check(context.Background(), etcd)
+		}
+
+		It("should return that the quota is healthy if all members are below the threshold", func() {
+			result := check()
+
+			Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeQuotaHealthy))
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal(QuotaUsageBelowThreshold))
+		})
+
+		It("should return that the quota is not healthy if a member exceeds the threshold", func() {
+			etcd.Status.Members[1].DBSize = ptr.To(resource.MustParse("900Mi"))
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(QuotaUsageAboveThreshold))
+			Expect(result.Message()).To(ContainSubstring("etcd-1"))
+		})
+
+		It("should return that the quota is not healthy if the NOSPACE alarm is raised", func() {
+			etcdCl.Alarms = []*etcdserverpb.AlarmMember{
+				{MemberID: 2, Alarm: etcdserverpb.AlarmType_NOSPACE},
+				{MemberID: 1, Alarm: etcdserverpb.AlarmType_CORRUPT},
+			}
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(NoSpaceAlarmPresent))
+			Expect(result.Message()).To(Equal("etcd has raised alarms: CORRUPT (etcd-0), NOSPACE (etcd-1)"))
+		})
+
+		It("should return that the quota is not healthy if other alarms are raised", func() {
+			etcdCl.Alarms = []*etcdserverpb.AlarmMember{{MemberID: 1, Alarm: etcdserverpb.AlarmType_CORRUPT}}
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(AlarmsPresent))
+		})
+
+		It("should return that the quota health is unknown if the alarms cannot be listed", func() {
+			etcdCl.Err = fmt.Errorf("connection refused")
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal("UnableToListAlarms"))
+		})
+
+		It("should return that the quota health is unknown if no database size is known", func() {
+			etcd.Status.Members[0].DBSize = nil
+			etcd.Status.Members[1].DBSize = nil
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal(Unknown))
+		})
+
+		It("should return that the quota health is unknown without connecting to etcd if no member is ready", func() {
+			etcdCl.Err = fmt.Errorf("should not connect")
+			for i := range etcd.Status.Members {
+				etcd.Status.Members[i].Status = druidv1alpha1.EtcdMemberStatusNotReady
+			}
+
+			result := check()
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Message()).To(Equal("No etcd member is ready"))
+		})
+	})
+})
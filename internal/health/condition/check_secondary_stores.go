@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReplicationSucceeded is a constant that means that snapshots have been replicated to a secondary store in time.
+	ReplicationSucceeded string = "ReplicationSucceeded"
+	// ReplicationFailed is a constant that means that snapshots have not been replicated to a secondary store in time.
+	ReplicationFailed string = "ReplicationFailed"
+)
+
+// CheckSecondaryStores determines the state of the replication of snapshots to each secondary store of the given etcd
+// from the snapshot lease of the secondary store, which is renewed by etcd-backup-restore whenever a snapshot has been
+// replicated. Full snapshots are expected to be replicated within the full snapshot interval, delta snapshots, if
+// replicated, within twice the delta snapshot period. The previous status of the secondary stores is used to preserve
+// the last transition time of their conditions.
+func CheckSecondaryStores(ctx context.Context, cl client.Client, etcd druidv1alpha1.Etcd, now metav1.Time) []druidv1alpha1.SecondaryStoreStatus {
+	if len(etcd.Spec.Backup.SecondaryStores) == 0 {
+		return nil
+	}
+	previousStatuses := make(map[string]druidv1alpha1.SecondaryStoreStatus, len(etcd.Status.SecondaryStores))
+	for _, status := range etcd.Status.SecondaryStores {
+		previousStatuses[status.Name] = status
+	}
+
+	fullSnapshotInterval := 24 * time.Hour
+	if etcd.Spec.Backup.FullSnapshotSchedule != nil {
+		if interval, err := utils.ComputeScheduleInterval(*etcd.Spec.Backup.FullSnapshotSchedule); err == nil {
+			fullSnapshotInterval = interval
+		}
+	}
+
+	statuses := make([]druidv1alpha1.SecondaryStoreStatus, 0, len(etcd.Spec.Backup.SecondaryStores))
+	for _, secondaryStore := range etcd.Spec.Backup.SecondaryStores {
+		replicationInterval := fullSnapshotInterval
+		if deltaSnapshotPeriod := etcd.Spec.Backup.DeltaSnapshotPeriod; deltaSnapshotPeriod != nil && secondaryStore.ReplicateDeltaSnapshots != nil && *secondaryStore.ReplicateDeltaSnapshots {
+			replicationInterval = 2 * deltaSnapshotPeriod.Duration
+		}
+
+		status := druidv1alpha1.SecondaryStoreStatus{Name: secondaryStore.Name}
+		conditionStatus, reason, message := druidv1alpha1.ConditionUnknown, Unknown, "Cannot determine replication status"
+		lease := &coordinationv1.Lease{}
+		leaseName := druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcd.ObjectMeta, secondaryStore.Name)
+		if err := cl.Get(ctx, client.ObjectKey{Name: leaseName, Namespace: etcd.Namespace}, lease); err != nil {
+			message = fmt.Sprintf("Cannot get snapshot lease %s: %v", leaseName, err)
+		} else if lease.Spec.RenewTime == nil {
+			message = "No snapshot has been replicated yet"
+		} else {
+			status.LastReplicationTime = &metav1.Time{Time: lease.Spec.RenewTime.Time}
+			if now.Sub(lease.Spec.RenewTime.Time) < replicationInterval {
+				conditionStatus, reason, message = druidv1alpha1.ConditionTrue, ReplicationSucceeded, "Snapshot replication succeeded"
+			} else {
+				conditionStatus, reason, message = druidv1alpha1.ConditionFalse, ReplicationFailed, "Stale snapshot lease. Not renewed in a long time"
+			}
+		}
+
+		previous, ok := previousStatuses[secondaryStore.Name]
+		if etcd.Spec.Replicas == 0 {
+			conditionStatus, reason, message = previous.Condition.Status, NotChecked, "etcd cluster has been scaled down"
+			if conditionStatus == "" {
+				conditionStatus = druidv1alpha1.ConditionUnknown
+			}
+		}
+		status.Condition = druidv1alpha1.Condition{
+			Type:               druidv1alpha1.ConditionTypeBackupReplicated,
+			Status:             conditionStatus,
+			LastTransitionTime: previous.Condition.LastTransitionTime,
+			LastUpdateTime:     now,
+			Reason:             reason,
+			Message:            message,
+		}
+		if !ok || previous.Condition.Status != conditionStatus {
+			status.Condition.LastTransitionTime = now
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
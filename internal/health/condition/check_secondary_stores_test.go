@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckSecondaryStores", func() {
+	var (
+		now  v1.Time
+		etcd druidv1alpha1.Etcd
+	)
+
+	newLease := func(storeName string, renewTime *time.Time) *coordinationv1.Lease {
+		lease := &coordinationv1.Lease{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcd.ObjectMeta, storeName),
+				Namespace: etcd.Namespace,
+			},
+		}
+		if renewTime != nil {
+			lease.Spec.RenewTime = &v1.MicroTime{Time: *renewTime}
+		}
+		return lease
+	}
+
+	BeforeEach(func() {
+		now = v1.NewTime(time.Now().Truncate(time.Second))
+		etcd = druidv1alpha1.Etcd{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "test-etcd",
+				Namespace: "default",
+			},
+			Spec: druidv1alpha1.EtcdSpec{
+				Replicas: 3,
+				Backup: druidv1alpha1.BackupSpec{
+					DeltaSnapshotPeriod: &v1.Duration{Duration: 2 * time.Minute},
+					SecondaryStores: []druidv1alpha1.SecondaryStoreSpec{
+						{Name: "full"},
+						{Name: "delta", ReplicateDeltaSnapshots: ptr.To(true)},
+					},
+				},
+			},
+		}
+	})
+
+	It("Should not return any status if no secondary stores are configured", func() {
+		etcd.Spec.Backup.SecondaryStores = nil
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil)
+		Expect(CheckSecondaryStores(context.TODO(), cl, etcd, now)).To(BeNil())
+	})
+
+	It("Should set the condition of each secondary store based on its snapshot lease", func() {
+		recent := now.Add(-time.Minute)
+		stale := now.Add(-time.Hour)
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{
+			newLease("full", &stale),
+			newLease("delta", &stale),
+		})
+
+		statuses := CheckSecondaryStores(context.TODO(), cl, etcd, now)
+		Expect(statuses).To(HaveLen(2))
+		Expect(statuses[0].Name).To(Equal("full"))
+		Expect(statuses[0].Condition.Type).To(Equal(druidv1alpha1.ConditionTypeBackupReplicated))
+		Expect(statuses[0].Condition.Status).To(Equal(druidv1alpha1.ConditionTrue))
+		Expect(statuses[0].Condition.Reason).To(Equal(ReplicationSucceeded))
+		Expect(statuses[0].LastReplicationTime.Time).To(BeTemporally("==", stale))
+		Expect(statuses[1].Name).To(Equal("delta"))
+		Expect(statuses[1].Condition.Status).To(Equal(druidv1alpha1.ConditionFalse))
+		Expect(statuses[1].Condition.Reason).To(Equal(ReplicationFailed))
+
+		cl = testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{
+			newLease("full", &stale),
+			newLease("delta", &recent),
+		})
+		etcd.Status.SecondaryStores = statuses
+		later := v1.NewTime(now.Add(time.Second))
+		updatedStatuses := CheckSecondaryStores(context.TODO(), cl, etcd, later)
+		Expect(updatedStatuses[0].Condition.LastTransitionTime).To(Equal(now))
+		Expect(updatedStatuses[0].Condition.LastUpdateTime).To(Equal(later))
+		Expect(updatedStatuses[1].Condition.Status).To(Equal(druidv1alpha1.ConditionTrue))
+		Expect(updatedStatuses[1].Condition.LastTransitionTime).To(Equal(later))
+	})
+
+	It("Should set status to Unknown if the snapshot lease is missing or has not been renewed yet", func() {
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{newLease("full", nil)})
+
+		statuses := CheckSecondaryStores(context.TODO(), cl, etcd, now)
+		Expect(statuses).To(HaveLen(2))
+		for _, status := range statuses {
+			Expect(status.Condition.Status).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(status.Condition.Reason).To(Equal(Unknown))
+			Expect(status.LastReplicationTime).To(BeNil())
+		}
+		Expect(statuses[0].Condition.Message).To(Equal("No snapshot has been replicated yet"))
+	})
+
+	It("Should keep the previous status with reason NotChecked if the etcd cluster has been scaled down", func() {
+		etcd.Spec.Replicas = 0
+		etcd.Status.SecondaryStores = []druidv1alpha1.SecondaryStoreStatus{
+			{
+				Name: "full",
+				Condition: druidv1alpha1.Condition{
+					Type:               druidv1alpha1.ConditionTypeBackupReplicated,
+					Status:             druidv1alpha1.ConditionTrue,
+					LastTransitionTime: v1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+		}
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil)
+
+		statuses := CheckSecondaryStores(context.TODO(), cl, etcd, now)
+		Expect(statuses).To(HaveLen(2))
+		Expect(statuses[0].Condition.Status).To(Equal(druidv1alpha1.ConditionTrue))
+		Expect(statuses[0].Condition.Reason).To(Equal(NotChecked))
+		Expect(statuses[0].Condition.LastTransitionTime).To(Equal(etcd.Status.SecondaryStores[0].Condition.LastTransitionTime))
+		Expect(statuses[1].Condition.Status).To(Equal(druidv1alpha1.ConditionUnknown))
+		Expect(statuses[1].Condition.Reason).To(Equal(NotChecked))
+	})
+})
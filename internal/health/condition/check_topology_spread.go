@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type topologySpreadSatisfied struct {
+	cl client.Client
+}
+
+// Check reads the zones of the nodes the etcd member pods are scheduled on, and checks that the etcd cluster keeps its
+// quorum if all members in any single zone fail. The check is only executed for multi-member etcd clusters which have
+// opted into the MultiZonal topology policy.
+func (t *topologySpreadSatisfied) Check(ctx context.Context, etcd druidv1alpha1.Etcd) Result {
+	topologyPolicy := etcd.Spec.SchedulingConstraints.TopologyPolicy
+	if topologyPolicy == nil || *topologyPolicy != druidv1alpha1.TopologyPolicyMultiZonal || etcd.Spec.Replicas <= 1 {
+		return nil
+	}
+
+	res := &result{
+		conType: druidv1alpha1.ConditionTypeTopologySpreadSatisfied,
+		status:  druidv1alpha1.ConditionUnknown,
+	}
+
+	membersPerZone := make(map[string]int32)
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		pod := &corev1.Pod{}
+		if err := t.cl.Get(ctx, client.ObjectKey{Name: podName, Namespace: etcd.Namespace}, pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				res.reason = "PodNotFound"
+				res.message = fmt.Sprintf("Pod %s not found", podName)
+				return res
+			}
+			res.reason = "UnableToFetchPod"
+			res.message = fmt.Sprintf("Unable to fetch pod %s: %s", podName, err.Error())
+			return res
+		}
+		if pod.Spec.NodeName == "" {
+			res.reason = "PodNotScheduled"
+			res.message = fmt.Sprintf("Pod %s has not been scheduled to a node yet", podName)
+			return res
+		}
+		node := &corev1.Node{}
+		if err := t.cl.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node); err != nil {
+			res.reason = "UnableToFetchNode"
+			res.message = fmt.Sprintf("Unable to fetch node %s of pod %s: %s", pod.Spec.NodeName, podName, err.Error())
+			return res
+		}
+		zone, ok := node.Labels[corev1.LabelTopologyZone]
+		if !ok || zone == "" {
+			res.reason = "ZoneLabelMissing"
+			res.message = fmt.Sprintf("Node %s of pod %s does not have the %s label", node.Name, podName, corev1.LabelTopologyZone)
+			return res
+		}
+		membersPerZone[zone]++
+	}
+
+	quorum := etcd.Spec.Replicas/2 + 1
+	var criticalZones []string
+	for zone, members := range membersPerZone {
+		if etcd.Spec.Replicas-members < quorum {
+			criticalZones = append(criticalZones, zone)
+		}
+	}
+	if len(criticalZones) > 0 {
+		slices.Sort(criticalZones)
+		res.status = druidv1alpha1.ConditionFalse
+		res.reason = "SingleZoneFailureBreaksQuorum"
+		res.message = fmt.Sprintf("A failure of zone(s) %s would break the quorum of %d out of %d members", strings.Join(criticalZones, ", "), quorum, etcd.Spec.Replicas)
+		return res
+	}
+
+	res.status = druidv1alpha1.ConditionTrue
+	res.reason = "MembersSpreadAcrossZones"
+	res.message = fmt.Sprintf("Members are spread across %d zones, a single zone failure does not break the quorum", len(membersPerZone))
+	return res
+}
+
+// TopologySpreadSatisfiedCheck returns a check for the "TopologySpreadSatisfied" condition.
+func TopologySpreadSatisfiedCheck(cl client.Client) Checker {
+	return &topologySpreadSatisfied{
+		cl: cl,
+	}
+}
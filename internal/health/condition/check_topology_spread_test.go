@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TopologySpreadSatisfiedCheck", func() {
+	Describe("#Check", func() {
+		var etcd druidv1alpha1.Etcd
+
+		BeforeEach(func() {
+			etcd = druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: "etcd-test"},
+				Spec: druidv1alpha1.EtcdSpec{
+					Replicas: 3,
+					SchedulingConstraints: druidv1alpha1.SchedulingConstraints{
+						TopologyPolicy: ptr.To(druidv1alpha1.TopologyPolicyMultiZonal),
+					},
+				},
+			}
+		})
+
+		newNode := func(name, zone string) *corev1.Node {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			if zone != "" {
+				node.Labels = map[string]string{corev1.LabelTopologyZone: zone}
+			}
+			return node
+		}
+		newPod := func(name, nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: etcd.Namespace},
+				Spec:       corev1.PodSpec{NodeName: nodeName},
+			}
+		}
+		check := func(objects ...client.Object) Result {
+			cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, nil, nil, nil, objects)
+			return TopologySpreadSatisfiedCheck(cl).Check(context.Background(), etcd)
+		}
+
+		It("should not return a result if the MultiZonal topology policy is not set", func() {
+			etcd.Spec.SchedulingConstraints.TopologyPolicy = ptr.To(druidv1alpha1.TopologyPolicyZonal)
+			Expect(check()).To(BeNil())
+		})
+
+		It("should not return a result for a single member etcd cluster", func() {
+			etcd.Spec.Replicas = 1
+			Expect(check()).To(BeNil())
+		})
+
+		It("should return True if a single zone failure does not break the quorum", func() {
+			result := check(
+				newNode("node-a", "zone-a"), newNode("node-b", "zone-b"), newNode("node-c", "zone-c"),
+				newPod("etcd-0", "node-a"), newPod("etcd-1", "node-b"), newPod("etcd-2", "node-c"),
+			)
+
+			Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeTopologySpreadSatisfied))
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal("MembersSpreadAcrossZones"))
+		})
+
+		It("should return False if two members are in the same zone", func() {
+			result := check(
+				newNode("node-a1", "zone-a"), newNode("node-a2", "zone-a"), newNode("node-b", "zone-b"),
+				newPod("etcd-0", "node-a1"), newPod("etcd-1", "node-a2"), newPod("etcd-2", "node-b"),
+			)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal("SingleZoneFailureBreaksQuorum"))
+			Expect(result.Message()).To(ContainSubstring("zone-a"))
+		})
+
+		It("should return Unknown if a pod has not been scheduled", func() {
+			result := check(
+				newNode("node-a", "zone-a"), newNode("node-b", "zone-b"),
+				newPod("etcd-0", "node-a"), newPod("etcd-1", "node-b"), newPod("etcd-2", ""),
+			)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal("PodNotScheduled"))
+		})
+
+		It("should return Unknown if a node does not have a zone label", func() {
+			result := check(
+				newNode("node-a", "zone-a"), newNode("node-b", "zone-b"), newNode("node-c", ""),
+				newPod("etcd-0", "node-a"), newPod("etcd-1", "node-b"), newPod("etcd-2", "node-c"),
+			)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal("ZoneLabelMissing"))
+		})
+
+		It("should return Unknown if a pod does not exist", func() {
+			result := check(newNode("node-a", "zone-a"), newPod("etcd-0", "node-a"))
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal("PodNotFound"))
+		})
+	})
+})
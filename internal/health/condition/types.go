@@ -45,3 +45,14 @@ func (r *result) Reason() string {
 func (r *result) Message() string {
 	return r.message
 }
+
+// NewResult returns a condition result with the given values. It can be used by checks which are implemented outside
+// of this package.
+func NewResult(conType druidv1alpha1.ConditionType, status druidv1alpha1.ConditionStatus, reason, message string) Result {
+	return &result{
+		conType: conType,
+		status:  status,
+		reason:  reason,
+		message: message,
+	}
+}
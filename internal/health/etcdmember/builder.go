@@ -85,6 +85,13 @@ func (b *defaultBuilder) Build() []druidv1alpha1.EtcdMemberStatus {
 			Status:             res.Status(),
 			Reason:             res.Reason(),
 			LastTransitionTime: now,
+			DBSize:             res.DBSize(),
+			DBSizeInUse:        res.DBSizeInUse(),
+			EtcdVersion:        res.EtcdVersion(),
+			RaftTerm:           res.RaftTerm(),
+			RaftIndex:          res.RaftIndex(),
+			RaftAppliedIndex:   res.RaftAppliedIndex(),
+			Revision:           res.Revision(),
 		}
 
 		// Don't reset LastTransitionTime if status didn't change
@@ -9,6 +9,7 @@ import (
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -114,6 +115,7 @@ var _ = Describe("Builder", func() {
 						MemberRole:   &memberRoleMember,
 						MemberStatus: druidv1alpha1.EtcdMemberStatusReady,
 						MemberReason: "foo reason",
+						MemberDBSize: ptr.To(resource.MustParse("1Gi")),
 					},
 					&result{
 						MemberID:     ptr.To("1"),
@@ -142,6 +144,7 @@ var _ = Describe("Builder", func() {
 					"Status":             Equal(druidv1alpha1.EtcdMemberStatusReady),
 					"Reason":             Equal("foo reason"),
 					"LastTransitionTime": Equal(metav1.NewTime(now)),
+					"DBSize":             PointTo(Equal(resource.MustParse("1Gi"))),
 				}))
 			})
 		})
@@ -154,6 +157,7 @@ type result struct {
 	MemberRole   *druidv1alpha1.EtcdRole
 	MemberStatus druidv1alpha1.EtcdMemberConditionStatus
 	MemberReason string
+	MemberDBSize *resource.Quantity
 }
 
 func (r *result) ID() *string {
@@ -175,3 +179,31 @@ func (r *result) Reason() string {
 func (r *result) Status() druidv1alpha1.EtcdMemberConditionStatus {
 	return r.MemberStatus
 }
+
+func (r *result) DBSize() *resource.Quantity {
+	return r.MemberDBSize
+}
+
+func (r *result) DBSizeInUse() *resource.Quantity {
+	return nil
+}
+
+func (r *result) EtcdVersion() *string {
+	return nil
+}
+
+func (r *result) RaftTerm() *int64 {
+	return nil
+}
+
+func (r *result) RaftIndex() *int64 {
+	return nil
+}
+
+func (r *result) RaftAppliedIndex() *int64 {
+	return nil
+}
+
+func (r *result) Revision() *int64 {
+	return nil
+}
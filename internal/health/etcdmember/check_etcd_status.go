@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdmember
+
+import (
+	"context"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+type etcdStatusCheck struct {
+	logger       logr.Logger
+	statusClient *etcdclient.StatusClient
+}
+
+// Check adds the status reported by etcd, such as the size of the backend database, the raft indices and the etcd
+// version, to the members which are ready. Members which etcd reports as learners are assigned the `Learner` role.
+// The status of the members is taken over from the previous check.
+func (d *etcdStatusCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) []Result {
+	results := make([]Result, 0, len(etcd.Status.Members))
+	readyMembers := make(map[string]*result)
+	for _, member := range etcd.Status.Members {
+		res := &result{
+			id:     member.ID,
+			name:   member.Name,
+			role:   member.Role,
+			status: member.Status,
+			reason: member.Reason,
+		}
+		if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			readyMembers[member.Name] = res
+		}
+		results = append(results, res)
+	}
+	if len(readyMembers) == 0 {
+		return results
+	}
+
+	memberList, err := d.statusClient.MemberList(ctx)
+	if err != nil {
+		d.logger.Error(err, "failed to list etcd members")
+		return results
+	}
+	for _, member := range memberList.Members {
+		res, ok := readyMembers[member.Name]
+		if !ok || len(member.ClientURLs) == 0 {
+			continue
+		}
+		status, err := d.statusClient.Status(ctx, member.ClientURLs[0])
+		if err != nil {
+			d.logger.Error(err, "failed to get status of etcd member", "name", member.Name)
+			continue
+		}
+		res.dbSize = resource.NewQuantity(status.DbSize, resource.BinarySI)
+		res.dbSizeInUse = resource.NewQuantity(status.DbSizeInUse, resource.BinarySI)
+		res.etcdVersion = ptr.To(status.Version)
+		res.raftTerm = ptr.To(int64(status.RaftTerm))
+		res.raftIndex = ptr.To(int64(status.RaftIndex))
+		res.raftAppliedIndex = ptr.To(int64(status.RaftAppliedIndex))
+		res.revision = ptr.To(status.Header.GetRevision())
+		if status.IsLearner {
+			res.role = ptr.To(druidv1alpha1.EtcdRoleLearner)
+		}
+	}
+	return results
+}
+
+// EtcdStatusCheck returns a check which records the status of the etcd members as reported by etcd, which is queried
+// with the given client.
+func EtcdStatusCheck(logger logr.Logger, statusClient *etcdclient.StatusClient) Checker {
+	return &etcdStatusCheck{
+		logger:       logger,
+		statusClient: statusClient,
+	}
+}
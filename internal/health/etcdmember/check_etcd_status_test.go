@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdmember_test
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/etcd-druid/internal/health/etcdmember"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("EtcdStatusCheck", func() {
+	Describe("#Check", func() {
+		var (
+			etcd   druidv1alpha1.Etcd
+			etcdCl *testutils.FakeMaintenanceClient
+		)
+
+		BeforeEach(func() {
+			etcd = druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: "etcd-test"},
+				Spec:       druidv1alpha1.EtcdSpec{Replicas: 3},
+				Status: druidv1alpha1.EtcdStatus{
+					Members: []druidv1alpha1.EtcdMemberStatus{
+						{Name: "etcd-0", ID: ptr.To("1"), Role: ptr.To(druidv1alpha1.EtcdRoleLeader), Status: druidv1alpha1.EtcdMemberStatusReady, Reason: "LeaseSucceeded"},
+						{Name: "etcd-1", ID: ptr.To("2"), Role: ptr.To(druidv1alpha1.EtcdRoleMember), Status: druidv1alpha1.EtcdMemberStatusReady, Reason: "LeaseSucceeded"},
+						{Name: "etcd-2", ID: ptr.To("3"), Role: ptr.To(druidv1alpha1.EtcdRoleMember), Status: druidv1alpha1.EtcdMemberStatusNotReady, Reason: "ContainersNotReady"},
+					},
+				},
+			}
+			etcdCl = testutils.NewFakeMaintenanceClient()
+			etcdCl.Members = []*etcdserverpb.Member{
+				{ID: 1, Name: "etcd-0", ClientURLs: []string{"http://etcd-0:2379"}},
+				{ID: 2, Name: "etcd-1", ClientURLs: []string{"http://etcd-1:2379"}},
+				{ID: 3, Name: "etcd-2", ClientURLs: []string{"http://etcd-2:2379"}},
+			}
+			etcdCl.Statuses = map[string]*clientv3.StatusResponse{
+				"http://etcd-0:2379": {
+					Header:           &etcdserverpb.ResponseHeader{Revision: 42},
+					Version:          "3.5.21",
+					DbSize:           1024,
+					DbSizeInUse:      512,
+					RaftTerm:         3,
+					RaftIndex:        100,
+					RaftAppliedIndex: 99,
+				},
+				"http://etcd-2:2379": {DbSize: 4096, DbSizeInUse: 4096},
+			}
+		})
+
+		check := func() []Result {
+			statusClient := etcdclient.NewStatusClient(testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil), etcdCl.Factory(), &etcd)
+			defer func() {
+				_ = statusClient.Close()
+			}()
+			return EtcdStatusCheck(logr.Discard(), statusClient).Check(context.Background(), etcd)
+		}
+
+		It("should add the status reported by etcd to the ready members and keep their status", func() {
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].Name()).To(Equal("etcd-0"))
+			Expect(results[0].Role()).To(PointTo(Equal(druidv1alpha1.EtcdRoleLeader)))
+			Expect(results[0].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+			Expect(results[0].Reason()).To(Equal("LeaseSucceeded"))
+			Expect(results[0].DBSize()).To(PointTo(Equal(*resource.NewQuantity(1024, resource.BinarySI))))
+			Expect(results[0].DBSizeInUse()).To(PointTo(Equal(*resource.NewQuantity(512, resource.BinarySI))))
+			Expect(results[0].EtcdVersion()).To(PointTo(Equal("3.5.21")))
+			Expect(results[0].RaftTerm()).To(PointTo(Equal(int64(3))))
+			Expect(results[0].RaftIndex()).To(PointTo(Equal(int64(100))))
+			Expect(results[0].RaftAppliedIndex()).To(PointTo(Equal(int64(99))))
+			Expect(results[0].Revision()).To(PointTo(Equal(int64(42))))
+			// The status of etcd-1 cannot be retrieved.
+			Expect(results[1].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+			Expect(results[1].DBSize()).To(BeNil())
+			// etcd-2 is not ready and therefore not queried.
+			Expect(results[2].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(results[2].DBSize()).To(BeNil())
+		})
+
+		It("should assign the learner role to members which etcd reports as learners", func() {
+			etcdCl.Statuses["http://etcd-1:2379"] = &clientv3.StatusResponse{IsLearner: true}
+
+			results := check()
+
+			Expect(results[1].Role()).To(PointTo(Equal(druidv1alpha1.EtcdRoleLearner)))
+			Expect(results[0].Role()).To(PointTo(Equal(druidv1alpha1.EtcdRoleLeader)))
+		})
+
+		It("should keep the status of the members if etcd cannot be reached", func() {
+			etcdCl.Err = fmt.Errorf("connection refused")
+
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			for _, res := range results {
+				Expect(res.DBSize()).To(BeNil())
+			}
+			Expect(results[2].Reason()).To(Equal("ContainersNotReady"))
+		})
+	})
+})
@@ -129,6 +129,9 @@ func asEtcdRole(roleStr string) *druidv1alpha1.EtcdRole {
 	case druidv1alpha1.EtcdRoleMember:
 		role := druidv1alpha1.EtcdRoleMember
 		return &role
+	case druidv1alpha1.EtcdRoleLearner:
+		role := druidv1alpha1.EtcdRoleLearner
+		return &role
 	default:
 		return nil
 	}
@@ -8,6 +8,8 @@ import (
 	"context"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Checker is an interface to check the members of an etcd cluster.
@@ -22,14 +24,28 @@ type Result interface {
 	Role() *druidv1alpha1.EtcdRole
 	Status() druidv1alpha1.EtcdMemberConditionStatus
 	Reason() string
+	DBSize() *resource.Quantity
+	DBSizeInUse() *resource.Quantity
+	EtcdVersion() *string
+	RaftTerm() *int64
+	RaftIndex() *int64
+	RaftAppliedIndex() *int64
+	Revision() *int64
 }
 
 type result struct {
-	id     *string
-	name   string
-	role   *druidv1alpha1.EtcdRole
-	status druidv1alpha1.EtcdMemberConditionStatus
-	reason string
+	id               *string
+	name             string
+	role             *druidv1alpha1.EtcdRole
+	status           druidv1alpha1.EtcdMemberConditionStatus
+	reason           string
+	dbSize           *resource.Quantity
+	dbSizeInUse      *resource.Quantity
+	etcdVersion      *string
+	raftTerm         *int64
+	raftIndex        *int64
+	raftAppliedIndex *int64
+	revision         *int64
 }
 
 func (r *result) ID() *string {
@@ -51,3 +67,50 @@ func (r *result) Status() druidv1alpha1.EtcdMemberConditionStatus {
 func (r *result) Reason() string {
 	return r.reason
 }
+
+func (r *result) DBSize() *resource.Quantity {
+	return r.dbSize
+}
+
+func (r *result) DBSizeInUse() *resource.Quantity {
+	return r.dbSizeInUse
+}
+
+func (r *result) EtcdVersion() *string {
+	return r.etcdVersion
+}
+
+func (r *result) RaftTerm() *int64 {
+	return r.raftTerm
+}
+
+func (r *result) RaftIndex() *int64 {
+	return r.raftIndex
+}
+
+func (r *result) RaftAppliedIndex() *int64 {
+	return r.raftAppliedIndex
+}
+
+func (r *result) Revision() *int64 {
+	return r.revision
+}
+
+// ResultFromStatus returns a result which carries the values of the given etcd member status. It can be used by checks
+// which are implemented outside of this package to keep the status of a member.
+func ResultFromStatus(member druidv1alpha1.EtcdMemberStatus) Result {
+	return &result{
+		id:               member.ID,
+		name:             member.Name,
+		role:             member.Role,
+		status:           member.Status,
+		reason:           member.Reason,
+		dbSize:           member.DBSize,
+		dbSizeInUse:      member.DBSizeInUse,
+		etcdVersion:      member.EtcdVersion,
+		raftTerm:         member.RaftTerm,
+		raftIndex:        member.RaftIndex,
+		raftAppliedIndex: member.RaftAppliedIndex,
+		revision:         member.Revision,
+	}
+}
@@ -6,10 +6,12 @@ package status
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
 	"github.com/gardener/etcd-druid/internal/health/condition"
 	"github.com/gardener/etcd-druid/internal/health/etcdmember"
 
@@ -40,6 +42,8 @@ var (
 		condition.BackupReadyCheck,
 		condition.DataVolumesReadyCheck,
 		condition.ClusterIDMismatchCheck,
+		condition.MembersInSyncCheck,
+		condition.TopologySpreadSatisfiedCheck,
 	}
 	// EtcdMemberChecks are the etcd member checks.
 	EtcdMemberChecks = []EtcdMemberCheckFn{
@@ -50,27 +54,45 @@ var (
 // Checker checks Etcd status conditions and the status of the Etcd members.
 type Checker struct {
 	cl                          client.Client
+	newMaintenanceClient        etcdclient.MaintenanceClientFactory
 	etcdMemberNotReadyThreshold time.Duration
 	etcdMemberUnknownThreshold  time.Duration
 	conditionCheckFns           []ConditionCheckFn
 	conditionBuilderFn          func() condition.Builder
 	etcdMemberCheckFns          []EtcdMemberCheckFn
 	etcdMemberBuilderFn         func() etcdmember.Builder
+	extraChecks                 ExtraChecks
 }
 
 // Check executes the status checks and mutates the passed status object with the corresponding results.
 func (c *Checker) Check(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) error {
+	etcdMemberCheckFns, conditionCheckFns := c.etcdMemberCheckFns, c.conditionCheckFns
+	if c.newMaintenanceClient != nil {
+		// The checks which query etcd share one client, so that etcd is asked only once per status sync.
+		statusClient := etcdclient.NewStatusClient(c.cl, c.newMaintenanceClient, etcd)
+		defer func() {
+			_ = statusClient.Close()
+		}()
+		etcdMemberCheckFns = append(slices.Clone(etcdMemberCheckFns), func(_ client.Client, logger logr.Logger, _, _ time.Duration) etcdmember.Checker {
+			return etcdmember.EtcdStatusCheck(logger, statusClient)
+		})
+		conditionCheckFns = append(slices.Clone(conditionCheckFns),
+			func(client.Client) condition.Checker { return condition.QuotaHealthyCheck(statusClient) },
+			func(client.Client) condition.Checker { return condition.DataConsistentCheck(statusClient) },
+		)
+	}
+
 	// First execute the etcd member checks for the status.
-	if err := c.executeEtcdMemberChecks(ctx, logger, etcd); err != nil {
+	if err := c.executeEtcdMemberChecks(ctx, logger, etcd, etcdMemberCheckFns); err != nil {
 		return err
 	}
 
 	// Execute condition checks after the etcd member checks because we need their result here.
-	return c.executeConditionChecks(ctx, etcd)
+	return c.executeConditionChecks(ctx, etcd, conditionCheckFns)
 }
 
 // executeConditionChecks runs all registered condition checks **in parallel**.
-func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha1.Etcd) error {
+func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha1.Etcd, conditionCheckFns []ConditionCheckFn) error {
 	var (
 		resultCh = make(chan condition.Result)
 
@@ -78,7 +100,7 @@ func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha
 	)
 
 	// Run condition checks in parallel since each check work independently of each other.
-	for _, newCheck := range c.conditionCheckFns {
+	for _, newCheck := range slices.Concat(conditionCheckFns, c.extraChecks.conditionCheckFns) {
 		c := newCheck(c.cl)
 		wg.Add(1)
 		go (func() {
@@ -103,15 +125,18 @@ func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha
 		WithResults(results).
 		Build(etcd.Spec.Replicas)
 
-	etcd.Status.Conditions = conditions
+	// The conditions of registered checks which are not enabled are not updated anymore and are therefore removed.
+	etcd.Status.Conditions = slices.DeleteFunc(conditions, func(cond druidv1alpha1.Condition) bool {
+		return c.extraChecks.disabledConditionTypes.Has(cond.Type)
+	})
 	return nil
 }
 
 // executeEtcdMemberChecks runs all registered etcd member checks **sequentially**.
 // The result of a check is passed via the `status` sub-resources to the next check.
-func (c *Checker) executeEtcdMemberChecks(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) error {
+func (c *Checker) executeEtcdMemberChecks(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, etcdMemberCheckFns []EtcdMemberCheckFn) error {
 	// Run etcd member checks sequentially as most of them act on multiple elements.
-	for _, newCheck := range c.etcdMemberCheckFns {
+	for _, newCheck := range slices.Concat(etcdMemberCheckFns, c.extraChecks.etcdMemberCheckFns) {
 		results := newCheck(c.cl, logger, c.etcdMemberNotReadyThreshold, c.etcdMemberUnknownThreshold).Check(ctx, *etcd)
 
 		// Build and assign the results after each check, so that the next check
@@ -139,3 +164,26 @@ func NewChecker(cl client.Client, etcdMemberNotReadyThreshold, etcdMemberUnknown
 		etcdMemberBuilderFn:         NewDefaultEtcdMemberBuilder,
 	}
 }
+
+// WithLeaderStability adds the check for the LeaderStable condition, which counts the leader changes within the given
+// window.
+func (c *Checker) WithLeaderStability(window time.Duration, maxLeaderChanges int32) *Checker {
+	c.conditionCheckFns = append(slices.Clone(c.conditionCheckFns), func(client.Client) condition.Checker {
+		return condition.LeaderStableCheck(window, maxLeaderChanges)
+	})
+	return c
+}
+
+// WithEtcdClient adds the checks which query etcd, i.e. the etcd member check recording the status reported by etcd and
+// the checks for the QuotaHealthy and DataConsistent conditions. The checks share one client to etcd per status sync,
+// which is created with the given factory.
+func (c *Checker) WithEtcdClient(newMaintenanceClient etcdclient.MaintenanceClientFactory) *Checker {
+	c.newMaintenanceClient = newMaintenanceClient
+	return c
+}
+
+// WithExtraChecks adds the given extra checks, which are executed after the built-in checks of the same kind.
+func (c *Checker) WithExtraChecks(extraChecks ExtraChecks) *Checker {
+	c.extraChecks = extraChecks
+	return c
+}
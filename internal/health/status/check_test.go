@@ -13,8 +13,12 @@ import (
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/health/condition"
 	"github.com/gardener/etcd-druid/internal/health/etcdmember"
+	testutils "github.com/gardener/etcd-druid/test/utils"
 
 	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -213,6 +217,44 @@ var _ = Describe("Check", func() {
 			))
 
 		})
+
+		It("should share one client to etcd between the checks which query etcd", func() {
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: "etcd-test"},
+				Spec:       druidv1alpha1.EtcdSpec{Replicas: 3},
+			}
+			etcdCl := testutils.NewFakeMaintenanceClient()
+			for i := 1; i <= 3; i++ {
+				endpoint := fmt.Sprintf("http://member%d:2379", i)
+				etcdCl.Members = append(etcdCl.Members, &etcdserverpb.Member{ID: uint64(i), Name: fmt.Sprintf("member%d", i), ClientURLs: []string{endpoint}})
+				etcdCl.Statuses[endpoint] = &clientv3.StatusResponse{Header: &etcdserverpb.ResponseHeader{Revision: 100}, DbSize: 1024}
+				etcdCl.Hashes[endpoint] = &clientv3.HashKVResponse{Hash: 42}
+			}
+
+			defer withVar(&ConditionChecks, []ConditionCheckFn{})()
+			defer withVar(&EtcdMemberChecks, []EtcdMemberCheckFn{
+				func(_ client.Client, _ logr.Logger, _, _ time.Duration) etcdmember.Checker {
+					return createEtcdMemberCheck(
+						etcdMemberResult{ptr.To("1"), "member1", ptr.To[druidv1alpha1.EtcdRole](druidv1alpha1.EtcdRoleLeader), druidv1alpha1.EtcdMemberStatusReady, "LeaseSucceeded"},
+						etcdMemberResult{ptr.To("2"), "member2", ptr.To[druidv1alpha1.EtcdRole](druidv1alpha1.EtcdRoleMember), druidv1alpha1.EtcdMemberStatusReady, "LeaseSucceeded"},
+						etcdMemberResult{ptr.To("3"), "member3", ptr.To[druidv1alpha1.EtcdRole](druidv1alpha1.EtcdRoleMember), druidv1alpha1.EtcdMemberStatusReady, "LeaseSucceeded"},
+					)
+				},
+			})()
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute).WithEtcdClient(etcdCl.Factory())
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(etcd.Status.Members).To(HaveEach(MatchFields(IgnoreExtras, Fields{"DBSize": PointTo(Equal(*resource.NewQuantity(1024, resource.BinarySI)))})))
+			Expect(etcd.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(druidv1alpha1.ConditionTypeQuotaHealthy), "Status": Equal(druidv1alpha1.ConditionTrue)}),
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(druidv1alpha1.ConditionTypeDataConsistent), "Status": Equal(druidv1alpha1.ConditionTrue)}),
+			))
+			Expect(etcdCl.ClientsCreated()).To(Equal(1))
+			for i := 1; i <= 3; i++ {
+				Expect(etcdCl.StatusCalls(fmt.Sprintf("http://member%d:2379", i))).To(Equal(1))
+			}
+		})
 	})
 })
 
@@ -286,6 +328,34 @@ func (r *etcdMemberResult) Reason() string {
 	return r.reason
 }
 
+func (r *etcdMemberResult) DBSize() *resource.Quantity {
+	return nil
+}
+
+func (r *etcdMemberResult) DBSizeInUse() *resource.Quantity {
+	return nil
+}
+
+func (r *etcdMemberResult) EtcdVersion() *string {
+	return nil
+}
+
+func (r *etcdMemberResult) RaftTerm() *int64 {
+	return nil
+}
+
+func (r *etcdMemberResult) RaftIndex() *int64 {
+	return nil
+}
+
+func (r *etcdMemberResult) RaftAppliedIndex() *int64 {
+	return nil
+}
+
+func (r *etcdMemberResult) Revision() *int64 {
+	return nil
+}
+
 type etcdMemberTestChecker struct {
 	results []etcdMemberResult
 }
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+	"github.com/gardener/etcd-druid/internal/health/etcdmember"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ExtraCheckTimedOut is the reason of the condition of an extra condition check which did not complete within its timeout.
+	ExtraCheckTimedOut = "ExtraCheckTimedOut"
+	// ExtraCheckFailed is the reason of the condition of an extra condition check which panicked.
+	ExtraCheckFailed = "ExtraCheckFailed"
+)
+
+type registeredConditionCheck struct {
+	conditionType druidv1alpha1.ConditionType
+	checkFn       ConditionCheckFn
+}
+
+// builtInConditionTypes are the condition types which are maintained by etcd-druid itself and cannot be claimed by a
+// registered condition check.
+var builtInConditionTypes = sets.New(
+	druidv1alpha1.ConditionTypeReady,
+	druidv1alpha1.ConditionTypeAllMembersReady,
+	druidv1alpha1.ConditionTypeAllMembersUpdated,
+	druidv1alpha1.ConditionTypeBackupReady,
+	druidv1alpha1.ConditionTypeBackupReplicated,
+	druidv1alpha1.ConditionTypeDataVolumesReady,
+	druidv1alpha1.ConditionTypeClusterIDMismatch,
+	druidv1alpha1.ConditionTypeQuotaHealthy,
+	druidv1alpha1.ConditionTypeDataConsistent,
+	druidv1alpha1.ConditionTypeMembersInSync,
+	druidv1alpha1.ConditionTypeLeaderStable,
+	druidv1alpha1.ConditionTypeTopologySpreadSatisfied,
+	druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded,
+	druidv1alpha1.ConditionTypeSnapshotCompactionBackoff,
+)
+
+var (
+	registryMu            sync.RWMutex
+	extraConditionChecks  = map[string]registeredConditionCheck{}
+	extraEtcdMemberChecks = map[string]EtcdMemberCheckFn{}
+)
+
+// RegisterConditionCheck registers a condition check in addition to the built-in condition checks. The check must only
+// return results for the given condition type. It is executed once it is enabled by its name in the operator
+// configuration. RegisterConditionCheck is meant to be called during the initialization of the operator and panics if
+// a condition check has already been registered with the same name or if the condition type is a built-in one.
+func RegisterConditionCheck(name string, conditionType druidv1alpha1.ConditionType, checkFn ConditionCheckFn) {
+	if builtInConditionTypes.Has(conditionType) {
+		panic(fmt.Sprintf("condition check %q cannot be registered for the built-in condition type %s", name, conditionType))
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := extraConditionChecks[name]; ok {
+		panic(fmt.Sprintf("condition check %q has already been registered", name))
+	}
+	extraConditionChecks[name] = registeredConditionCheck{conditionType: conditionType, checkFn: checkFn}
+}
+
+// RegisterEtcdMemberCheck registers an etcd member check in addition to the built-in etcd member checks. As for the
+// built-in checks, the members of the Etcd status are rebuilt from the results of the check, it must therefore return
+// a result for every member. It is executed once it is enabled by its name in the operator configuration.
+// RegisterEtcdMemberCheck is meant to be called during the initialization of the operator and panics if an etcd member
+// check has already been registered with the same name.
+func RegisterEtcdMemberCheck(name string, checkFn EtcdMemberCheckFn) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := extraEtcdMemberChecks[name]; ok {
+		panic(fmt.Sprintf("etcd member check %q has already been registered", name))
+	}
+	extraEtcdMemberChecks[name] = checkFn
+}
+
+// ExtraChecks are the registered checks which are enabled in the operator configuration. Each check is executed with
+// its own timeout and in isolation from the other checks, i.e. a check which times out or panics does not affect the
+// results of the other checks.
+type ExtraChecks struct {
+	conditionCheckFns  []ConditionCheckFn
+	etcdMemberCheckFns []EtcdMemberCheckFn
+	// disabledConditionTypes are the condition types of the registered condition checks which are not enabled. Their
+	// conditions are removed from the status, since they are no longer updated.
+	disabledConditionTypes sets.Set[druidv1alpha1.ConditionType]
+}
+
+// NewExtraChecks returns the registered checks which are enabled in the given configuration. An error is returned if
+// a check is enabled which has not been registered.
+func NewExtraChecks(config druidconfigv1alpha1.ExtraChecksConfiguration) (ExtraChecks, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	var extraChecks ExtraChecks
+	enabledConditionTypes := sets.New[druidv1alpha1.ConditionType]()
+	for _, extraCheck := range config.Conditions {
+		registered, ok := extraConditionChecks[extraCheck.Name]
+		if !ok {
+			return ExtraChecks{}, fmt.Errorf("condition check %q is enabled but has not been registered", extraCheck.Name)
+		}
+		extraChecks.conditionCheckFns = append(extraChecks.conditionCheckFns, newIsolatedConditionCheckFn(extraCheck, registered))
+		enabledConditionTypes.Insert(registered.conditionType)
+	}
+	extraChecks.disabledConditionTypes = sets.New[druidv1alpha1.ConditionType]()
+	for _, registered := range extraConditionChecks {
+		if !enabledConditionTypes.Has(registered.conditionType) {
+			extraChecks.disabledConditionTypes.Insert(registered.conditionType)
+		}
+	}
+	for _, extraCheck := range config.EtcdMembers {
+		checkFn, ok := extraEtcdMemberChecks[extraCheck.Name]
+		if !ok {
+			return ExtraChecks{}, fmt.Errorf("etcd member check %q is enabled but has not been registered", extraCheck.Name)
+		}
+		extraChecks.etcdMemberCheckFns = append(extraChecks.etcdMemberCheckFns, newIsolatedEtcdMemberCheckFn(extraCheck, checkFn))
+	}
+	return extraChecks, nil
+}
+
+func getTimeout(extraCheck druidconfigv1alpha1.ExtraCheck) time.Duration {
+	if extraCheck.Timeout == nil {
+		return druidconfigv1alpha1.DefaultExtraCheckTimeout
+	}
+	return extraCheck.Timeout.Duration
+}
+
+// isolatedConditionCheck executes an extra condition check with a timeout. If the check does not complete within the
+// timeout or panics, an Unknown result is returned for its condition type.
+type isolatedConditionCheck struct {
+	name          string
+	conditionType druidv1alpha1.ConditionType
+	timeout       time.Duration
+	newChecker    func() condition.Checker
+}
+
+func newIsolatedConditionCheckFn(extraCheck druidconfigv1alpha1.ExtraCheck, registered registeredConditionCheck) ConditionCheckFn {
+	return func(cl client.Client) condition.Checker {
+		return &isolatedConditionCheck{
+			name:          extraCheck.Name,
+			conditionType: registered.conditionType,
+			timeout:       getTimeout(extraCheck),
+			newChecker:    func() condition.Checker { return registered.checkFn(cl) },
+		}
+	}
+}
+
+func (i *isolatedConditionCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) condition.Result {
+	result, err := runIsolated(ctx, i.timeout, func(ctx context.Context) condition.Result {
+		return i.newChecker().Check(ctx, *etcd.DeepCopy())
+	})
+	if err != nil {
+		reason := ExtraCheckFailed
+		if errors.Is(err, context.DeadlineExceeded) {
+			reason = ExtraCheckTimedOut
+		}
+		return condition.NewResult(i.conditionType, druidv1alpha1.ConditionUnknown, reason, fmt.Sprintf("Check %s did not complete: %v", i.name, err))
+	}
+	if result != nil && result.ConditionType() != i.conditionType {
+		return condition.NewResult(i.conditionType, druidv1alpha1.ConditionUnknown, ExtraCheckFailed,
+			fmt.Sprintf("Check %s returned a result for condition type %s", i.name, result.ConditionType()))
+	}
+	return result
+}
+
+// isolatedEtcdMemberCheck executes an extra etcd member check with a timeout. If the check does not complete within
+// the timeout or panics, the error is logged and the members of the Etcd status are kept as they are.
+type isolatedEtcdMemberCheck struct {
+	name       string
+	timeout    time.Duration
+	newChecker func() etcdmember.Checker
+	logger     logr.Logger
+}
+
+func newIsolatedEtcdMemberCheckFn(extraCheck druidconfigv1alpha1.ExtraCheck, checkFn EtcdMemberCheckFn) EtcdMemberCheckFn {
+	return func(cl client.Client, logger logr.Logger, notReadyThreshold, unknownThreshold time.Duration) etcdmember.Checker {
+		return &isolatedEtcdMemberCheck{
+			name:    extraCheck.Name,
+			timeout: getTimeout(extraCheck),
+			newChecker: func() etcdmember.Checker {
+				return checkFn(cl, logger, notReadyThreshold, unknownThreshold)
+			},
+			logger: logger,
+		}
+	}
+}
+
+func (i *isolatedEtcdMemberCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) []etcdmember.Result {
+	results, err := runIsolated(ctx, i.timeout, func(ctx context.Context) []etcdmember.Result {
+		return i.newChecker().Check(ctx, *etcd.DeepCopy())
+	})
+	if err == nil {
+		return results
+	}
+	i.logger.Error(err, "Etcd member check did not complete, keeping the members of the Etcd status", "check", i.name)
+	results = make([]etcdmember.Result, 0, len(etcd.Status.Members))
+	for _, member := range etcd.Status.Members {
+		results = append(results, etcdmember.ResultFromStatus(member))
+	}
+	return results
+}
+
+// runIsolated runs checkFn with the given timeout. An error is returned if checkFn does not return within the timeout
+// or panics. checkFn is expected to respect the cancellation of its context, otherwise it keeps running in the
+// background after the timeout has expired.
+func runIsolated[T any](ctx context.Context, timeout time.Duration, checkFn func(context.Context) T) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type outcome struct {
+		result T
+		err    error
+	}
+	outcomeCh := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				outcomeCh <- outcome{err: fmt.Errorf("check panicked: %v", r)}
+			}
+		}()
+		outcomeCh <- outcome{result: checkFn(ctx)}
+	}()
+
+	select {
+	case o := <-outcomeCh:
+		return o.result, o.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status_test
+
+import (
+	"context"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+	"github.com/gardener/etcd-druid/internal/health/etcdmember"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	. "github.com/gardener/etcd-druid/internal/health/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	conditionTypeZoneSpread    druidv1alpha1.ConditionType = "ZoneSpread"
+	conditionTypeBucketRegion  druidv1alpha1.ConditionType = "BackupBucketInAllowedRegion"
+	conditionTypeSlowCheck     druidv1alpha1.ConditionType = "SlowCheck"
+	conditionTypePanickedCheck druidv1alpha1.ConditionType = "PanickedCheck"
+)
+
+type conditionCheckFunc func(ctx context.Context, etcd druidv1alpha1.Etcd) condition.Result
+
+func (f conditionCheckFunc) Check(ctx context.Context, etcd druidv1alpha1.Etcd) condition.Result {
+	return f(ctx, etcd)
+}
+
+type etcdMemberCheckFunc func(ctx context.Context, etcd druidv1alpha1.Etcd) []etcdmember.Result
+
+func (f etcdMemberCheckFunc) Check(ctx context.Context, etcd druidv1alpha1.Etcd) []etcdmember.Result {
+	return f(ctx, etcd)
+}
+
+func init() {
+	RegisterConditionCheck("test-zone-spread", conditionTypeZoneSpread, func(client.Client) condition.Checker {
+		return conditionCheckFunc(func(_ context.Context, _ druidv1alpha1.Etcd) condition.Result {
+			return condition.NewResult(conditionTypeZoneSpread, druidv1alpha1.ConditionTrue, "MembersSpread", "members are spread across 3 zones")
+		})
+	})
+	RegisterConditionCheck("test-wrong-condition-type", conditionTypeBucketRegion, func(client.Client) condition.Checker {
+		return conditionCheckFunc(func(_ context.Context, _ druidv1alpha1.Etcd) condition.Result {
+			return condition.NewResult(druidv1alpha1.ConditionTypeReady, druidv1alpha1.ConditionFalse, "Hijacked", "")
+		})
+	})
+	RegisterConditionCheck("test-slow", conditionTypeSlowCheck, func(client.Client) condition.Checker {
+		return conditionCheckFunc(func(ctx context.Context, _ druidv1alpha1.Etcd) condition.Result {
+			<-ctx.Done()
+			return condition.NewResult(conditionTypeSlowCheck, druidv1alpha1.ConditionTrue, "TooLate", "")
+		})
+	})
+	RegisterConditionCheck("test-panicking", conditionTypePanickedCheck, func(client.Client) condition.Checker {
+		return conditionCheckFunc(func(_ context.Context, _ druidv1alpha1.Etcd) condition.Result {
+			panic("boom")
+		})
+	})
+	RegisterEtcdMemberCheck("test-panicking-member-check", func(_ client.Client, _ logr.Logger, _, _ time.Duration) etcdmember.Checker {
+		return etcdMemberCheckFunc(func(_ context.Context, _ druidv1alpha1.Etcd) []etcdmember.Result {
+			panic("boom")
+		})
+	})
+}
+
+var _ = Describe("Registry", func() {
+	Describe("#RegisterConditionCheck", func() {
+		It("should panic if a check has already been registered with the same name", func() {
+			Expect(func() {
+				RegisterConditionCheck("test-zone-spread", conditionTypeZoneSpread, nil)
+			}).To(Panic())
+		})
+
+		It("should panic if a check is registered for a built-in condition type", func() {
+			Expect(func() {
+				RegisterConditionCheck("test-ready", druidv1alpha1.ConditionTypeReady, nil)
+			}).To(Panic())
+		})
+	})
+
+	Describe("#NewExtraChecks", func() {
+		It("should return an error if an enabled condition check has not been registered", func() {
+			_, err := NewExtraChecks(druidconfigv1alpha1.ExtraChecksConfiguration{
+				Conditions: []druidconfigv1alpha1.ExtraCheck{{Name: "does-not-exist"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("does-not-exist")))
+		})
+
+		It("should return an error if an enabled etcd member check has not been registered", func() {
+			_, err := NewExtraChecks(druidconfigv1alpha1.ExtraChecksConfiguration{
+				EtcdMembers: []druidconfigv1alpha1.ExtraCheck{{Name: "test-zone-spread"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("test-zone-spread")))
+		})
+	})
+
+	Describe("#Check with extra checks", func() {
+		var (
+			etcd     *druidv1alpha1.Etcd
+			timeNow  time.Time
+			restores []func()
+		)
+
+		BeforeEach(func() {
+			timeNow = time.Now()
+			etcd = &druidv1alpha1.Etcd{
+				Spec: druidv1alpha1.EtcdSpec{Replicas: 1},
+				Status: druidv1alpha1.EtcdStatus{
+					Members: []druidv1alpha1.EtcdMemberStatus{{
+						ID:                 ptr.To("1"),
+						Name:               "member1",
+						Role:               ptr.To(druidv1alpha1.EtcdRoleLeader),
+						Status:             druidv1alpha1.EtcdMemberStatusReady,
+						Reason:             "LeaseSucceeded",
+						LastTransitionTime: metav1.NewTime(timeNow.Add(-time.Hour)),
+					}},
+				},
+			}
+			restores = []func(){
+				withVar(&ConditionChecks, []ConditionCheckFn{}),
+				withVar(&EtcdMemberChecks, []EtcdMemberCheckFn{}),
+			}
+		})
+
+		AfterEach(func() {
+			for _, restore := range restores {
+				restore()
+			}
+		})
+
+		It("should merge the results of the enabled condition checks and isolate failing checks", func() {
+			extraChecks, err := NewExtraChecks(druidconfigv1alpha1.ExtraChecksConfiguration{
+				Conditions: []druidconfigv1alpha1.ExtraCheck{
+					{Name: "test-zone-spread"},
+					{Name: "test-wrong-condition-type"},
+					{Name: "test-slow", Timeout: &metav1.Duration{Duration: 10 * time.Millisecond}},
+					{Name: "test-panicking"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute).WithExtraChecks(extraChecks)
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(etcd.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(conditionTypeZoneSpread),
+					"Status": Equal(druidv1alpha1.ConditionTrue),
+					"Reason": Equal("MembersSpread"),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(conditionTypeBucketRegion),
+					"Status": Equal(druidv1alpha1.ConditionUnknown),
+					"Reason": Equal(ExtraCheckFailed),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(conditionTypeSlowCheck),
+					"Status": Equal(druidv1alpha1.ConditionUnknown),
+					"Reason": Equal(ExtraCheckTimedOut),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(conditionTypePanickedCheck),
+					"Status": Equal(druidv1alpha1.ConditionUnknown),
+					"Reason": Equal(ExtraCheckFailed),
+				}),
+			))
+		})
+
+		It("should remove the conditions of registered condition checks which are not enabled", func() {
+			etcd.Status.Conditions = []druidv1alpha1.Condition{
+				{Type: conditionTypeZoneSpread, Status: druidv1alpha1.ConditionTrue, Reason: "MembersSpread"},
+				{Type: druidv1alpha1.ConditionTypeSnapshotCompactionBackoff, Status: druidv1alpha1.ConditionFalse},
+			}
+			extraChecks, err := NewExtraChecks(druidconfigv1alpha1.ExtraChecksConfiguration{})
+			Expect(err).ToNot(HaveOccurred())
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute).WithExtraChecks(extraChecks)
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(etcd.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(druidv1alpha1.ConditionTypeSnapshotCompactionBackoff)}),
+			))
+		})
+
+		It("should keep the members if an enabled etcd member check fails", func() {
+			extraChecks, err := NewExtraChecks(druidconfigv1alpha1.ExtraChecksConfiguration{
+				EtcdMembers: []druidconfigv1alpha1.ExtraCheck{{Name: "test-panicking-member-check"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			expectedMembers := etcd.Status.DeepCopy().Members
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute).WithExtraChecks(extraChecks)
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(etcd.Status.Members).To(Equal(expectedMembers))
+		})
+	})
+})
@@ -32,6 +32,8 @@ const (
 
 	// LabelEtcdNamespace is the label for prometheus metrics to indicate etcd namespace
 	LabelEtcdNamespace = "etcd_namespace"
+	// LabelEtcdName is the label for prometheus metrics to indicate etcd name
+	LabelEtcdName = "etcd_name"
 )
 
 var (
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recommender
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podMetricsGVK is the GroupVersionKind of the pod metrics served by the metrics API.
+var podMetricsGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetrics"}
+
+// MetricsClient reads the current resource usage of pods.
+type MetricsClient interface {
+	// GetPodUsage returns the resource usage of the containers of the pod with the given key, keyed by container name.
+	GetPodUsage(ctx context.Context, key client.ObjectKey) (map[string]corev1.ResourceList, error)
+}
+
+type metricsAPIClient struct {
+	client client.Client
+}
+
+// NewMetricsAPIClient returns a MetricsClient which reads the resource usage of pods from the metrics API, which is
+// served e.g. by metrics-server. The pod metrics are read as unstructured objects, so that no client for the metrics
+// API is required.
+func NewMetricsAPIClient(cl client.Client) MetricsClient {
+	return &metricsAPIClient{client: cl}
+}
+
+func (m *metricsAPIClient) GetPodUsage(ctx context.Context, key client.ObjectKey) (map[string]corev1.ResourceList, error) {
+	podMetrics := &unstructured.Unstructured{}
+	podMetrics.SetGroupVersionKind(podMetricsGVK)
+	if err := m.client.Get(ctx, key, podMetrics); err != nil {
+		return nil, err
+	}
+	containers, _, err := unstructured.NestedSlice(podMetrics.Object, "containers")
+	if err != nil {
+		return nil, fmt.Errorf("invalid containers in pod metrics %v: %w", key, err)
+	}
+	usage := make(map[string]corev1.ResourceList, len(containers))
+	for _, c := range containers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid container in pod metrics %v", key)
+		}
+		name, _, _ := unstructured.NestedString(container, "name")
+		containerUsage, _, err := unstructured.NestedStringMap(container, "usage")
+		if err != nil {
+			return nil, fmt.Errorf("invalid usage of container %s in pod metrics %v: %w", name, key, err)
+		}
+		resources := make(corev1.ResourceList, len(containerUsage))
+		for resourceName, value := range containerUsage {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s usage of container %s in pod metrics %v: %w", resourceName, name, key, err)
+			}
+			resources[corev1.ResourceName(resourceName)] = quantity
+		}
+		usage[name] = resources
+	}
+	return usage, nil
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recommender
+
+import (
+	"context"
+	"math"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// safetyMargin is the fraction by which the recommended requests exceed the peak resource usage.
+	safetyMargin = 0.15
+	// dbSizeProjectionPeriod is the period for which the database size of a member is projected with its growth. The
+	// memory recommended for the etcd container is at least the largest projected database size, since etcd maps its
+	// whole backend database into memory.
+	dbSizeProjectionPeriod = 7 * 24 * time.Hour
+	// dbSizeGrowthSmoothingFactor is the weight of the most recently observed database size growth in the smoothed growth.
+	dbSizeGrowthSmoothingFactor = 0.5
+	// roundingTolerance prevents that recommendations are rounded up due to floating point inaccuracies.
+	roundingTolerance = 1e-6
+)
+
+// minRecommendedRequests are the smallest resource requests which are recommended.
+var minRecommendedRequests = corev1.ResourceList{
+	corev1.ResourceCPU:    resource.MustParse("10m"),
+	corev1.ResourceMemory: resource.MustParse("32Mi"),
+}
+
+// Recommender recommends resources for the etcd and backup-restore containers of an etcd cluster. The recommended
+// requests follow the peak resource usage of the members with a safety margin. Previously observed peaks decay
+// exponentially with the configured half-life, so that the recommendations shrink again after a load peak has passed.
+type Recommender struct {
+	metricsClient MetricsClient
+	halfLife      time.Duration
+}
+
+// New returns a new Recommender which samples the resource usage with the given metrics client.
+func New(metricsClient MetricsClient, halfLife time.Duration) *Recommender {
+	return &Recommender{
+		metricsClient: metricsClient,
+		halfLife:      halfLife,
+	}
+}
+
+// Recommend samples the resource usage of the members of the given Etcd and returns the updated recommendations. The
+// recommendations which are currently in the status are returned unchanged if the usage of no member could be sampled,
+// e.g. because no member pod is running.
+func (r *Recommender) Recommend(ctx context.Context, etcd *druidv1alpha1.Etcd, now time.Time) (*druidv1alpha1.ResourceRecommendationStatus, error) {
+	previous := etcd.Status.ResourceRecommendation
+	var elapsed time.Duration
+	previousMembers := make(map[string]druidv1alpha1.MemberResourceUsage)
+	if previous != nil {
+		if previous.LastUpdateTime != nil {
+			elapsed = now.Sub(previous.LastUpdateTime.Time)
+		}
+		for _, member := range previous.Members {
+			previousMembers[member.Name] = member
+		}
+	}
+	dbSizes := make(map[string]*resource.Quantity, len(etcd.Status.Members))
+	for _, member := range etcd.Status.Members {
+		dbSizes[member.Name] = member.DBSize
+	}
+
+	var members []druidv1alpha1.MemberResourceUsage
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		usage, err := r.metricsClient.GetPodUsage(ctx, client.ObjectKey{Name: podName, Namespace: etcd.Namespace})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		member := druidv1alpha1.MemberResourceUsage{
+			Name:          podName,
+			Etcd:          usage[common.ContainerNameEtcd],
+			BackupRestore: usage[common.ContainerNameEtcdBackupRestore],
+		}
+		if dbSize := dbSizes[podName]; dbSize != nil {
+			member.DBSize = dbSize
+			member.DBSizeGrowthPerDay = computeDBSizeGrowthPerDay(previousMembers[podName], *dbSize, elapsed)
+		}
+		members = append(members, member)
+	}
+	if len(members) == 0 {
+		return previous, nil
+	}
+
+	decay := 0.0
+	var previousEtcd, previousBackupRestore *druidv1alpha1.ContainerResources
+	if previous != nil {
+		decay = math.Pow(0.5, float64(elapsed)/float64(r.halfLife))
+		previousEtcd, previousBackupRestore = previous.Etcd, previous.BackupRestore
+	}
+	recommendation := &druidv1alpha1.ResourceRecommendationStatus{
+		Etcd: recommendContainerResources(
+			getPeakUsage(members, func(m druidv1alpha1.MemberResourceUsage) corev1.ResourceList { return m.Etcd }),
+			previousEtcd, decay, getProjectedDBSize(members), etcd.Spec.Etcd.Resources),
+		BackupRestore: recommendContainerResources(
+			getPeakUsage(members, func(m druidv1alpha1.MemberResourceUsage) corev1.ResourceList { return m.BackupRestore }),
+			previousBackupRestore, decay, 0, etcd.Spec.Backup.Resources),
+		Members:        members,
+		LastUpdateTime: &metav1.Time{Time: now},
+	}
+	return recommendation, nil
+}
+
+// computeDBSizeGrowthPerDay returns the growth of the database size per day, smoothed with the growth which has
+// previously been observed for the member. Nil is returned if the database size has not been observed before.
+func computeDBSizeGrowthPerDay(previous druidv1alpha1.MemberResourceUsage, dbSize resource.Quantity, elapsed time.Duration) *resource.Quantity {
+	if previous.DBSize == nil || elapsed <= 0 {
+		return previous.DBSizeGrowthPerDay
+	}
+	growth := float64(dbSize.Value()-previous.DBSize.Value()) / elapsed.Hours() * 24
+	if previous.DBSizeGrowthPerDay != nil {
+		growth = dbSizeGrowthSmoothingFactor*growth + (1-dbSizeGrowthSmoothingFactor)*float64(previous.DBSizeGrowthPerDay.Value())
+	}
+	return resource.NewQuantity(int64(math.Round(growth)), resource.BinarySI)
+}
+
+// getProjectedDBSize returns the largest database size of the members, projected with their growth over dbSizeProjectionPeriod.
+func getProjectedDBSize(members []druidv1alpha1.MemberResourceUsage) float64 {
+	var projected float64
+	for _, member := range members {
+		if member.DBSize == nil {
+			continue
+		}
+		dbSize := member.DBSize.AsApproximateFloat64()
+		if member.DBSizeGrowthPerDay != nil {
+			dbSize += max(member.DBSizeGrowthPerDay.AsApproximateFloat64(), 0) * dbSizeProjectionPeriod.Hours() / 24
+		}
+		projected = max(projected, dbSize)
+	}
+	return projected
+}
+
+func getPeakUsage(members []druidv1alpha1.MemberResourceUsage, usageFn func(druidv1alpha1.MemberResourceUsage) corev1.ResourceList) corev1.ResourceList {
+	peak := corev1.ResourceList{}
+	for _, member := range members {
+		for name, quantity := range usageFn(member) {
+			if current, ok := peak[name]; !ok || quantity.Cmp(current) > 0 {
+				peak[name] = quantity
+			}
+		}
+	}
+	return peak
+}
+
+// recommendContainerResources recommends the CPU and memory requests of a container from its peak usage, the
+// decayed previous recommendation and, for memory, the given lower bound. Limits are only recommended for resources
+// which are limited in the configured resources, keeping the configured ratio between the limit and the request.
+func recommendContainerResources(peakUsage corev1.ResourceList, previous *druidv1alpha1.ContainerResources, decay, memoryLowerBound float64, configured *corev1.ResourceRequirements) *druidv1alpha1.ContainerResources {
+	recommended := &druidv1alpha1.ContainerResources{Requests: corev1.ResourceList{}}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		target := 0.0
+		if usage, ok := peakUsage[name]; ok {
+			target = usage.AsApproximateFloat64() * (1 + safetyMargin)
+		}
+		if previous != nil {
+			if previousRequest, ok := previous.Requests[name]; ok {
+				target = max(target, previousRequest.AsApproximateFloat64()*decay)
+			}
+		}
+		if name == corev1.ResourceMemory {
+			target = max(target, memoryLowerBound)
+		}
+		minRequest := minRecommendedRequests[name]
+		target = max(target, minRequest.AsApproximateFloat64())
+		recommended.Requests[name] = roundUp(name, target)
+	}
+
+	if configured == nil {
+		return recommended
+	}
+	for name, limit := range configured.Limits {
+		request, ok := recommended.Requests[name]
+		if !ok {
+			continue
+		}
+		if recommended.Limits == nil {
+			recommended.Limits = corev1.ResourceList{}
+		}
+		configuredRequest, ok := configured.Requests[name]
+		if !ok || configuredRequest.IsZero() {
+			recommended.Limits[name] = maxQuantity(limit, request)
+			continue
+		}
+		ratio := limit.AsApproximateFloat64() / configuredRequest.AsApproximateFloat64()
+		recommended.Limits[name] = maxQuantity(roundUp(name, request.AsApproximateFloat64()*ratio), request)
+	}
+	return recommended
+}
+
+// roundUp rounds the given value up to full millicores for CPU and to full mebibytes for memory.
+func roundUp(name corev1.ResourceName, value float64) resource.Quantity {
+	if name == corev1.ResourceCPU {
+		return *resource.NewMilliQuantity(int64(math.Ceil(value*1000-roundingTolerance)), resource.DecimalSI)
+	}
+	const mebibyte = 1024 * 1024
+	return *resource.NewQuantity(int64(math.Ceil(value/mebibyte-roundingTolerance))*mebibyte, resource.BinarySI)
+}
+
+func maxQuantity(a, b resource.Quantity) resource.Quantity {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recommender
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRecommend(t *testing.T) {
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name                  string
+		dbSize                string
+		etcdResources         *corev1.ResourceRequirements
+		previous              *druidv1alpha1.ResourceRecommendationStatus
+		expectedEtcd          *druidv1alpha1.ContainerResources
+		expectedBackupRestore *druidv1alpha1.ContainerResources
+		expectedGrowthPerDay  *resource.Quantity
+	}{
+		{
+			name:                  "recommends the peak usage with a safety margin",
+			dbSize:                "100Mi",
+			expectedEtcd:          containerResources("230m", "345Mi", nil),
+			expectedBackupRestore: containerResources("46m", "58Mi", nil),
+		},
+		{
+			name:                  "keeps the configured ratio between limits and requests",
+			dbSize:                "100Mi",
+			etcdResources:         &corev1.ResourceRequirements{Requests: resourceList("100m", "100Mi"), Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")}},
+			expectedEtcd:          containerResources("230m", "345Mi", corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("690Mi")}),
+			expectedBackupRestore: containerResources("46m", "58Mi", nil),
+		},
+		{
+			name:   "decays the previous recommendation with the half-life",
+			dbSize: "100Mi",
+			previous: &druidv1alpha1.ResourceRecommendationStatus{
+				Etcd:           containerResources("1", "1Gi", nil),
+				BackupRestore:  containerResources("40m", "50Mi", nil),
+				LastUpdateTime: &metav1.Time{Time: now.Add(-24 * time.Hour)},
+			},
+			expectedEtcd:          containerResources("500m", "512Mi", nil),
+			expectedBackupRestore: containerResources("46m", "58Mi", nil),
+		},
+		{
+			name:   "recommends at least the projected database size as memory for etcd",
+			dbSize: "200Mi",
+			previous: &druidv1alpha1.ResourceRecommendationStatus{
+				Members: []druidv1alpha1.MemberResourceUsage{
+					{Name: "etcd-test-0", DBSize: ptr.To(resource.MustParse("100Mi"))},
+				},
+				LastUpdateTime: &metav1.Time{Time: now.Add(-24 * time.Hour)},
+			},
+			expectedEtcd:          containerResources("230m", "900Mi", nil),
+			expectedBackupRestore: containerResources("46m", "58Mi", nil),
+			expectedGrowthPerDay:  ptr.To(resource.MustParse("100Mi")),
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := createEtcd(tc.dbSize)
+			etcd.Spec.Etcd.Resources = tc.etcdResources
+			etcd.Status.ResourceRecommendation = tc.previous
+			metricsClient := testutils.NewFakeMetricsClient()
+			for i, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+				cpu := []string{"100m", "200m", "150m"}[i]
+				memory := []string{"200Mi", "300Mi", "250Mi"}[i]
+				metricsClient.SetPodUsage(client.ObjectKey{Name: podName, Namespace: etcd.Namespace}, map[string]corev1.ResourceList{
+					common.ContainerNameEtcd:              resourceList(cpu, memory),
+					common.ContainerNameEtcdBackupRestore: resourceList("40m", "50Mi"),
+				})
+			}
+
+			recommendation, err := New(metricsClient, 24*time.Hour).Recommend(context.Background(), etcd, now)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(recommendation.Etcd).To(BeComparableTo(tc.expectedEtcd))
+			g.Expect(recommendation.BackupRestore).To(BeComparableTo(tc.expectedBackupRestore))
+			g.Expect(recommendation.Members).To(HaveLen(3))
+			g.Expect(recommendation.Members[0].DBSizeGrowthPerDay).To(BeComparableTo(tc.expectedGrowthPerDay))
+			g.Expect(recommendation.LastUpdateTime.Time).To(Equal(now))
+			g.Expect(recommendation.Applied).To(BeNil())
+		})
+	}
+}
+
+func TestRecommendWithoutUsage(t *testing.T) {
+	g := NewWithT(t)
+	etcd := createEtcd("100Mi")
+	previous := &druidv1alpha1.ResourceRecommendationStatus{Etcd: containerResources("1", "1Gi", nil)}
+	etcd.Status.ResourceRecommendation = previous
+
+	recommendation, err := New(testutils.NewFakeMetricsClient(), time.Hour).Recommend(context.Background(), etcd, time.Now())
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(recommendation).To(Equal(previous))
+}
+
+func TestRecommendWithMetricsAPIError(t *testing.T) {
+	g := NewWithT(t)
+	metricsClient := testutils.NewFakeMetricsClient()
+	metricsClient.Err = errors.New("metrics API not available")
+
+	_, err := New(metricsClient, time.Hour).Recommend(context.Background(), createEtcd("100Mi"), time.Now())
+
+	g.Expect(err).To(HaveOccurred())
+}
+
+func createEtcd(dbSize string) *druidv1alpha1.Etcd {
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+	etcd.Spec.Etcd.Resources = nil
+	etcd.Spec.Backup.Resources = nil
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		etcd.Status.Members = append(etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+			Name:   podName,
+			DBSize: ptr.To(resource.MustParse(dbSize)),
+		})
+	}
+	return etcd
+}
+
+func containerResources(cpu, memory string, limits corev1.ResourceList) *druidv1alpha1.ContainerResources {
+	return &druidv1alpha1.ContainerResources{Requests: resourceList(cpu, memory), Limits: limits}
+}
+
+func resourceList(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
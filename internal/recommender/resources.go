@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recommender
+
+import (
+	"math"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// significantChangeThreshold is the relative difference from the applied resources above which recommended resources
+// are applied again. Smaller differences do not justify rolling the etcd members.
+const significantChangeThreshold = 0.1
+
+// GetAppliedResources returns the recommended resources which have been applied for the container with the given
+// name, as recorded in the AppliedResourceRecommendationAnnotation. Nil is returned if the Etcd does not opt into
+// applying resource recommendations or if none have been applied yet.
+func GetAppliedResources(etcd *druidv1alpha1.Etcd, containerName string) *druidv1alpha1.ContainerResources {
+	if etcd.Spec.ResourceRecommendation == nil || !ptr.Deref(etcd.Spec.ResourceRecommendation.Apply, false) {
+		return nil
+	}
+	applied := druidv1alpha1.GetAppliedResourceRecommendation(etcd)
+	if applied == nil {
+		return nil
+	}
+	switch containerName {
+	case common.ContainerNameEtcd:
+		return applied.Etcd
+	case common.ContainerNameEtcdBackupRestore:
+		return applied.BackupRestore
+	default:
+		return nil
+	}
+}
+
+// GetContainerResources returns the resources of the container with the given name. The requests and limits of the
+// configured resources are replaced by the applied recommended resources, if there are any.
+func GetContainerResources(etcd *druidv1alpha1.Etcd, containerName string, configured corev1.ResourceRequirements) corev1.ResourceRequirements {
+	resources := *configured.DeepCopy()
+	if applied := GetAppliedResources(etcd, containerName); applied != nil {
+		resources.Requests = applied.Requests.DeepCopy()
+		resources.Limits = applied.Limits.DeepCopy()
+	}
+	return resources
+}
+
+// ClampToBounds returns the given recommended resources, clamped to the given bounds. Limits are never smaller than
+// the corresponding requests.
+func ClampToBounds(recommended *druidv1alpha1.ContainerResources, bounds *druidv1alpha1.ResourceBounds) *druidv1alpha1.ContainerResources {
+	if recommended == nil {
+		return nil
+	}
+	clamped := recommended.DeepCopy()
+	if bounds == nil {
+		return clamped
+	}
+	for _, resources := range []corev1.ResourceList{clamped.Requests, clamped.Limits} {
+		for name, quantity := range resources {
+			if minAllowed, ok := bounds.MinAllowed[name]; ok && quantity.Cmp(minAllowed) < 0 {
+				resources[name] = minAllowed.DeepCopy()
+			}
+			if maxAllowed, ok := bounds.MaxAllowed[name]; ok && quantity.Cmp(maxAllowed) > 0 {
+				resources[name] = maxAllowed.DeepCopy()
+			}
+		}
+	}
+	for name, limit := range clamped.Limits {
+		if request, ok := clamped.Requests[name]; ok && limit.Cmp(request) < 0 {
+			clamped.Limits[name] = request.DeepCopy()
+		}
+	}
+	return clamped
+}
+
+// HasSignificantChange checks if the recommended resources differ significantly from the applied resources.
+func HasSignificantChange(applied, recommended *druidv1alpha1.ContainerResources) bool {
+	if applied == nil || recommended == nil {
+		return applied != recommended
+	}
+	return hasSignificantChange(applied.Requests, recommended.Requests) || hasSignificantChange(applied.Limits, recommended.Limits)
+}
+
+func hasSignificantChange(applied, recommended corev1.ResourceList) bool {
+	if len(applied) != len(recommended) {
+		return true
+	}
+	for name, recommendedQuantity := range recommended {
+		appliedQuantity, ok := applied[name]
+		if !ok {
+			return true
+		}
+		if relativeDifference(appliedQuantity, recommendedQuantity) > significantChangeThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+func relativeDifference(a, b resource.Quantity) float64 {
+	x, y := a.AsApproximateFloat64(), b.AsApproximateFloat64()
+	if x == 0 {
+		if y == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return math.Abs(y-x) / x
+}
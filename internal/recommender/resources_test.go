@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package recommender
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestClampToBounds(t *testing.T) {
+	bounds := &druidv1alpha1.ResourceBounds{
+		MinAllowed: resourceList("100m", "256Mi"),
+		MaxAllowed: resourceList("2", "4Gi"),
+	}
+	testCases := []struct {
+		name        string
+		recommended *druidv1alpha1.ContainerResources
+		bounds      *druidv1alpha1.ResourceBounds
+		expected    *druidv1alpha1.ContainerResources
+	}{
+		{
+			name:        "nil recommendation",
+			bounds:      bounds,
+			recommended: nil,
+			expected:    nil,
+		},
+		{
+			name:        "recommendation without bounds",
+			recommended: containerResources("10m", "32Mi", nil),
+			expected:    containerResources("10m", "32Mi", nil),
+		},
+		{
+			name:        "recommendation below the minimum",
+			bounds:      bounds,
+			recommended: containerResources("10m", "32Mi", nil),
+			expected:    containerResources("100m", "256Mi", nil),
+		},
+		{
+			name:        "recommendation above the maximum",
+			bounds:      bounds,
+			recommended: containerResources("3", "8Gi", resourceList("6", "16Gi")),
+			expected:    containerResources("2", "4Gi", resourceList("2", "4Gi")),
+		},
+		{
+			name:        "limits are raised to the clamped requests",
+			bounds:      &druidv1alpha1.ResourceBounds{MinAllowed: resourceList("1", "1Gi")},
+			recommended: containerResources("500m", "512Mi", resourceList("800m", "768Mi")),
+			expected:    containerResources("1", "1Gi", resourceList("1", "1Gi")),
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(ClampToBounds(tc.recommended, tc.bounds)).To(BeComparableTo(tc.expected))
+		})
+	}
+}
+
+func TestHasSignificantChange(t *testing.T) {
+	testCases := []struct {
+		name        string
+		applied     *druidv1alpha1.ContainerResources
+		recommended *druidv1alpha1.ContainerResources
+		expected    bool
+	}{
+		{
+			name:     "nothing applied and nothing recommended",
+			expected: false,
+		},
+		{
+			name:        "nothing applied yet",
+			recommended: containerResources("100m", "256Mi", nil),
+			expected:    true,
+		},
+		{
+			name:        "small change of the requests",
+			applied:     containerResources("100m", "256Mi", nil),
+			recommended: containerResources("105m", "270Mi", nil),
+			expected:    false,
+		},
+		{
+			name:        "large change of the requests",
+			applied:     containerResources("100m", "256Mi", nil),
+			recommended: containerResources("100m", "512Mi", nil),
+			expected:    true,
+		},
+		{
+			name:        "limit is added",
+			applied:     containerResources("100m", "256Mi", nil),
+			recommended: containerResources("100m", "256Mi", corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")}),
+			expected:    true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(HasSignificantChange(tc.applied, tc.recommended)).To(Equal(tc.expected))
+		})
+	}
+}
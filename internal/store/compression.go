@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"k8s.io/utils/ptr"
+)
+
+// compressionPoliciesVersionConstraint is the constraint on the version of etcd-backup-restore which supports the
+// zstd and lz4 compression policies. Older versions only know the gzip, lzw and zlib policies and fail to start with
+// any other policy.
+const compressionPoliciesVersionConstraint = ">= 0.43.0"
+
+// CheckCompressionSupported returns an error if the policy of the given snapshot compression is not supported by the
+// given etcd-backup-restore image.
+func CheckCompressionSupported(compression *druidv1alpha1.CompressionSpec, image string) error {
+	if compression == nil || compression.Policy == nil {
+		return nil
+	}
+	policy := *compression.Policy
+	if (policy != druidv1alpha1.ZstdCompression && policy != druidv1alpha1.Lz4Compression) || isSupportedByImage(image, compressionPoliciesVersionConstraint) {
+		return nil
+	}
+	return fmt.Errorf("compression policy %s requires etcd-backup-restore %s, which is not satisfied by image %s", policy, compressionPoliciesVersionConstraint, image)
+}
+
+// GetCompressionArgs returns the etcd-backup-restore command line arguments for the given snapshot compression. The
+// defaults are used if no compression is specified. The policy only applies to snapshots which are newly created,
+// existing snapshots are decompressed according to the policy with which they have been compressed, so that backups
+// with snapshots of different compression policies can be restored.
+func GetCompressionArgs(compression *druidv1alpha1.CompressionSpec) []string {
+	compressionEnabled, compressionPolicy := druidv1alpha1.DefaultCompressionEnabled, druidv1alpha1.DefaultCompression
+	if compression != nil {
+		compressionEnabled = ptr.Deref(compression.Enabled, druidv1alpha1.DefaultCompressionEnabled)
+		compressionPolicy = ptr.Deref(compression.Policy, druidv1alpha1.DefaultCompression)
+	}
+	return []string{
+		fmt.Sprintf("--compress-snapshots=%t", compressionEnabled),
+		fmt.Sprintf("--compression-policy=%s", compressionPolicy),
+	}
+}
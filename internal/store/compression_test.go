@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/store"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetCompressionArgs(t *testing.T) {
+	testCases := []struct {
+		name         string
+		compression  *druidv1alpha1.CompressionSpec
+		expectedArgs []string
+	}{
+		{
+			name:         "no compression specified, should return the defaults",
+			expectedArgs: []string{"--compress-snapshots=true", "--compression-policy=gzip"},
+		},
+		{
+			name:         "compression disabled, should disable compression",
+			compression:  &druidv1alpha1.CompressionSpec{Enabled: ptr.To(false)},
+			expectedArgs: []string{"--compress-snapshots=false", "--compression-policy=gzip"},
+		},
+		{
+			name:         "zstd policy, should use zstd",
+			compression:  &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.ZstdCompression)},
+			expectedArgs: []string{"--compress-snapshots=true", "--compression-policy=zstd"},
+		},
+		{
+			name:         "lz4 policy, should use lz4",
+			compression:  &druidv1alpha1.CompressionSpec{Enabled: ptr.To(true), Policy: ptr.To(druidv1alpha1.Lz4Compression)},
+			expectedArgs: []string{"--compress-snapshots=true", "--compression-policy=lz4"},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g.Expect(store.GetCompressionArgs(tc.compression)).To(Equal(tc.expectedArgs))
+		})
+	}
+}
+
+func TestCheckCompressionSupported(t *testing.T) {
+	testCases := []struct {
+		name        string
+		compression *druidv1alpha1.CompressionSpec
+		image       string
+		expectErr   bool
+	}{
+		{
+			name:  "no compression specified, should succeed for any image",
+			image: "etcdbrctl:v0.41.2",
+		},
+		{
+			name:        "gzip policy, should succeed for any image",
+			compression: &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.GzipCompression)},
+			image:       "etcdbrctl:v0.41.2",
+		},
+		{
+			name:        "zstd policy with an image which supports it, should succeed",
+			compression: &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.ZstdCompression)},
+			image:       "etcdbrctl:v0.43.0",
+		},
+		{
+			name:        "zstd policy with an image which does not support it, should fail",
+			compression: &druidv1alpha1.CompressionSpec{Policy: ptr.To(druidv1alpha1.ZstdCompression)},
+			image:       "etcdbrctl:v0.41.2",
+			expectErr:   true,
+		},
+		{
+			name:        "lz4 policy with disabled compression and an image which does not support it, should fail",
+			compression: &druidv1alpha1.CompressionSpec{Enabled: ptr.To(false), Policy: ptr.To(druidv1alpha1.Lz4Compression)},
+			image:       "etcdbrctl:v0.42.0",
+			expectErr:   true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := store.CheckCompressionSupported(tc.compression, tc.image)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
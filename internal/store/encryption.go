@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// encryptionVersionConstraint is the constraint on the version of etcd-backup-restore which supports the encryption of
+// snapshots. Older versions do not accept the encryption command line arguments and fail to start.
+const encryptionVersionConstraint = ">= 0.43.0"
+
+// CheckEncryptionSupported returns an error if the given encryption is configured but not supported by the given
+// etcd-backup-restore image.
+func CheckEncryptionSupported(encryption *druidv1alpha1.EncryptionSpec, image string) error {
+	if encryption == nil || isSupportedByImage(image, encryptionVersionConstraint) {
+		return nil
+	}
+	return fmt.Errorf("encryption of snapshots requires etcd-backup-restore %s, which is not satisfied by image %s", encryptionVersionConstraint, image)
+}
+
+// getEncryptionSecretName returns the name of the secret which is mounted for the given encryption, or an empty
+// string if no secret needs to be mounted.
+func getEncryptionSecretName(encryption *druidv1alpha1.EncryptionSpec) string {
+	switch {
+	case encryption == nil:
+		return ""
+	case encryption.SecretRef != nil:
+		return encryption.SecretRef.Name
+	case encryption.KMS != nil && encryption.KMS.CredentialsSecretRef != nil:
+		return encryption.KMS.CredentialsSecretRef.Name
+	}
+	return ""
+}
+
+// GetEncryptionVolume returns a volume with the keys or the key management service credentials of the given encryption,
+// or nil if no secret needs to be mounted.
+func GetEncryptionVolume(encryption *druidv1alpha1.EncryptionSpec, volumeName string) *corev1.Volume {
+	secretName := getEncryptionSecretName(encryption)
+	if secretName == "" {
+		return nil
+	}
+	return &corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  secretName,
+				DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+			},
+		},
+	}
+}
+
+// GetEncryptionVolumeMount returns the volume mount for the volume returned by GetEncryptionVolume, or nil if no secret
+// needs to be mounted.
+func GetEncryptionVolumeMount(encryption *druidv1alpha1.EncryptionSpec, volumeName, mountPath string) *corev1.VolumeMount {
+	if getEncryptionSecretName(encryption) == "" {
+		return nil
+	}
+	return &corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: mountPath,
+		ReadOnly:  true,
+	}
+}
+
+// GetEncryptionArgs returns the etcd-backup-restore command line arguments for the given encryption, given that the
+// volume returned by GetEncryptionVolume is mounted at mountPath. The argPrefix is prepended to the names of all arguments.
+func GetEncryptionArgs(encryption *druidv1alpha1.EncryptionSpec, argPrefix, mountPath string) []string {
+	if encryption == nil {
+		return nil
+	}
+	argPrefix = "--" + argPrefix
+	args := []string{argPrefix + "encryption-key-id=" + encryption.KeyID}
+	switch {
+	case encryption.SecretRef != nil:
+		args = append(args, argPrefix+"encryption-keys-dir="+mountPath)
+	case encryption.KMS != nil:
+		args = append(args, argPrefix+"encryption-kms-endpoint="+encryption.KMS.Endpoint)
+		if encryption.KMS.CredentialsSecretRef != nil {
+			args = append(args, argPrefix+"encryption-kms-credentials-dir="+mountPath)
+		}
+	}
+	return args
+}
+
+// CheckEncryptionKeys checks that the keys of all given key IDs are available for the given encryption, so that all
+// snapshots encrypted with them can be restored. Keys held by a key management service cannot be checked, only the
+// existence of the credentials secret is verified for them.
+func CheckEncryptionKeys(ctx context.Context, cl client.Client, namespace string, encryption *druidv1alpha1.EncryptionSpec, keyIDs []string) error {
+	secretName := getEncryptionSecretName(encryption)
+	if secretName == "" {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, secret); err != nil {
+		return fmt.Errorf("could not get encryption secret %s/%s: %w", namespace, secretName, err)
+	}
+	if encryption.SecretRef == nil {
+		return nil
+	}
+	var missingKeyIDs []string
+	for _, keyID := range append([]string{encryption.KeyID}, keyIDs...) {
+		if len(secret.Data[keyID]) == 0 && !slices.Contains(missingKeyIDs, keyID) {
+			missingKeyIDs = append(missingKeyIDs, keyID)
+		}
+	}
+	if len(missingKeyIDs) > 0 {
+		return fmt.Errorf("encryption secret %s/%s does not contain the keys with IDs %s", namespace, secretName, strings.Join(missingKeyIDs, ", "))
+	}
+	return nil
+}
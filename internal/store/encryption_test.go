@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/store"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testEncryptionSecretName = "etcd-backup-encryption"
+	testEncryptionMountPath  = "/var/etcdbr/encryption"
+	testKMSEndpoint          = "http://kms-stub.default.svc:8080"
+)
+
+func TestGetEncryptionArgs(t *testing.T) {
+	testCases := []struct {
+		name         string
+		encryption   *druidv1alpha1.EncryptionSpec
+		argPrefix    string
+		expectedArgs []string
+	}{
+		{
+			name: "no encryption, should not return any args",
+		},
+		{
+			name: "keys from secret, should return the key ID and the keys directory",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-1",
+				SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+			},
+			expectedArgs: []string{
+				"--encryption-key-id=key-1",
+				"--encryption-keys-dir=" + testEncryptionMountPath,
+			},
+		},
+		{
+			name: "KMS without credentials, should return the key ID and the KMS endpoint",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID: "key-1",
+				KMS:   &druidv1alpha1.KMSSpec{Endpoint: testKMSEndpoint},
+			},
+			expectedArgs: []string{
+				"--encryption-key-id=key-1",
+				"--encryption-kms-endpoint=" + testKMSEndpoint,
+			},
+		},
+		{
+			name: "KMS with credentials and prefix, should return prefixed args including the credentials directory",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID: "key-1",
+				KMS: &druidv1alpha1.KMSSpec{
+					Endpoint:             testKMSEndpoint,
+					CredentialsSecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+				},
+			},
+			argPrefix: "source-",
+			expectedArgs: []string{
+				"--source-encryption-key-id=key-1",
+				"--source-encryption-kms-endpoint=" + testKMSEndpoint,
+				"--source-encryption-kms-credentials-dir=" + testEncryptionMountPath,
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g.Expect(store.GetEncryptionArgs(tc.encryption, tc.argPrefix, testEncryptionMountPath)).To(Equal(tc.expectedArgs))
+		})
+	}
+}
+
+func TestCheckEncryptionSupported(t *testing.T) {
+	encryption := &druidv1alpha1.EncryptionSpec{KeyID: "key-1", SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName}}
+	testCases := []struct {
+		name       string
+		encryption *druidv1alpha1.EncryptionSpec
+		image      string
+		expectErr  bool
+	}{
+		{
+			name:  "no encryption, should succeed for any image",
+			image: "etcdbrctl:v0.41.2",
+		},
+		{
+			name:       "encryption with an image which supports it, should succeed",
+			encryption: encryption,
+			image:      "etcdbrctl:v0.43.0",
+		},
+		{
+			name:       "encryption with an image whose version cannot be determined, should succeed",
+			encryption: encryption,
+			image:      "etcdbrctl@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+		},
+		{
+			name:       "encryption with an image which does not support it, should fail",
+			encryption: encryption,
+			image:      "etcdbrctl:v0.41.2",
+			expectErr:  true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := store.CheckEncryptionSupported(tc.encryption, tc.image)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestGetEncryptionVolume(t *testing.T) {
+	testCases := []struct {
+		name               string
+		encryption         *druidv1alpha1.EncryptionSpec
+		expectedSecretName string
+	}{
+		{
+			name: "no encryption, should not return a volume",
+		},
+		{
+			name: "KMS without credentials, should not return a volume",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID: "key-1",
+				KMS:   &druidv1alpha1.KMSSpec{Endpoint: testKMSEndpoint},
+			},
+		},
+		{
+			name: "keys from secret, should mount the secret",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-1",
+				SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+			},
+			expectedSecretName: testEncryptionSecretName,
+		},
+		{
+			name: "KMS with credentials, should mount the credentials secret",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID: "key-1",
+				KMS: &druidv1alpha1.KMSSpec{
+					Endpoint:             testKMSEndpoint,
+					CredentialsSecretRef: &corev1.LocalObjectReference{Name: "kms-credentials"},
+				},
+			},
+			expectedSecretName: "kms-credentials",
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			volume := store.GetEncryptionVolume(tc.encryption, "test-volume")
+			volumeMount := store.GetEncryptionVolumeMount(tc.encryption, "test-volume", testEncryptionMountPath)
+			if tc.expectedSecretName == "" {
+				g.Expect(volume).To(BeNil())
+				g.Expect(volumeMount).To(BeNil())
+				return
+			}
+			g.Expect(volume).ToNot(BeNil())
+			g.Expect(volume.Name).To(Equal("test-volume"))
+			g.Expect(volume.Secret).ToNot(BeNil())
+			g.Expect(volume.Secret.SecretName).To(Equal(tc.expectedSecretName))
+			g.Expect(volumeMount).To(Equal(&corev1.VolumeMount{Name: "test-volume", MountPath: testEncryptionMountPath, ReadOnly: true}))
+		})
+	}
+}
+
+func TestCheckEncryptionKeys(t *testing.T) {
+	testCases := []struct {
+		name         string
+		encryption   *druidv1alpha1.EncryptionSpec
+		keyIDs       []string
+		secretData   map[string][]byte
+		secretExists bool
+		expectErr    bool
+	}{
+		{
+			name: "no encryption, should not return an error",
+		},
+		{
+			name: "all keys present, should not return an error",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-2",
+				SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+			},
+			keyIDs:       []string{"key-1", "key-2"},
+			secretData:   map[string][]byte{"key-1": []byte("old"), "key-2": []byte("new")},
+			secretExists: true,
+		},
+		{
+			name: "key of an older snapshot missing, should return an error",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-2",
+				SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+			},
+			keyIDs:       []string{"key-1", "key-2"},
+			secretData:   map[string][]byte{"key-2": []byte("new")},
+			secretExists: true,
+			expectErr:    true,
+		},
+		{
+			name: "active key missing, should return an error",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-2",
+				SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+			},
+			secretData:   map[string][]byte{"key-1": []byte("old")},
+			secretExists: true,
+			expectErr:    true,
+		},
+		{
+			name: "secret missing, should return an error",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID:     "key-1",
+				SecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+			},
+			expectErr: true,
+		},
+		{
+			name: "KMS credentials present, should not check the keys",
+			encryption: &druidv1alpha1.EncryptionSpec{
+				KeyID: "key-2",
+				KMS: &druidv1alpha1.KMSSpec{
+					Endpoint:             testKMSEndpoint,
+					CredentialsSecretRef: &corev1.LocalObjectReference{Name: testEncryptionSecretName},
+				},
+			},
+			keyIDs:       []string{"key-1"},
+			secretExists: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var existingObjects []client.Object
+			if tc.secretExists {
+				existingObjects = append(existingObjects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: testEncryptionSecretName, Namespace: testutils.TestNamespace},
+					Data:       tc.secretData,
+				})
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
+			err := store.CheckEncryptionKeys(context.Background(), cl, testutils.TestNamespace, tc.encryption, tc.keyIDs)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+)
+
+// tieredGarbageCollectionVersionConstraint is the constraint on the version of etcd-backup-restore which supports the
+// Tiered garbage collection policy. Older versions do not accept the policy and its tiers and fail to start.
+const tieredGarbageCollectionVersionConstraint = ">= 0.43.0"
+
+// CheckGarbageCollectionPolicySupported returns an error if the given garbage collection policy is not supported by
+// the given etcd-backup-restore image.
+func CheckGarbageCollectionPolicySupported(policy *druidv1alpha1.GarbageCollectionPolicy, image string) error {
+	if policy == nil || *policy != druidv1alpha1.GarbageCollectionPolicyTiered || isSupportedByImage(image, tieredGarbageCollectionVersionConstraint) {
+		return nil
+	}
+	return fmt.Errorf("garbage collection policy %s requires etcd-backup-restore %s, which is not satisfied by image %s", *policy, tieredGarbageCollectionVersionConstraint, image)
+}
+
+// GetGarbageCollectionTierArgs returns the etcd-backup-restore command line arguments for the given tiers of the
+// Tiered garbage collection policy. Each tier is passed as a separate argument with comma separated key-value pairs.
+func GetGarbageCollectionTierArgs(tiers []druidv1alpha1.GarbageCollectionTier) []string {
+	args := make([]string, 0, len(tiers))
+	for _, tier := range tiers {
+		arg := "--garbage-collection-tier=interval=" + tier.Interval.Duration.String()
+		if tier.Count != nil {
+			arg += fmt.Sprintf(",count=%d", *tier.Count)
+		}
+		if tier.RetentionPeriod != nil {
+			arg += ",retention-period=" + tier.RetentionPeriod.Duration.String()
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// ParseRetainedSnapshots parses the number of retained full snapshots per tier as reported by etcd-backup-restore,
+// which has the format `<interval>=<count>[,<interval>=<count>...]`, e.g. `1h0m0s=48,24h0m0s=30`.
+func ParseRetainedSnapshots(value string) (map[time.Duration]int32, error) {
+	retainedSnapshots := make(map[time.Duration]int32)
+	if value == "" {
+		return retainedSnapshots, nil
+	}
+	for _, entry := range strings.Split(value, ",") {
+		intervalStr, countStr, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid retained snapshots entry %q, expected <interval>=<count>", entry)
+		}
+		interval, err := time.ParseDuration(strings.TrimSpace(intervalStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval in retained snapshots entry %q: %w", entry, err)
+		}
+		count, err := strconv.ParseInt(strings.TrimSpace(countStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid count in retained snapshots entry %q: %w", entry, err)
+		}
+		retainedSnapshots[interval] = int32(count)
+	}
+	return retainedSnapshots, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/store"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetGarbageCollectionTierArgs(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(store.GetGarbageCollectionTierArgs(nil)).To(BeEmpty())
+	g.Expect(store.GetGarbageCollectionTierArgs([]druidv1alpha1.GarbageCollectionTier{
+		{Interval: metav1.Duration{Duration: time.Hour}, RetentionPeriod: &metav1.Duration{Duration: 48 * time.Hour}},
+		{Interval: metav1.Duration{Duration: 24 * time.Hour}, Count: ptr.To[int32](30)},
+		{Interval: metav1.Duration{Duration: 7 * 24 * time.Hour}, RetentionPeriod: &metav1.Duration{Duration: 365 * 24 * time.Hour}},
+	})).To(Equal([]string{
+		"--garbage-collection-tier=interval=1h0m0s,retention-period=48h0m0s",
+		"--garbage-collection-tier=interval=24h0m0s,count=30",
+		"--garbage-collection-tier=interval=168h0m0s,retention-period=8760h0m0s",
+	}))
+}
+
+func TestCheckGarbageCollectionPolicySupported(t *testing.T) {
+	g := NewWithT(t)
+
+	tiered := ptr.To[druidv1alpha1.GarbageCollectionPolicy](druidv1alpha1.GarbageCollectionPolicyTiered)
+	g.Expect(store.CheckGarbageCollectionPolicySupported(nil, "etcdbrctl:v0.41.2")).To(Succeed())
+	g.Expect(store.CheckGarbageCollectionPolicySupported(ptr.To[druidv1alpha1.GarbageCollectionPolicy](druidv1alpha1.GarbageCollectionPolicyExponential), "etcdbrctl:v0.41.2")).To(Succeed())
+	g.Expect(store.CheckGarbageCollectionPolicySupported(tiered, "etcdbrctl:v0.43.0")).To(Succeed())
+	g.Expect(store.CheckGarbageCollectionPolicySupported(tiered, "etcdbrctl:v0.41.2")).ToNot(Succeed())
+}
+
+func TestParseRetainedSnapshots(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		expected  map[time.Duration]int32
+		expectErr bool
+	}{
+		{
+			name:     "empty value, should return no retained snapshots",
+			expected: map[time.Duration]int32{},
+		},
+		{
+			name:  "valid value, should return the retained snapshots per interval",
+			value: "1h0m0s=48, 24h=30,168h0m0s=0",
+			expected: map[time.Duration]int32{
+				time.Hour:          48,
+				24 * time.Hour:     30,
+				7 * 24 * time.Hour: 0,
+			},
+		},
+		{
+			name:      "entry without count, should return an error",
+			value:     "1h0m0s",
+			expectErr: true,
+		},
+		{
+			name:      "invalid interval, should return an error",
+			value:     "hourly=48",
+			expectErr: true,
+		},
+		{
+			name:      "invalid count, should return an error",
+			value:     "1h0m0s=many",
+			expectErr: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			retainedSnapshots, err := store.ParseRetainedSnapshots(tc.value)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(retainedSnapshots).To(Equal(tc.expected))
+		})
+	}
+}
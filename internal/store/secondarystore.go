@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// secondaryStoresVersionConstraint is the constraint on the version of etcd-backup-restore which supports the
+// replication of snapshots to secondary stores.
+const secondaryStoresVersionConstraint = ">= 0.43.0"
+
+// SupportsSecondaryStores checks whether the given etcd-backup-restore image supports the replication of snapshots to
+// secondary stores. Older versions do not accept the secondary store command line argument and fail to start.
+func SupportsSecondaryStores(image string) bool {
+	return isSupportedByImage(image, secondaryStoresVersionConstraint)
+}
+
+// GetSecondaryStoreVolumeName returns the name of the volume with the credentials of the given secondary store.
+func GetSecondaryStoreVolumeName(secondaryStore druidv1alpha1.SecondaryStoreSpec) string {
+	return common.VolumeNamePrefixSecondaryStore + secondaryStore.Name
+}
+
+// GetSecondaryStoreMountPath returns the path at which the credentials of the given secondary store are mounted.
+func GetSecondaryStoreMountPath(secondaryStore druidv1alpha1.SecondaryStoreSpec) string {
+	return path.Join(common.VolumeMountPathSecondaryStores, secondaryStore.Name)
+}
+
+// GetSecondaryStoreVolume returns the volume with the credentials of the given secondary store.
+func GetSecondaryStoreVolume(secondaryStore druidv1alpha1.SecondaryStoreSpec) (*corev1.Volume, error) {
+	if secondaryStore.Store.SecretRef == nil {
+		return nil, fmt.Errorf("no secretRef is configured for secondary store %s", secondaryStore.Name)
+	}
+	return &corev1.Volume{
+		Name: GetSecondaryStoreVolumeName(secondaryStore),
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  secondaryStore.Store.SecretRef.Name,
+				DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+			},
+		},
+	}, nil
+}
+
+// GetSecondaryStoreVolumeMount returns the volume mount for the volume returned by GetSecondaryStoreVolume.
+func GetSecondaryStoreVolumeMount(secondaryStore druidv1alpha1.SecondaryStoreSpec) corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      GetSecondaryStoreVolumeName(secondaryStore),
+		MountPath: GetSecondaryStoreMountPath(secondaryStore),
+		ReadOnly:  true,
+	}
+}
+
+// GetSecondaryStoreArg returns the etcd-backup-restore command line argument which configures the replication of
+// snapshots to the given secondary store. All settings of the secondary store are passed as comma separated key-value
+// pairs, the credentials are read from the directory returned by GetSecondaryStoreMountPath.
+func GetSecondaryStoreArg(etcdObjMeta metav1.ObjectMeta, secondaryStore druidv1alpha1.SecondaryStoreSpec) (string, error) {
+	provider, err := StorageProviderFromInfraProvider(secondaryStore.Store.Provider)
+	if err != nil {
+		return "", fmt.Errorf("storage provider of secondary store %s is not recognized: %w", secondaryStore.Name, err)
+	}
+	if provider == Local || provider == "" {
+		return "", fmt.Errorf("storage provider %q is not supported for secondary store %s", provider, secondaryStore.Name)
+	}
+	settings := []string{
+		"name=" + secondaryStore.Name,
+		"storage-provider=" + provider,
+		"store-prefix=" + secondaryStore.Store.Prefix,
+	}
+	if container := ptr.Deref(secondaryStore.Store.Container, ""); container != "" {
+		settings = append(settings, "store-container="+container)
+	}
+	if endpointOverride := ptr.Deref(secondaryStore.Store.EndpointOverride, ""); endpointOverride != "" {
+		settings = append(settings, "store-endpoint-override="+endpointOverride)
+	}
+	settings = append(settings,
+		"credentials-dir="+GetSecondaryStoreMountPath(secondaryStore),
+		"snapshot-lease-name="+druidv1alpha1.GetSecondaryStoreSnapshotLeaseName(etcdObjMeta, secondaryStore.Name),
+		"replicate-delta-snapshots="+strconv.FormatBool(ptr.Deref(secondaryStore.ReplicateDeltaSnapshots, false)),
+	)
+	return "--secondary-store=" + strings.Join(settings, ","), nil
+}
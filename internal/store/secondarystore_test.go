@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/store"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetSecondaryStoreArg(t *testing.T) {
+	etcdObjMeta := metav1.ObjectMeta{Name: "test-etcd", Namespace: testutils.TestNamespace}
+	testCases := []struct {
+		name        string
+		store       druidv1alpha1.StoreSpec
+		replicate   *bool
+		expectedArg string
+		expectErr   bool
+	}{
+		{
+			name: "minimal store, should only replicate full snapshots",
+			store: druidv1alpha1.StoreSpec{
+				Prefix:   "etcd-test",
+				Provider: ptr.To(druidv1alpha1.StorageProvider("aws")),
+			},
+			expectedArg: "--secondary-store=name=dr,storage-provider=S3,store-prefix=etcd-test,credentials-dir=/var/etcdbr/secondary-stores/dr," +
+				"snapshot-lease-name=test-etcd-replicated-snap-dr,replicate-delta-snapshots=false",
+		},
+		{
+			name: "store with container and endpoint override, should pass them and replicate delta snapshots",
+			store: druidv1alpha1.StoreSpec{
+				Container:        ptr.To("bucket"),
+				Prefix:           "etcd-test",
+				Provider:         ptr.To(druidv1alpha1.StorageProvider("gcp")),
+				EndpointOverride: ptr.To("http://gcs-emulator:4443"),
+			},
+			replicate: ptr.To(true),
+			expectedArg: "--secondary-store=name=dr,storage-provider=GCS,store-prefix=etcd-test,store-container=bucket,store-endpoint-override=http://gcs-emulator:4443," +
+				"credentials-dir=/var/etcdbr/secondary-stores/dr,snapshot-lease-name=test-etcd-replicated-snap-dr,replicate-delta-snapshots=true",
+		},
+		{
+			name: "local provider, should return an error",
+			store: druidv1alpha1.StoreSpec{
+				Prefix:   "etcd-test",
+				Provider: ptr.To(druidv1alpha1.StorageProvider(store.Local)),
+			},
+			expectErr: true,
+		},
+		{
+			name:      "no provider, should return an error",
+			store:     druidv1alpha1.StoreSpec{Prefix: "etcd-test"},
+			expectErr: true,
+		},
+		{
+			name: "unknown provider, should return an error",
+			store: druidv1alpha1.StoreSpec{
+				Prefix:   "etcd-test",
+				Provider: ptr.To(druidv1alpha1.StorageProvider("unknown")),
+			},
+			expectErr: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			arg, err := store.GetSecondaryStoreArg(etcdObjMeta, druidv1alpha1.SecondaryStoreSpec{Name: "dr", Store: tc.store, ReplicateDeltaSnapshots: tc.replicate})
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(arg).To(Equal(tc.expectedArg))
+		})
+	}
+}
+
+func TestSupportsSecondaryStores(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(store.SupportsSecondaryStores("etcdbrctl:v0.41.2")).To(BeFalse())
+	g.Expect(store.SupportsSecondaryStores("etcdbrctl:v0.43.0")).To(BeTrue())
+	g.Expect(store.SupportsSecondaryStores("etcdbrctl@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945")).To(BeTrue())
+}
+
+func TestGetSecondaryStoreVolume(t *testing.T) {
+	g := NewWithT(t)
+
+	secondaryStore := druidv1alpha1.SecondaryStoreSpec{Name: "dr"}
+	_, err := store.GetSecondaryStoreVolume(secondaryStore)
+	g.Expect(err).To(HaveOccurred())
+
+	secondaryStore.Store.SecretRef = &corev1.SecretReference{Name: testutils.SecondaryBackupStoreSecretName}
+	volume, err := store.GetSecondaryStoreVolume(secondaryStore)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(volume.Name).To(Equal("secondary-store-dr"))
+	g.Expect(volume.Secret).ToNot(BeNil())
+	g.Expect(volume.Secret.SecretName).To(Equal(testutils.SecondaryBackupStoreSecretName))
+	g.Expect(store.GetSecondaryStoreVolumeMount(secondaryStore)).To(Equal(corev1.VolumeMount{
+		Name:      "secondary-store-dr",
+		MountPath: "/var/etcdbr/secondary-stores/dr",
+		ReadOnly:  true,
+	}))
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -55,6 +56,21 @@ const (
 	OCS = "OCS"
 )
 
+const (
+	// AnnotationAWSRoleARN is the ServiceAccount annotation which holds the ARN of the AWS IAM role assumed with workload identity.
+	AnnotationAWSRoleARN = "eks.amazonaws.com/role-arn"
+	// AnnotationAzureClientID is the ServiceAccount annotation which holds the client ID of the Azure identity used with workload identity.
+	AnnotationAzureClientID = "azure.workload.identity/client-id"
+	// AnnotationAzureTenantID is the ServiceAccount annotation which holds the tenant ID of the Azure identity used with workload identity.
+	AnnotationAzureTenantID = "azure.workload.identity/tenant-id"
+	// WorkloadIdentityTokenFileName is the name of the file the service account token used with workload identity is projected to.
+	WorkloadIdentityTokenFileName = "token"
+
+	defaultWorkloadIdentityAudienceS3            = "sts.amazonaws.com"
+	defaultWorkloadIdentityAudienceABS           = "api://AzureADTokenExchange"
+	workloadIdentityTokenExpirationSeconds int64 = 3600
+)
+
 // StorageProviderFromInfraProvider converts infra to object store provider.
 func StorageProviderFromInfraProvider(infra *druidv1alpha1.StorageProvider) (string, error) {
 	if infra == nil {
@@ -118,6 +134,10 @@ func GetProviderEnvVars(store *druidv1alpha1.StoreSpec) ([]corev1.EnvVar, error)
 		return nil, fmt.Errorf("storage provider is not recognized while fetching secrets from environment variable")
 	}
 
+	if !UsesSecretCredentials(store) {
+		return GetWorkloadIdentityEnvVars(store, provider, "", common.VolumeMountPathWorkloadIdentityToken), nil
+	}
+
 	switch provider {
 	case S3:
 		envVars = append(envVars, utils.GetEnvVarFromValue(common.EnvAWSApplicationCredentials, common.VolumeMountPathNonGCSProviderBackupSecret))
@@ -145,6 +165,87 @@ func GetProviderEnvVars(store *druidv1alpha1.StoreSpec) ([]corev1.EnvVar, error)
 	case OCS:
 		envVars = append(envVars, utils.GetEnvVarFromValue(common.EnvOpenshiftApplicationCredentials, common.VolumeMountPathNonGCSProviderBackupSecret))
 	}
+	envVars = append(envVars, GetWorkloadIdentityEnvVars(store, provider, "", common.VolumeMountPathWorkloadIdentityToken)...)
 
 	return envVars, nil
 }
+
+// UsesSecretCredentials checks if the given store is accessed with the credentials of the secret referenced by it.
+// This is the case unless workload identity is configured without a secret.
+func UsesSecretCredentials(store *druidv1alpha1.StoreSpec) bool {
+	return store.WorkloadIdentity == nil || store.SecretRef != nil
+}
+
+// GetWorkloadIdentityTokenAudience returns the audience of the service account token which is projected for accessing
+// the given store with workload identity, or nil if no token needs to be projected.
+func GetWorkloadIdentityTokenAudience(store *druidv1alpha1.StoreSpec, provider string) *string {
+	if store == nil || store.WorkloadIdentity == nil {
+		return nil
+	}
+	if store.WorkloadIdentity.Audience != nil {
+		return store.WorkloadIdentity.Audience
+	}
+	switch provider {
+	case S3:
+		return ptr.To(defaultWorkloadIdentityAudienceS3)
+	case ABS:
+		return ptr.To(defaultWorkloadIdentityAudienceABS)
+	}
+	return nil
+}
+
+// GetWorkloadIdentityTokenVolume returns a volume with the service account token projected for accessing the given
+// store with workload identity, or nil if no token needs to be projected.
+func GetWorkloadIdentityTokenVolume(store *druidv1alpha1.StoreSpec, provider, volumeName string) *corev1.Volume {
+	audience := GetWorkloadIdentityTokenAudience(store, provider)
+	if audience == nil {
+		return nil
+	}
+	return &corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          *audience,
+							ExpirationSeconds: ptr.To(workloadIdentityTokenExpirationSeconds),
+							Path:              WorkloadIdentityTokenFileName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// GetWorkloadIdentityEnvVars returns the environment variables for accessing the given store with workload identity,
+// given that the projected service account token is mounted at tokenMountPath. The envKeyPrefix is prepended to the
+// keys of all environment variables.
+func GetWorkloadIdentityEnvVars(store *druidv1alpha1.StoreSpec, provider, envKeyPrefix, tokenMountPath string) []corev1.EnvVar {
+	if GetWorkloadIdentityTokenAudience(store, provider) == nil {
+		return nil
+	}
+	var (
+		envVars     []corev1.EnvVar
+		annotations = store.WorkloadIdentity.ServiceAccountAnnotations
+		tokenFile   = tokenMountPath + "/" + WorkloadIdentityTokenFileName
+	)
+	switch provider {
+	case S3:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAWSWebIdentityTokenFile, tokenFile))
+		if roleARN, ok := annotations[AnnotationAWSRoleARN]; ok {
+			envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAWSRoleARN, roleARN))
+		}
+	case ABS:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAzureFederatedTokenFile, tokenFile))
+		if clientID, ok := annotations[AnnotationAzureClientID]; ok {
+			envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAzureClientID, clientID))
+		}
+		if tenantID, ok := annotations[AnnotationAzureTenantID]; ok {
+			envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAzureTenantID, tenantID))
+		}
+	}
+	return envVars
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -22,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 )
 
 func TestGetHostMountPathFromSecretRef(t *testing.T) {
@@ -106,6 +108,117 @@ func TestGetHostMountPathFromSecretRef(t *testing.T) {
 	}
 }
 
+func TestGetProviderEnvVarsWithWorkloadIdentity(t *testing.T) {
+	const roleARN = "arn:aws:iam::123456789012:role/etcd-backup"
+	testCases := []struct {
+		name            string
+		provider        druidv1alpha1.StorageProvider
+		secretRef       *corev1.SecretReference
+		audience        *string
+		expectedEnvVars []corev1.EnvVar
+	}{
+		{
+			name:     "S3 without secret, should only return workload identity env vars",
+			provider: "aws",
+			expectedEnvVars: []corev1.EnvVar{
+				{Name: common.EnvAWSWebIdentityTokenFile, Value: common.VolumeMountPathWorkloadIdentityToken + "/token"},
+				{Name: common.EnvAWSRoleARN, Value: roleARN},
+			},
+		},
+		{
+			name:      "S3 with secret, should return credentials and workload identity env vars",
+			provider:  "aws",
+			secretRef: &corev1.SecretReference{Name: "test-backup-secret"},
+			expectedEnvVars: []corev1.EnvVar{
+				{Name: common.EnvAWSApplicationCredentials, Value: common.VolumeMountPathNonGCSProviderBackupSecret},
+				{Name: common.EnvAWSWebIdentityTokenFile, Value: common.VolumeMountPathWorkloadIdentityToken + "/token"},
+				{Name: common.EnvAWSRoleARN, Value: roleARN},
+			},
+		},
+		{
+			name:            "GCS without audience, should not return any env vars",
+			provider:        "gcp",
+			expectedEnvVars: nil,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			storeSpec := &druidv1alpha1.StoreSpec{
+				Provider:  ptr.To(tc.provider),
+				SecretRef: tc.secretRef,
+				WorkloadIdentity: &druidv1alpha1.WorkloadIdentity{
+					ServiceAccountAnnotations: map[string]string{store.AnnotationAWSRoleARN: roleARN},
+					Audience:                  tc.audience,
+				},
+			}
+			envVars, err := store.GetProviderEnvVars(storeSpec)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(envVars).To(Equal(tc.expectedEnvVars))
+		})
+	}
+}
+
+func TestGetWorkloadIdentityTokenVolume(t *testing.T) {
+	testCases := []struct {
+		name             string
+		provider         string
+		workloadIdentity *druidv1alpha1.WorkloadIdentity
+		expectedAudience *string
+	}{
+		{
+			name:     "no workload identity, should not return a volume",
+			provider: store.S3,
+		},
+		{
+			name:             "S3, should default the audience",
+			provider:         store.S3,
+			workloadIdentity: &druidv1alpha1.WorkloadIdentity{},
+			expectedAudience: ptr.To("sts.amazonaws.com"),
+		},
+		{
+			name:             "ABS, should default the audience",
+			provider:         store.ABS,
+			workloadIdentity: &druidv1alpha1.WorkloadIdentity{},
+			expectedAudience: ptr.To("api://AzureADTokenExchange"),
+		},
+		{
+			name:             "GCS without audience, should not return a volume",
+			provider:         store.GCS,
+			workloadIdentity: &druidv1alpha1.WorkloadIdentity{},
+		},
+		{
+			name:             "GCS with audience, should use the configured audience",
+			provider:         store.GCS,
+			workloadIdentity: &druidv1alpha1.WorkloadIdentity{Audience: ptr.To("test-audience")},
+			expectedAudience: ptr.To("test-audience"),
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			volume := store.GetWorkloadIdentityTokenVolume(&druidv1alpha1.StoreSpec{WorkloadIdentity: tc.workloadIdentity}, tc.provider, "test-volume")
+			if tc.expectedAudience == nil {
+				g.Expect(volume).To(BeNil())
+				return
+			}
+			g.Expect(volume).ToNot(BeNil())
+			g.Expect(volume.Name).To(Equal("test-volume"))
+			g.Expect(volume.Projected).ToNot(BeNil())
+			g.Expect(volume.Projected.Sources).To(ConsistOf(HaveField("ServiceAccountToken", PointTo(MatchFields(IgnoreExtras, Fields{
+				"Audience": Equal(*tc.expectedAudience),
+				"Path":     Equal(store.WorkloadIdentityTokenFileName),
+			})))))
+		})
+	}
+}
+
 func createStoreSpec(secretRefDefined bool, secretName, secretNamespace string) *druidv1alpha1.StoreSpec {
 	var secretRef *corev1.SecretReference
 	if secretRefDefined {
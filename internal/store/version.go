@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"github.com/gardener/etcd-druid/internal/utils/version"
+)
+
+// isSupportedByImage checks whether the version of the given etcd-backup-restore image meets the given constraint.
+// Images whose version cannot be determined from their tag, e.g. because they are referenced by digest only, are
+// assumed to be recent enough.
+func isSupportedByImage(image, constraint string) bool {
+	ok, err := version.CheckImageVersionMeetsConstraint(image, constraint)
+	return err != nil || ok
+}
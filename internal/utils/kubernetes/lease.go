@@ -6,6 +6,8 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -98,3 +100,25 @@ func parseAndGetTLSEnabledValue(leaseObjMeta metav1.PartialObjectMetadata, logge
 	}
 	return false, nil
 }
+
+// GetSnapshotsFromLease returns the snapshots which etcd-backup-restore has recorded on the given full or delta
+// snapshot lease, ordered from oldest to newest. If the snapshots have not been recorded by etcd-backup-restore, only
+// the last revision from the holder identity and the renew time of the lease are known, from which a single snapshot
+// is returned. No snapshot is returned if the lease has not been renewed yet.
+func GetSnapshotsFromLease(lease *coordinationv1.Lease) ([]druidv1alpha1.SnapshotInfo, error) {
+	if value, ok := lease.Annotations[common.LeaseAnnotationKeySnapshots]; ok {
+		var snapshots []druidv1alpha1.SnapshotInfo
+		if err := json.Unmarshal([]byte(value), &snapshots); err != nil {
+			return nil, fmt.Errorf("snapshots annotation of lease %s is not valid: %w", lease.Name, err)
+		}
+		return snapshots, nil
+	}
+	if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil {
+		return nil, nil
+	}
+	lastRevision, err := strconv.ParseInt(*lease.Spec.HolderIdentity, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("holder identity of lease %s is not a revision: %w", lease.Name, err)
+	}
+	return []druidv1alpha1.SnapshotInfo{{LastRevision: lastRevision, CreatedOn: metav1.NewTime(lease.Spec.RenewTime.Time)}}, nil
+}
@@ -20,6 +20,7 @@ import (
 	coordinationv1 "k8s.io/api/coordination/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/onsi/gomega"
@@ -188,3 +189,79 @@ func randomizeAnnotations() map[string]string {
 	}
 	return nil
 }
+
+func TestGetSnapshotsFromLease(t *testing.T) {
+	renewTime := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name              string
+		annotations       map[string]string
+		holderIdentity    *string
+		expectedSnapshots []druidv1alpha1.SnapshotInfo
+		expectErr         bool
+	}{
+		{
+			name: "lease not renewed yet, should not return any snapshots",
+		},
+		{
+			name:           "no snapshots annotation, should return the snapshot from holder identity and renew time",
+			holderIdentity: ptr.To("1500"),
+			expectedSnapshots: []druidv1alpha1.SnapshotInfo{
+				{LastRevision: 1500, CreatedOn: metav1.NewTime(renewTime)},
+			},
+		},
+		{
+			name:           "holder identity is not a revision, should return an error",
+			holderIdentity: ptr.To("not-a-revision"),
+			expectErr:      true,
+		},
+		{
+			name: "snapshots annotation, should return the recorded snapshots",
+			annotations: map[string]string{
+				common.LeaseAnnotationKeySnapshots: `[{"name":"Incr-1001-1200-1748772000.gz","startRevision":1001,"lastRevision":1200,"createdOn":"2025-06-01T10:00:00Z","sizeBytes":2048,"compressionPolicy":"gzip"}]`,
+			},
+			holderIdentity: ptr.To("1200"),
+			expectedSnapshots: []druidv1alpha1.SnapshotInfo{
+				{
+					Name:              "Incr-1001-1200-1748772000.gz",
+					StartRevision:     1001,
+					LastRevision:      1200,
+					CreatedOn:         metav1.NewTime(renewTime),
+					SizeBytes:         ptr.To[int64](2048),
+					CompressionPolicy: ptr.To(druidv1alpha1.GzipCompression),
+				},
+			},
+		},
+		{
+			name:        "invalid snapshots annotation, should return an error",
+			annotations: map[string]string{common.LeaseAnnotationKeySnapshots: "{"},
+			expectErr:   true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			lease := &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-lease", Annotations: tc.annotations},
+				Spec:       coordinationv1.LeaseSpec{HolderIdentity: tc.holderIdentity},
+			}
+			if tc.holderIdentity != nil {
+				lease.Spec.RenewTime = &metav1.MicroTime{Time: renewTime}
+			}
+			snapshots, err := GetSnapshotsFromLease(lease)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(snapshots).To(HaveLen(len(tc.expectedSnapshots)))
+			for i := range tc.expectedSnapshots {
+				g.Expect(snapshots[i].CreatedOn.Equal(&tc.expectedSnapshots[i].CreatedOn)).To(BeTrue())
+				snapshots[i].CreatedOn = tc.expectedSnapshots[i].CreatedOn
+			}
+			g.Expect(snapshots).To(Equal(tc.expectedSnapshots))
+		})
+	}
+}
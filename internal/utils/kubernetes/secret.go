@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetReferencedSecretNames returns the sorted names of all TLS and backup store secrets referenced by the given Etcd.
+func GetReferencedSecretNames(etcd *druidv1alpha1.Etcd) []string {
+	secretNames := sets.New[string]()
+	if tls := etcd.Spec.Etcd.ClientUrlTLS; tls != nil {
+		secretNames.Insert(tls.TLSCASecretRef.Name, tls.ServerTLSSecretRef.Name, tls.ClientTLSSecretRef.Name)
+	}
+	if tls := etcd.Spec.Etcd.PeerUrlTLS; tls != nil {
+		// Currently, no client certificate for peer url is used in ETCD cluster
+		secretNames.Insert(tls.TLSCASecretRef.Name, tls.ServerTLSSecretRef.Name)
+	}
+	if tls := etcd.Spec.Backup.TLS; tls != nil {
+		secretNames.Insert(tls.TLSCASecretRef.Name, tls.ServerTLSSecretRef.Name, tls.ClientTLSSecretRef.Name)
+	}
+	if etcd.IsBackupStoreEnabled() && etcd.Spec.Backup.Store.SecretRef != nil {
+		secretNames.Insert(etcd.Spec.Backup.Store.SecretRef.Name)
+	}
+	for _, secondaryStore := range etcd.Spec.Backup.SecondaryStores {
+		if secondaryStore.Store.SecretRef != nil {
+			secretNames.Insert(secondaryStore.Store.SecretRef.Name)
+		}
+	}
+	secretNames.Delete("")
+	return sets.List(secretNames)
+}
+
+// ComputeSecretsCheckSum computes a checksum over the data of all TLS and backup store secrets referenced by the given
+// Etcd. The checksum changes whenever the contents of any of these secrets change.
+func ComputeSecretsCheckSum(ctx context.Context, cl client.Client, etcd *druidv1alpha1.Etcd) (string, error) {
+	secretsData := make(map[string]map[string][]byte)
+	for _, secretName := range GetReferencedSecretNames(etcd) {
+		secret := &corev1.Secret{}
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: etcd.Namespace, Name: secretName}, secret); err != nil {
+			return "", fmt.Errorf("could not get secret %s/%s referenced by etcd %s: %w", etcd.Namespace, secretName, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta), err)
+		}
+		secretsData[secretName] = secret.Data
+	}
+	jsonData, err := json.Marshal(secretsData)
+	if err != nil {
+		return "", err
+	}
+	return utils.ComputeSHA256Hex(jsonData), nil
+}
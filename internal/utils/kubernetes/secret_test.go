@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetReferencedSecretNames(t *testing.T) {
+	g := NewWithT(t)
+	etcd := testutils.EtcdBuilderWithoutDefaults(testutils.TestEtcdName, testutils.TestNamespace).
+		WithClientTLS().
+		WithPeerTLS().
+		WithDefaultBackup().
+		WithSecondaryBackupStore("secondary").
+		Build()
+	g.Expect(GetReferencedSecretNames(etcd)).To(Equal([]string{
+		testutils.ClientTLSCASecretName,
+		testutils.ClientTLSClientCertSecretName,
+		testutils.ClientTLSServerCertSecretName,
+		testutils.BackupStoreSecretName,
+		testutils.SecondaryBackupStoreSecretName,
+		testutils.PeerTLSCASecretName,
+		testutils.PeerTLSServerCertSecretName,
+	}))
+}
+
+func TestComputeSecretsCheckSum(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	etcd := testutils.EtcdBuilderWithoutDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+	cl := testutils.CreateDefaultFakeClient()
+
+	_, err := ComputeSecretsCheckSum(ctx, cl, etcd)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	g.Expect(testutils.CreateSecrets(ctx, cl, etcd.Namespace, GetReferencedSecretNames(etcd)...)).To(Succeed())
+	checkSum, err := ComputeSecretsCheckSum(ctx, cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(checkSum).ToNot(BeEmpty())
+
+	unchangedCheckSum, err := ComputeSecretsCheckSum(ctx, cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(unchangedCheckSum).To(Equal(checkSum))
+
+	secret := &corev1.Secret{}
+	g.Expect(cl.Get(ctx, client.ObjectKey{Namespace: etcd.Namespace, Name: testutils.ClientTLSServerCertSecretName}, secret)).To(Succeed())
+	secret.Data["tls.crt"] = []byte("rotated")
+	g.Expect(cl.Update(ctx, secret)).To(Succeed())
+	changedCheckSum, err := ComputeSecretsCheckSum(ctx, cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changedCheckSum).ToNot(Equal(checkSum))
+}
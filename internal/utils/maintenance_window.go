@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"github.com/robfig/cron/v3"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// IsMaintenanceWindowOpen returns true if the given maintenance window is open at the given time. The window is open
+// if it has opened according to its schedule within its duration before the given time. A nil maintenance window is
+// always open, an error is returned if the schedule of the window cannot be parsed.
+func IsMaintenanceWindowOpen(window *druidv1alpha1.MaintenanceWindow, now time.Time) (bool, error) {
+	if window == nil {
+		return true, nil
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return false, err
+	}
+	// Next returns the first activation strictly after the given time, the window is therefore open if it has been
+	// activated after now-duration and not after now.
+	return !schedule.Next(now.Add(-window.Duration.Duration)).After(now), nil
+}
+
+// ShouldDeferToMaintenanceWindow returns true if disruptive operations on the given Etcd have to be deferred at the
+// given time because its maintenance window is closed, together with the time at which the window opens next.
+// Operations are never deferred if the Etcd does not define a maintenance window or if it is annotated with
+// druidv1alpha1.IgnoreMaintenanceWindowAnnotation.
+func ShouldDeferToMaintenanceWindow(etcd *druidv1alpha1.Etcd, now time.Time) (bool, time.Time, error) {
+	window := etcd.Spec.MaintenanceWindow
+	if window == nil || druidv1alpha1.IsMaintenanceWindowIgnored(etcd.ObjectMeta) {
+		return false, time.Time{}, nil
+	}
+	open, err := IsMaintenanceWindowOpen(window, now)
+	if err != nil || open {
+		return false, time.Time{}, err
+	}
+	// The schedule has already been parsed successfully to determine whether the window is open.
+	schedule, _ := cron.ParseStandard(window.Schedule)
+	return true, schedule.Next(now), nil
+}
+
+// IsDeferrableOperationPending returns true if an operation which is deferred while the maintenance window of the given
+// Etcd is closed is still pending, i.e. if an operation is recorded as deferred in the status or if the latest spec has
+// not been reconciled yet. The annotation which bypasses the maintenance window is only removed by the consumer which
+// carries out the last pending operation, so that the bypass applies to every operation which is pending when it is set.
+func IsDeferrableOperationPending(etcd *druidv1alpha1.Etcd) bool {
+	return len(etcd.Status.DeferredOperations) > 0 ||
+		druidv1alpha1.HasReconcileOperationAnnotation(etcd.ObjectMeta) ||
+		etcd.Status.ObservedGeneration == nil || *etcd.Status.ObservedGeneration != etcd.Generation
+}
+
+// SetDeferredOperation records the operation of the given type as deferred until the given scheduled time in the
+// status of the Etcd. DeferredSince is retained if the operation has already been deferred before. It returns true if
+// the status has been changed.
+func SetDeferredOperation(status *druidv1alpha1.EtcdStatus, opType druidv1alpha1.DeferredOperationType, description string, scheduledTime, now time.Time) bool {
+	desired := druidv1alpha1.DeferredOperation{
+		Type:          opType,
+		Description:   description,
+		DeferredSince: metav1.NewTime(now),
+		ScheduledTime: ptr.To(metav1.NewTime(scheduledTime)),
+	}
+	for i, op := range status.DeferredOperations {
+		if op.Type != opType {
+			continue
+		}
+		desired.DeferredSince = op.DeferredSince
+		if apiequality.Semantic.DeepEqual(op, desired) {
+			return false
+		}
+		status.DeferredOperations[i] = desired
+		return true
+	}
+	status.DeferredOperations = append(status.DeferredOperations, desired)
+	return true
+}
+
+// RemoveDeferredOperation removes the operation of the given type from the deferred operations in the status of the
+// Etcd. It returns true if the status has been changed.
+func RemoveDeferredOperation(status *druidv1alpha1.EtcdStatus, opType druidv1alpha1.DeferredOperationType) bool {
+	for i, op := range status.DeferredOperations {
+		if op.Type == opType {
+			status.DeferredOperations = append(status.DeferredOperations[:i], status.DeferredOperations[i+1:]...)
+			if len(status.DeferredOperations) == 0 {
+				status.DeferredOperations = nil
+			}
+			return true
+		}
+	}
+	return false
+}
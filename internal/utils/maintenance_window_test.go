@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIsMaintenanceWindowOpen(t *testing.T) {
+	window := &druidv1alpha1.MaintenanceWindow{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}}
+	testCases := []struct {
+		name        string
+		window      *druidv1alpha1.MaintenanceWindow
+		now         time.Time
+		expected    bool
+		expectedErr bool
+	}{
+		{
+			name:     "nil maintenance window is always open",
+			now:      time.Date(2025, 1, 1, 12, 0, 0, 0, time.Local),
+			expected: true,
+		},
+		{
+			name:     "window is open at its start",
+			window:   window,
+			now:      time.Date(2025, 1, 1, 2, 0, 0, 0, time.Local),
+			expected: true,
+		},
+		{
+			name:     "window is open within its duration",
+			window:   window,
+			now:      time.Date(2025, 1, 1, 2, 59, 0, 0, time.Local),
+			expected: true,
+		},
+		{
+			name:     "window is closed after its duration",
+			window:   window,
+			now:      time.Date(2025, 1, 1, 3, 0, 0, 0, time.Local),
+			expected: false,
+		},
+		{
+			name:     "window is closed before it opens",
+			window:   window,
+			now:      time.Date(2025, 1, 1, 1, 59, 0, 0, time.Local),
+			expected: false,
+		},
+		{
+			name:        "invalid schedule",
+			window:      &druidv1alpha1.MaintenanceWindow{Schedule: "invalid", Duration: metav1.Duration{Duration: time.Hour}},
+			now:         time.Date(2025, 1, 1, 2, 0, 0, 0, time.Local),
+			expectedErr: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			open, err := IsMaintenanceWindowOpen(tc.window, tc.now)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(open).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestShouldDeferToMaintenanceWindow(t *testing.T) {
+	window := &druidv1alpha1.MaintenanceWindow{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}}
+	testCases := []struct {
+		name         string
+		window       *druidv1alpha1.MaintenanceWindow
+		annotations  map[string]string
+		now          time.Time
+		expected     bool
+		expectedNext time.Time
+		expectedErr  bool
+	}{
+		{
+			name: "operations are not deferred without maintenance window",
+			now:  time.Date(2025, 1, 1, 12, 0, 0, 0, time.Local),
+		},
+		{
+			name:   "operations are not deferred while the window is open",
+			window: window,
+			now:    time.Date(2025, 1, 1, 2, 30, 0, 0, time.Local),
+		},
+		{
+			name:         "operations are deferred while the window is closed",
+			window:       window,
+			now:          time.Date(2025, 1, 1, 12, 0, 0, 0, time.Local),
+			expected:     true,
+			expectedNext: time.Date(2025, 1, 2, 2, 0, 0, 0, time.Local),
+		},
+		{
+			name:        "operations are not deferred if the window is ignored",
+			window:      window,
+			annotations: map[string]string{druidv1alpha1.IgnoreMaintenanceWindowAnnotation: ""},
+			now:         time.Date(2025, 1, 1, 12, 0, 0, 0, time.Local),
+		},
+		{
+			name:        "invalid schedule",
+			window:      &druidv1alpha1.MaintenanceWindow{Schedule: "invalid", Duration: metav1.Duration{Duration: time.Hour}},
+			now:         time.Date(2025, 1, 1, 12, 0, 0, 0, time.Local),
+			expectedErr: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       druidv1alpha1.EtcdSpec{MaintenanceWindow: tc.window},
+			}
+			deferred, next, err := ShouldDeferToMaintenanceWindow(etcd, tc.now)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(deferred).To(Equal(tc.expected))
+			g.Expect(next).To(BeTemporally("==", tc.expectedNext))
+		})
+	}
+}
+
+func TestIsDeferrableOperationPending(t *testing.T) {
+	testCases := []struct {
+		name               string
+		annotations        map[string]string
+		observedGeneration *int64
+		deferredOperations []druidv1alpha1.DeferredOperation
+		expected           bool
+	}{
+		{
+			name:               "no operation is pending if the latest spec has been reconciled and nothing is deferred",
+			observedGeneration: ptr.To[int64](1),
+		},
+		{
+			name:               "an operation is pending if an operation is deferred",
+			observedGeneration: ptr.To[int64](1),
+			deferredOperations: []druidv1alpha1.DeferredOperation{{Type: druidv1alpha1.DeferredOperationTypeCompaction}},
+			expected:           true,
+		},
+		{
+			name:               "an operation is pending if the latest spec has not been reconciled",
+			observedGeneration: ptr.To[int64](0),
+			expected:           true,
+		},
+		{
+			name:     "an operation is pending if the spec has never been reconciled",
+			expected: true,
+		},
+		{
+			name:               "an operation is pending if a spec reconciliation has been requested",
+			annotations:        map[string]string{druidv1alpha1.DruidOperationAnnotation: druidv1alpha1.DruidOperationReconcile},
+			observedGeneration: ptr.To[int64](1),
+			expected:           true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations, Generation: 1},
+				Status: druidv1alpha1.EtcdStatus{
+					ObservedGeneration: tc.observedGeneration,
+					DeferredOperations: tc.deferredOperations,
+				},
+			}
+			g.Expect(IsDeferrableOperationPending(etcd)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestSetAndRemoveDeferredOperation(t *testing.T) {
+	g := NewWithT(t)
+	deferredSince := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	scheduledTime := time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)
+	status := &druidv1alpha1.EtcdStatus{}
+
+	g.Expect(SetDeferredOperation(status, druidv1alpha1.DeferredOperationTypeCompaction, "compaction", scheduledTime, deferredSince)).To(BeTrue())
+	g.Expect(status.DeferredOperations).To(HaveLen(1))
+	// setting the same operation again does not change the status and retains the time since which it is deferred
+	g.Expect(SetDeferredOperation(status, druidv1alpha1.DeferredOperationTypeCompaction, "compaction", scheduledTime, deferredSince.Add(time.Hour))).To(BeFalse())
+	g.Expect(SetDeferredOperation(status, druidv1alpha1.DeferredOperationTypeCompaction, "compaction", scheduledTime.Add(24*time.Hour), deferredSince.Add(time.Hour))).To(BeTrue())
+	g.Expect(status.DeferredOperations).To(HaveLen(1))
+	g.Expect(status.DeferredOperations[0].DeferredSince.Time).To(BeTemporally("==", deferredSince))
+	g.Expect(status.DeferredOperations[0].ScheduledTime.Time).To(BeTemporally("==", scheduledTime.Add(24*time.Hour)))
+
+	g.Expect(SetDeferredOperation(status, druidv1alpha1.DeferredOperationTypeRollingUpdate, "rolling update", scheduledTime, deferredSince)).To(BeTrue())
+	g.Expect(status.DeferredOperations).To(HaveLen(2))
+
+	g.Expect(RemoveDeferredOperation(status, druidv1alpha1.DeferredOperationTypeCompaction)).To(BeTrue())
+	g.Expect(RemoveDeferredOperation(status, druidv1alpha1.DeferredOperationTypeCompaction)).To(BeFalse())
+	g.Expect(status.DeferredOperations).To(HaveLen(1))
+	g.Expect(status.DeferredOperations[0].Type).To(Equal(druidv1alpha1.DeferredOperationTypeRollingUpdate))
+	g.Expect(RemoveDeferredOperation(status, druidv1alpha1.DeferredOperationTypeRollingUpdate)).To(BeTrue())
+	g.Expect(status.DeferredOperations).To(BeNil())
+}
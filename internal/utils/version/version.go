@@ -5,6 +5,7 @@
 package version
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -25,6 +26,16 @@ func CheckVersionMeetsConstraint(version, constraint string) (bool, error) {
 	return c.Check(v), nil
 }
 
+// CheckImageVersionMeetsConstraint returns true if the version given by the tag of the <image> meets the <constraint>.
+// An error is returned if the version cannot be determined, e.g. because the image is referenced by digest only.
+func CheckImageVersionMeetsConstraint(image, constraint string) (bool, error) {
+	i := strings.LastIndex(image, ":")
+	if i == -1 || strings.Contains(image[i:], "/") || strings.Contains(image, "@") {
+		return false, fmt.Errorf("cannot determine the version of image %s", image)
+	}
+	return CheckVersionMeetsConstraint(image[i+1:], constraint)
+}
+
 func normalize(version string) string {
 	v := strings.ReplaceAll(version, "v", "")
 	idx := strings.IndexAny(v, "-+")
@@ -55,3 +55,53 @@ func TestCheckVersionMeetsConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckImageVersionMeetsConstraint(t *testing.T) {
+	tests := []struct {
+		name           string
+		image          string
+		expectedResult bool
+		expectErr      bool
+	}{
+		{
+			name:           "version of image matches constraint",
+			image:          "europe-docker.pkg.dev/gardener-project/public/gardener/etcdbrctl:v0.43.0",
+			expectedResult: true,
+		},
+		{
+			name:           "version of image does not match constraint",
+			image:          "europe-docker.pkg.dev/gardener-project/public/gardener/etcdbrctl:v0.41.2",
+			expectedResult: false,
+		},
+		{
+			name:           "version of image on registry with port matches constraint",
+			image:          "localhost:5001/etcdbrctl:v0.44.1",
+			expectedResult: true,
+		},
+		{
+			name:      "image without tag",
+			image:     "localhost:5001/etcdbrctl",
+			expectErr: true,
+		},
+		{
+			name:      "image referenced by digest",
+			image:     "etcdbrctl:v0.43.0@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+			expectErr: true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := CheckImageVersionMeetsConstraint(test.image, ">= 0.43.0")
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(Equal(test.expectedResult))
+		})
+	}
+}
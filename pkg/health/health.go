@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health allows binaries which embed etcd-druid to register condition and etcd member checks in addition to
+// the built-in checks. Registered checks are executed once they are enabled in controllers.etcd.extraChecks of the
+// operator configuration.
+package health
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+	"github.com/gardener/etcd-druid/internal/health/etcdmember"
+	"github.com/gardener/etcd-druid/internal/health/status"
+)
+
+type (
+	// ConditionChecker checks an Etcd and returns the result for a condition. Returning nil keeps the previous condition.
+	ConditionChecker = condition.Checker
+	// ConditionResult is the result of a condition check.
+	ConditionResult = condition.Result
+	// ConditionCheckFn returns a ConditionChecker.
+	ConditionCheckFn = status.ConditionCheckFn
+	// EtcdMemberChecker checks the members of an Etcd and returns a result for every member.
+	EtcdMemberChecker = etcdmember.Checker
+	// EtcdMemberResult is the result of an etcd member check for a single member.
+	EtcdMemberResult = etcdmember.Result
+	// EtcdMemberCheckFn returns an EtcdMemberChecker.
+	EtcdMemberCheckFn = status.EtcdMemberCheckFn
+)
+
+// NewConditionResult returns a condition result with the given values.
+func NewConditionResult(conType druidv1alpha1.ConditionType, status druidv1alpha1.ConditionStatus, reason, message string) ConditionResult {
+	return condition.NewResult(conType, status, reason, message)
+}
+
+// EtcdMemberResultFromStatus returns an etcd member result which carries the values of the given etcd member status.
+func EtcdMemberResultFromStatus(member druidv1alpha1.EtcdMemberStatus) EtcdMemberResult {
+	return etcdmember.ResultFromStatus(member)
+}
+
+// RegisterConditionCheck registers a condition check under the given name. The check must only return results for the
+// given condition type. It panics if a condition check has already been registered with the same name or if the
+// condition type is a built-in one.
+func RegisterConditionCheck(name string, conditionType druidv1alpha1.ConditionType, checkFn ConditionCheckFn) {
+	status.RegisterConditionCheck(name, conditionType, checkFn)
+}
+
+// RegisterEtcdMemberCheck registers an etcd member check under the given name. It panics if an etcd member check has
+// already been registered with the same name.
+func RegisterEtcdMemberCheck(name string, checkFn EtcdMemberCheckFn) {
+	status.RegisterEtcdMemberCheck(name, checkFn)
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manager allows binaries which embed etcd-druid to create the etcd-druid controller manager.
+package manager
+
+import (
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidmgr "github.com/gardener/etcd-druid/internal/manager"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// InitializeManager creates a controller manager and adds all the controllers and webhooks to the controller-manager
+// using the passed in Config. Checks which have been registered through the health package must be registered before.
+func InitializeManager(config *druidconfigv1alpha1.OperatorConfiguration) (ctrl.Manager, error) {
+	return druidmgr.InitializeManager(config)
+}
@@ -8,8 +8,14 @@ package etcd
 
 import (
 	"testing"
+	"time"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // TestSpecExternallyManagedMemberAddresses tests the validation of the Spec.ExternallyManagedMemberAddresses field.
@@ -64,3 +70,108 @@ func TestSpecExternallyManagedMemberAddresses(t *testing.T) {
 		})
 	}
 }
+
+// validates the cron expression passed into the etcd.spec.maintenanceWindow.schedule field.
+func TestValidateSpecMaintenanceWindowSchedule(t *testing.T) {
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range cronFieldTestCases {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.MaintenanceWindow = &druidv1alpha1.MaintenanceWindow{Schedule: test.value, Duration: metav1.Duration{Duration: time.Hour}}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
+
+// validates that the etcd.spec.maintenanceWindow.duration field is greater than zero.
+func TestValidateSpecMaintenanceWindowDuration(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name      string
+		etcdName  string
+		duration  time.Duration
+		expectErr bool
+	}{
+		{
+			name:      "Valid duration",
+			etcdName:  "etcd-valid-1",
+			duration:  time.Hour,
+			expectErr: false,
+		},
+		{
+			name:      "Invalid duration #1: zero",
+			etcdName:  "etcd-invalid-1",
+			duration:  0,
+			expectErr: true,
+		},
+		{
+			name:      "Invalid duration #2: negative",
+			etcdName:  "etcd-invalid-2",
+			duration:  -time.Hour,
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.MaintenanceWindow = &druidv1alpha1.MaintenanceWindow{Schedule: "0 1 * * *", Duration: metav1.Duration{Duration: test.duration}}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
+
+// validates that the minimum resources in etcd.spec.resourceRecommendation do not exceed the maximum resources.
+func TestValidateSpecResourceRecommendationBounds(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name       string
+		etcdName   string
+		minAllowed corev1.ResourceList
+		maxAllowed corev1.ResourceList
+		expectErr  bool
+	}{
+		{
+			name:       "Valid bounds #1: minimum below maximum",
+			etcdName:   "etcd-valid-1",
+			minAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+			maxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			expectErr:  false,
+		},
+		{
+			name:       "Valid bounds #2: only a minimum",
+			etcdName:   "etcd-valid-2",
+			minAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			expectErr:  false,
+		},
+		{
+			name:       "Valid bounds #3: different resources",
+			etcdName:   "etcd-valid-3",
+			minAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			maxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			expectErr:  false,
+		},
+		{
+			name:       "Invalid bounds: minimum above maximum",
+			etcdName:   "etcd-invalid-1",
+			minAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			maxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			expectErr:  true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.ResourceRecommendation = &druidv1alpha1.ResourceRecommendationSpec{
+				Etcd: &druidv1alpha1.ResourceBounds{MinAllowed: test.minAllowed, MaxAllowed: test.maxAllowed},
+			}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
@@ -8,9 +8,14 @@ package etcd
 
 import (
 	"testing"
+	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // TestValidateGarbageCollectionPolicy tests the validation of `Spec.Backup.GarbageCollectionPolicy` field in the Etcd resource.
@@ -38,7 +43,85 @@ func TestValidateSpecBackupGarbageCollectionPolicy(t *testing.T) {
 	}
 }
 
-// runs validation on the field etcd.spec.backup.compression.policy. Accepted values: gzip, lzw, zlib
+// TestValidateSpecBackupGarbageCollectionTiers tests the validation of `Spec.Backup.GarbageCollectionTiers` field in the Etcd resource.
+func TestValidateSpecBackupGarbageCollectionTiers(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	hourly := druidv1alpha1.GarbageCollectionTier{Interval: metav1.Duration{Duration: time.Hour}, RetentionPeriod: &metav1.Duration{Duration: 48 * time.Hour}}
+	daily := druidv1alpha1.GarbageCollectionTier{Interval: metav1.Duration{Duration: 24 * time.Hour}, Count: ptr.To[int32](30)}
+	tests := []struct {
+		name      string
+		etcdName  string
+		policy    string
+		tiers     []druidv1alpha1.GarbageCollectionTier
+		expectErr bool
+	}{
+		{
+			name:      "Tiered policy with ascending tiers; valid",
+			etcdName:  "etcd-valid-1",
+			policy:    druidv1alpha1.GarbageCollectionPolicyTiered,
+			tiers:     []druidv1alpha1.GarbageCollectionTier{hourly, daily},
+			expectErr: false,
+		},
+		{
+			name:      "Tiered policy without tiers; invalid",
+			etcdName:  "etcd-invalid-1",
+			policy:    druidv1alpha1.GarbageCollectionPolicyTiered,
+			expectErr: true,
+		},
+		{
+			name:      "tiers with LimitBased policy; invalid",
+			etcdName:  "etcd-invalid-2",
+			policy:    druidv1alpha1.GarbageCollectionPolicyLimitBased,
+			tiers:     []druidv1alpha1.GarbageCollectionTier{hourly},
+			expectErr: true,
+		},
+		{
+			name:      "tiers with descending intervals; invalid",
+			etcdName:  "etcd-invalid-3",
+			policy:    druidv1alpha1.GarbageCollectionPolicyTiered,
+			tiers:     []druidv1alpha1.GarbageCollectionTier{daily, hourly},
+			expectErr: true,
+		},
+		{
+			name:      "tiers with duplicate intervals; invalid",
+			etcdName:  "etcd-invalid-4",
+			policy:    druidv1alpha1.GarbageCollectionPolicyTiered,
+			tiers:     []druidv1alpha1.GarbageCollectionTier{hourly, hourly},
+			expectErr: true,
+		},
+		{
+			name:     "tier with count and retention period; invalid",
+			etcdName: "etcd-invalid-5",
+			policy:   druidv1alpha1.GarbageCollectionPolicyTiered,
+			tiers: []druidv1alpha1.GarbageCollectionTier{
+				{Interval: metav1.Duration{Duration: time.Hour}, Count: ptr.To[int32](48), RetentionPeriod: &metav1.Duration{Duration: 48 * time.Hour}},
+			},
+			expectErr: true,
+		},
+		{
+			name:     "tier with retention period less than interval; invalid",
+			etcdName: "etcd-invalid-6",
+			policy:   druidv1alpha1.GarbageCollectionPolicyTiered,
+			tiers: []druidv1alpha1.GarbageCollectionTier{
+				{Interval: metav1.Duration{Duration: 24 * time.Hour}, RetentionPeriod: &metav1.Duration{Duration: time.Hour}},
+			},
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.Backup.GarbageCollectionPolicy = (*druidv1alpha1.GarbageCollectionPolicy)(&test.policy)
+			etcd.Spec.Backup.GarbageCollectionTiers = test.tiers
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
+
+// runs validation on the field etcd.spec.backup.compression.policy. Accepted values: gzip, lzw, zlib, zstd, lz4
 func TestValidateSpecBackupCompressionPolicy(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -64,6 +147,18 @@ func TestValidateSpecBackupCompressionPolicy(t *testing.T) {
 			policy:    "zlib",
 			expectErr: false,
 		},
+		{
+			name:      "Valid compression Policy #4: zstd",
+			etcdName:  "etcd-valid-4",
+			policy:    "zstd",
+			expectErr: false,
+		},
+		{
+			name:      "Valid compression Policy #5: lz4",
+			etcdName:  "etcd-valid-5",
+			policy:    "lz4",
+			expectErr: false,
+		},
 		{
 			name:      "Invalid compression Policy #1: invalid value",
 			etcdName:  "etcd-invalid-1",
@@ -269,3 +364,115 @@ func TestValidateSpecBackupFullSnapshotSchedule(t *testing.T) {
 		})
 	}
 }
+
+// validates that etcd.spec.backup.store.workloadIdentity is only accepted for the S3, GCS and ABS storage providers.
+func TestValidateSpecBackupStoreWorkloadIdentity(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name      string
+		etcdName  string
+		provider  string
+		expectErr bool
+	}{
+		{"workload identity with S3 storage provider; valid", "etcd-valid-1", "aws", false},
+		{"workload identity with GCS storage provider; valid", "etcd-valid-2", "gcp", false},
+		{"workload identity with ABS storage provider; valid", "etcd-valid-3", "azure", false},
+		{"workload identity with Swift storage provider; invalid", "etcd-invalid-1", "openstack", true},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
+				Prefix:           test.etcdName,
+				Provider:         (*druidv1alpha1.StorageProvider)(&test.provider),
+				WorkloadIdentity: &druidv1alpha1.WorkloadIdentity{},
+			}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
+
+// TestValidateSpecBackupSecondaryStores tests the validation of `Spec.Backup.SecondaryStores` field in the Etcd resource.
+func TestValidateSpecBackupSecondaryStores(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name           string
+		etcdName       string
+		withStore      bool
+		secondaryStore druidv1alpha1.SecondaryStoreSpec
+		expectErr      bool
+	}{
+		{
+			name:      "secondary store with secretRef; valid",
+			etcdName:  "etcd-valid-1",
+			withStore: true,
+			secondaryStore: druidv1alpha1.SecondaryStoreSpec{
+				Name:  "dr",
+				Store: druidv1alpha1.StoreSpec{Prefix: "etcd-valid-1", SecretRef: &corev1.SecretReference{Name: "secondary-backup"}},
+			},
+			expectErr: false,
+		},
+		{
+			name:     "secondary store without primary store; invalid",
+			etcdName: "etcd-invalid-1",
+			secondaryStore: druidv1alpha1.SecondaryStoreSpec{
+				Name:  "dr",
+				Store: druidv1alpha1.StoreSpec{Prefix: "etcd-invalid-1", SecretRef: &corev1.SecretReference{Name: "secondary-backup"}},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "secondary store without secretRef; invalid",
+			etcdName:  "etcd-invalid-2",
+			withStore: true,
+			secondaryStore: druidv1alpha1.SecondaryStoreSpec{
+				Name:  "dr",
+				Store: druidv1alpha1.StoreSpec{Prefix: "etcd-invalid-2"},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "secondary store with workload identity; invalid",
+			etcdName:  "etcd-invalid-3",
+			withStore: true,
+			secondaryStore: druidv1alpha1.SecondaryStoreSpec{
+				Name: "dr",
+				Store: druidv1alpha1.StoreSpec{
+					Prefix:           "etcd-invalid-3",
+					SecretRef:        &corev1.SecretReference{Name: "secondary-backup"},
+					WorkloadIdentity: &druidv1alpha1.WorkloadIdentity{},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "secondary store with invalid name; invalid",
+			etcdName:  "etcd-invalid-4",
+			withStore: true,
+			secondaryStore: druidv1alpha1.SecondaryStoreSpec{
+				Name:  "Secondary_Store",
+				Store: druidv1alpha1.StoreSpec{Prefix: "etcd-invalid-4", SecretRef: &corev1.SecretReference{Name: "secondary-backup"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			if test.withStore {
+				etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
+					Prefix:    test.etcdName,
+					SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+				}
+			}
+			etcd.Spec.Backup.SecondaryStores = []druidv1alpha1.SecondaryStoreSpec{test.secondaryStore}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
@@ -110,3 +110,43 @@ func TestValidateSpecEtcdDefragmentationSchedule(t *testing.T) {
 		})
 	}
 }
+
+// runs validation on the keys of the etcd.spec.etcd.additionalConfig field.
+func TestValidateSpecEtcdAdditionalConfig(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name             string
+		etcdName         string
+		additionalConfig map[string]string
+		expectErr        bool
+	}{
+		{
+			name:             "Valid additionalConfig #1: supported options",
+			etcdName:         "etcd-valid-1",
+			additionalConfig: map[string]string{"max-request-bytes": "10485760", "log-level": "warn"},
+			expectErr:        false,
+		},
+		{
+			name:             "Invalid additionalConfig #1: option managed by etcd-druid",
+			etcdName:         "etcd-invalid-1",
+			additionalConfig: map[string]string{"data-dir": "/tmp"},
+			expectErr:        true,
+		},
+		{
+			name:             "Invalid additionalConfig #2: unsupported option",
+			etcdName:         "etcd-invalid-2",
+			additionalConfig: map[string]string{"unsafe-no-fsync": "true"},
+			expectErr:        true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.Etcd.AdditionalConfig = test.additionalConfig
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
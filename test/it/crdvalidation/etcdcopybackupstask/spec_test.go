@@ -5,11 +5,15 @@
 package etcdcopybackupstask
 
 import (
+	"context"
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/test/utils"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
 	. "github.com/onsi/gomega"
 )
 
@@ -164,3 +168,200 @@ func TestValidateSpecMaxBackups(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateSpecSchedule tests the validation of the `spec.schedule` field of the EtcdCopyBackupsTask CR.
+func TestValidateSpecSchedule(t *testing.T) {
+	testNs, g := setupTestEnvironment(t)
+	var tests = []struct {
+		name               string
+		taskName           string
+		withOptionalFields bool
+		value              string
+		expectErr          bool
+	}{
+		{
+			name:      "valid schedule #1",
+			taskName:  "task-valid-1",
+			value:     "*/30 * * * *",
+			expectErr: false,
+		},
+		{
+			name:      "valid schedule #2",
+			taskName:  "task-valid-2",
+			value:     "0 */2 * * *",
+			expectErr: false,
+		},
+		{
+			name:      "invalid schedule #1",
+			taskName:  "task-invalid-1",
+			value:     "every hour",
+			expectErr: true,
+		},
+		{
+			name:      "invalid schedule #2",
+			taskName:  "task-invalid-2",
+			value:     "0 25 * * *",
+			expectErr: true,
+		},
+		{
+			name:               "schedule with waitForFinalSnapshot enabled",
+			taskName:           "task-invalid-3",
+			withOptionalFields: true,
+			value:              "0 */2 * * *",
+			expectErr:          true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			copyBackupTask := utils.CreateEtcdCopyBackupsTask(test.taskName, testNs, "aws", test.withOptionalFields)
+			patchedCopyBackupTask, err := patchObject(copyBackupTask, []string{
+				"spec", "schedule",
+			}, test.value)
+			g.Expect(err).ToNot(HaveOccurred(), "failed to patch object: %v", err)
+			validatePatchedObjectCreation[*druidv1alpha1.EtcdCopyBackupsTask](g, patchedCopyBackupTask, test.expectErr)
+		})
+	}
+}
+
+// TestValidateSpecEtcdRefs tests the validation of the `spec.sourceEtcdRef`, `spec.targetEtcdRef` and
+// `spec.triggerTargetEtcdReconcile` fields of the EtcdCopyBackupsTask CR.
+func TestValidateSpecEtcdRefs(t *testing.T) {
+	testNs, g := setupTestEnvironment(t)
+	var tests = []struct {
+		name                       string
+		taskName                   string
+		withSourceStore            bool
+		withSourceEtcdRef          bool
+		withTargetStore            bool
+		withTargetEtcdRef          bool
+		withSchedule               bool
+		triggerTargetEtcdReconcile bool
+		expectErr                  bool
+	}{
+		{
+			name:              "source and target etcd references",
+			taskName:          "task-valid-1",
+			withSourceEtcdRef: true,
+			withTargetEtcdRef: true,
+			expectErr:         false,
+		},
+		{
+			name:              "source store and target etcd reference",
+			taskName:          "task-valid-2",
+			withSourceStore:   true,
+			withTargetEtcdRef: true,
+			expectErr:         false,
+		},
+		{
+			name:                       "target etcd reconciliation triggered with target etcd reference",
+			taskName:                   "task-valid-3",
+			withSourceStore:            true,
+			withTargetEtcdRef:          true,
+			triggerTargetEtcdReconcile: true,
+			expectErr:                  false,
+		},
+		{
+			name:              "both source store and source etcd reference",
+			taskName:          "task-invalid-1",
+			withSourceStore:   true,
+			withSourceEtcdRef: true,
+			withTargetStore:   true,
+			expectErr:         true,
+		},
+		{
+			name:            "neither target store nor target etcd reference",
+			taskName:        "task-invalid-2",
+			withSourceStore: true,
+			expectErr:       true,
+		},
+		{
+			name:                       "target etcd reconciliation triggered without target etcd reference",
+			taskName:                   "task-invalid-3",
+			withSourceStore:            true,
+			withTargetStore:            true,
+			triggerTargetEtcdReconcile: true,
+			expectErr:                  true,
+		},
+		{
+			name:                       "target etcd reconciliation triggered for recurring task",
+			taskName:                   "task-invalid-4",
+			withSourceStore:            true,
+			withTargetEtcdRef:          true,
+			withSchedule:               true,
+			triggerTargetEtcdReconcile: true,
+			expectErr:                  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			copyBackupTask := utils.CreateEtcdCopyBackupsTask(test.taskName, testNs, "aws", false)
+			if !test.withSourceStore {
+				copyBackupTask.Spec.SourceStore = druidv1alpha1.StoreSpec{}
+			}
+			if test.withSourceEtcdRef {
+				copyBackupTask.Spec.SourceEtcdRef = &corev1.LocalObjectReference{Name: "source-etcd"}
+			}
+			if !test.withTargetStore {
+				copyBackupTask.Spec.TargetStore = druidv1alpha1.StoreSpec{}
+			}
+			if test.withTargetEtcdRef {
+				copyBackupTask.Spec.TargetEtcdRef = &corev1.LocalObjectReference{Name: "target-etcd"}
+			}
+			if test.withSchedule {
+				copyBackupTask.Spec.Schedule = ptr.To("0 */2 * * *")
+			}
+			patchedCopyBackupTask, err := patchObject(copyBackupTask, []string{
+				"spec", "triggerTargetEtcdReconcile",
+			}, test.triggerTargetEtcdReconcile)
+			g.Expect(err).ToNot(HaveOccurred(), "failed to patch object: %v", err)
+			validatePatchedObjectCreation[*druidv1alpha1.EtcdCopyBackupsTask](g, patchedCopyBackupTask, test.expectErr)
+		})
+	}
+}
+
+// checks that the choice between a store and an etcd reference cannot be changed after creation
+func TestValidateUpdateSpecEtcdRefs(t *testing.T) {
+	testNs, g := setupTestEnvironment(t)
+	var tests = []struct {
+		name              string
+		taskName          string
+		switchSourceStore bool
+		changeSourceStore bool
+		expectErr         bool
+	}{
+		{
+			name:              "Valid #1: Unchanged source store",
+			taskName:          "task-valid-1",
+			changeSourceStore: true,
+			expectErr:         false,
+		},
+		{
+			name:              "Invalid #1: Source store replaced by source etcd reference",
+			taskName:          "task-invalid-1",
+			switchSourceStore: true,
+			expectErr:         true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			copyBackupTask := utils.CreateEtcdCopyBackupsTask(test.taskName, testNs, "aws", false)
+			cl := itTestEnv.GetClient()
+			ctx := context.Background()
+			g.Expect(cl.Create(ctx, copyBackupTask)).To(Succeed())
+
+			if test.changeSourceStore {
+				copyBackupTask.Spec.SourceStore.Container = ptr.To("new-container")
+			}
+			if test.switchSourceStore {
+				copyBackupTask.Spec.SourceStore = druidv1alpha1.StoreSpec{}
+				copyBackupTask.Spec.SourceEtcdRef = &corev1.LocalObjectReference{Name: "source-etcd"}
+			}
+			updateErr := cl.Update(ctx, copyBackupTask)
+			if test.expectErr {
+				g.Expect(updateErr).To(HaveOccurred())
+			} else {
+				g.Expect(updateErr).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
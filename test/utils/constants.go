@@ -46,6 +46,12 @@ const (
 	BackupRestoreTLSClientCertSecretName = "etcdbr-client-tls" // #nosec G101 - this is not a credential itself but the name of the kubernetes secret resource.
 	// BackupStoreSecretName is the name of the kubernetes Secret containing the backup store credentials.
 	BackupStoreSecretName = "etcd-backup"
+	// EtcdAuthUserPasswordSecretName is the name of the kubernetes Secret containing the password of an etcd user.
+	EtcdAuthUserPasswordSecretName = "etcd-user-password" // #nosec G101 - this is not a credential itself but the name of the kubernetes secret resource.
+	// BackupEncryptionSecretName is the name of the kubernetes Secret containing the keys with which backups are encrypted.
+	BackupEncryptionSecretName = "etcd-backup-encryption" // #nosec G101 - this is not a credential itself but the name of the kubernetes secret resource.
+	// SecondaryBackupStoreSecretName is the name of the kubernetes Secret containing the credentials of the secondary backup store.
+	SecondaryBackupStoreSecretName = "etcd-secondary-backup" // #nosec G101 - this is not a credential itself but the name of the kubernetes secret resource.
 )
 
 const (
@@ -179,6 +179,61 @@ func (eb *EtcdBuilder) WithBackupRestoreTLS() *EtcdBuilder {
 	return eb
 }
 
+// WithEtcdAuth configures etcd's built-in authentication with a single user on the Etcd resource.
+func (eb *EtcdBuilder) WithEtcdAuth() *EtcdBuilder {
+	if eb == nil || eb.etcd == nil {
+		return nil
+	}
+	eb.etcd.Spec.Etcd.Auth = &druidv1alpha1.EtcdAuth{
+		Roles: []druidv1alpha1.EtcdAuthRole{
+			{
+				Name:        "reader",
+				Permissions: []druidv1alpha1.EtcdAuthPermission{{KeyPrefix: "/registry/", Type: druidv1alpha1.EtcdAuthPermissionRead}},
+			},
+		},
+		Users: []druidv1alpha1.EtcdAuthUser{
+			{
+				Name: "reader",
+				PasswordSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: EtcdAuthUserPasswordSecretName},
+					Key:                  "password",
+				},
+				Roles: []string{"reader"},
+			},
+		},
+	}
+	return eb
+}
+
+// WithBackupEncryption configures the encryption of backups with the key "key-1" from a secret on the Etcd resource.
+func (eb *EtcdBuilder) WithBackupEncryption() *EtcdBuilder {
+	if eb == nil || eb.etcd == nil {
+		return nil
+	}
+	eb.etcd.Spec.Backup.Encryption = &druidv1alpha1.EncryptionSpec{
+		KeyID:     "key-1",
+		SecretRef: &corev1.LocalObjectReference{Name: BackupEncryptionSecretName},
+	}
+	return eb
+}
+
+// WithSecondaryBackupStore adds a secondary backup store with the given name to the Etcd resource.
+func (eb *EtcdBuilder) WithSecondaryBackupStore(name string) *EtcdBuilder {
+	if eb == nil || eb.etcd == nil {
+		return nil
+	}
+	eb.etcd.Spec.Backup.SecondaryStores = append(eb.etcd.Spec.Backup.SecondaryStores, druidv1alpha1.SecondaryStoreSpec{
+		Name: name,
+		Store: druidv1alpha1.StoreSpec{
+			Container: ptr.To("secondary-bucket"),
+			Prefix:    fmt.Sprintf("%s--%s", eb.etcd.Namespace, eb.etcd.Name),
+			Provider:  ptr.To(druidv1alpha1.StorageProvider(store.GCS)),
+			SecretRef: &corev1.SecretReference{Name: SecondaryBackupStoreSecretName},
+		},
+	})
+	return eb
+}
+
 // WithDeltaSnapshotPeriod sets the delta snapshot period on the Etcd resource.
 func (eb *EtcdBuilder) WithDeltaSnapshotPeriod(deltaSnapshotPeriod time.Duration) *EtcdBuilder {
 	if eb == nil || eb.etcd == nil {
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	etcdclient "github.com/gardener/etcd-druid/internal/client/etcd"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// FakeMaintenanceClient is a fake etcdclient.MaintenanceClient which serves the members, alarms, statuses and hashes
+// of an etcd cluster from memory. Calls of methods which are not implemented panic.
+type FakeMaintenanceClient struct {
+	clientv3.Cluster
+	clientv3.Maintenance
+	// Members are returned by MemberList.
+	Members []*etcdserverpb.Member
+	// Alarms are returned by AlarmList and are removed by AlarmDisarm.
+	Alarms []*etcdserverpb.AlarmMember
+	// Statuses are the statuses of the members keyed by their endpoints. Members without status are unreachable.
+	Statuses map[string]*clientv3.StatusResponse
+	// Hashes are the hashes of the members keyed by their endpoints. Members without hash are unreachable.
+	Hashes map[string]*clientv3.HashKVResponse
+	// Err is returned by MemberList and AlarmList if set.
+	Err error
+
+	mu sync.Mutex
+	// clientsCreated is the number of clients which have been created by the factory.
+	clientsCreated int
+	// statusCalls is the number of calls of Status per endpoint.
+	statusCalls map[string]int
+	// disarmed is set once an alarm has been disarmed.
+	disarmed bool
+}
+
+// NewFakeMaintenanceClient returns a new FakeMaintenanceClient without any members.
+func NewFakeMaintenanceClient() *FakeMaintenanceClient {
+	return &FakeMaintenanceClient{
+		Statuses:    make(map[string]*clientv3.StatusResponse),
+		Hashes:      make(map[string]*clientv3.HashKVResponse),
+		statusCalls: make(map[string]int),
+	}
+}
+
+// Factory returns an etcdclient.MaintenanceClientFactory which returns this client.
+func (f *FakeMaintenanceClient) Factory() etcdclient.MaintenanceClientFactory {
+	return func(_ string, _ *tls.Config) (etcdclient.MaintenanceClient, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.clientsCreated++
+		return f, nil
+	}
+}
+
+// ClientsCreated returns the number of clients which have been created by the factory.
+func (f *FakeMaintenanceClient) ClientsCreated() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.clientsCreated
+}
+
+// StatusCalls returns the number of calls of Status for the given endpoint.
+func (f *FakeMaintenanceClient) StatusCalls(endpoint string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.statusCalls[endpoint]
+}
+
+// Disarmed returns true if an alarm has been disarmed.
+func (f *FakeMaintenanceClient) Disarmed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.disarmed
+}
+
+// Close is a no-op.
+func (f *FakeMaintenanceClient) Close() error { return nil }
+
+// MemberList returns the members of the fake etcd cluster.
+func (f *FakeMaintenanceClient) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	return &clientv3.MemberListResponse{Members: f.Members}, nil
+}
+
+// AlarmList returns the alarms of the fake etcd cluster.
+func (f *FakeMaintenanceClient) AlarmList(_ context.Context) (*clientv3.AlarmResponse, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &clientv3.AlarmResponse{Alarms: f.Alarms}, nil
+}
+
+// AlarmDisarm removes all alarms of the fake etcd cluster.
+func (f *FakeMaintenanceClient) AlarmDisarm(_ context.Context, _ *clientv3.AlarmMember) (*clientv3.AlarmResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Alarms = nil
+	f.disarmed = true
+	return &clientv3.AlarmResponse{}, nil
+}
+
+// Status returns the status of the member with the given endpoint.
+func (f *FakeMaintenanceClient) Status(_ context.Context, endpoint string) (*clientv3.StatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statusCalls[endpoint]++
+	status, ok := f.Statuses[endpoint]
+	if !ok {
+		return nil, fmt.Errorf("endpoint %s is unreachable", endpoint)
+	}
+	return status, nil
+}
+
+// HashKV returns the hash of the key-value store of the member with the given endpoint at the given revision.
+func (f *FakeMaintenanceClient) HashKV(_ context.Context, endpoint string, rev int64) (*clientv3.HashKVResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	hash, ok := f.Hashes[endpoint]
+	if !ok {
+		return nil, fmt.Errorf("endpoint %s is unreachable", endpoint)
+	}
+	return &clientv3.HashKVResponse{Hash: hash.Hash, CompactRevision: hash.CompactRevision, HashRevision: rev}, nil
+}
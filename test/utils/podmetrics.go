@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FakeMetricsClient is a fake client for the metrics API which serves the resource usage of pods from memory.
+type FakeMetricsClient struct {
+	// Usage is the resource usage of the containers of pods, keyed by pod and container name.
+	Usage map[client.ObjectKey]map[string]corev1.ResourceList
+	// Err is returned for all pods if set.
+	Err error
+}
+
+// NewFakeMetricsClient returns a new FakeMetricsClient without any resource usage.
+func NewFakeMetricsClient() *FakeMetricsClient {
+	return &FakeMetricsClient{Usage: make(map[client.ObjectKey]map[string]corev1.ResourceList)}
+}
+
+// SetPodUsage sets the resource usage of the containers of the pod with the given key.
+func (f *FakeMetricsClient) SetPodUsage(key client.ObjectKey, usage map[string]corev1.ResourceList) {
+	f.Usage[key] = usage
+}
+
+// GetPodUsage returns the resource usage of the containers of the pod with the given key.
+func (f *FakeMetricsClient) GetPodUsage(_ context.Context, key client.ObjectKey) (map[string]corev1.ResourceList, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	usage, ok := f.Usage[key]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "metrics.k8s.io", Resource: "pods"}, key.Name)
+	}
+	return usage, nil
+}